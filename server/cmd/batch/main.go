@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/repository"
 	"game_eating_pizza/pkg/database"
 )
 
@@ -29,6 +30,9 @@ func main() {
 	defer database.Close()
 	log.Println("Database connected successfully")
 
+	// Repository 초기화
+	repos := repository.NewRepositories(db, cfg)
+
 	// 배치 작업 실행 컨텍스트
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -47,8 +51,10 @@ func main() {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				// TODO: 배치 작업 구현
-				// 예: 던전 상태 업데이트, 이벤트 생성, 통계 수집 등
+				// TODO: 던전 상태 업데이트, 이벤트 생성, 통계 수집 등
+				purgeExpiredRefreshTokens(repos.RefreshToken)
+				purgeExpiredPasswordResets(repos.PasswordReset)
+				purgeDeletedPlayers(repos.Player, cfg)
 				log.Println("Batch job executed")
 			}
 		}
@@ -64,3 +70,54 @@ func main() {
 
 	log.Println("Batch server exited")
 }
+
+// purgeExpiredRefreshTokens는 만료된 리프레시 토큰을 정리합니다
+func purgeExpiredRefreshTokens(refreshTokenRepo repository.RefreshTokenRepositoryInterface) {
+	deleted, err := refreshTokenRepo.DeleteExpired(time.Now())
+	if err != nil {
+		log.Printf("Failed to purge expired refresh tokens: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Purged %d expired refresh tokens", deleted)
+	}
+}
+
+// purgeExpiredPasswordResets는 만료된 비밀번호 재설정 코드를 정리합니다
+func purgeExpiredPasswordResets(passwordResetRepo repository.PasswordResetRepositoryInterface) {
+	deleted, err := passwordResetRepo.DeleteExpired(time.Now())
+	if err != nil {
+		log.Printf("Failed to purge expired password reset codes: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Purged %d expired password reset codes", deleted)
+	}
+}
+
+// purgeBatchSize는 한 번의 배치 실행에서 완전 삭제하는 최대 계정 수입니다
+const purgeBatchSize = 100
+
+// purgeDeletedPlayers는 삭제 유예 기간이 지난 계정을 관련 데이터와 함께 완전 삭제합니다
+func purgeDeletedPlayers(playerRepo repository.PlayerRepositoryInterface, cfg *config.Config) {
+	now := time.Now()
+	softDeletedBefore := now.AddDate(0, 0, -cfg.AccountDeletionGraceDays)
+
+	ids, err := playerRepo.FindIDsDueForPurge(now, softDeletedBefore, purgeBatchSize)
+	if err != nil {
+		log.Printf("Failed to find players to purge: %v", err)
+		return
+	}
+
+	var purged int
+	for _, id := range ids {
+		if err := playerRepo.Purge(id); err != nil {
+			log.Printf("Failed to purge player %d: %v", id, err)
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		log.Printf("Purged %d deleted players", purged)
+	}
+}
\ No newline at end of file
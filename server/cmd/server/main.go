@@ -9,9 +9,11 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // 시간대 데이터가 없는 컨테이너에서도 플레이어 시간대를 불러올 수 있도록 내장
 
 	"game_eating_pizza/internal/api"
 	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
 	"game_eating_pizza/pkg/database"
 
 	_ "game_eating_pizza/docs" // Swagger 문서를 위한 import
@@ -52,8 +54,29 @@ func main() {
 	defer database.Close()
 	log.Println("Database connected successfully")
 
+	// 게임 콘텐츠 로드 (검증에 실패하면 서버를 시작하지 않음)
+	contentStore, err := content.NewStore(cfg.ContentDir)
+	if err != nil {
+		log.Fatalf("Failed to load game content: %v", err)
+	}
+	log.Printf("Game content %s loaded from %s", contentStore.Current().Version, cfg.ContentDir)
+
+	// SIGHUP을 받으면 게임 콘텐츠를 다시 불러옴 (실패하면 기존 버전 유지)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			catalog, err := contentStore.Reload()
+			if err != nil {
+				log.Printf("Failed to reload game content, keeping %s: %v", contentStore.Current().Version, err)
+				continue
+			}
+			log.Printf("Game content %s reloaded", catalog.Version)
+		}
+	}()
+
 	// 라우터 설정
-	router := api.SetupRouter(db, cfg)
+	router := api.SetupRouter(db, cfg, contentStore)
 
 	// 서버 시작
 	serverAddr := fmt.Sprintf("%s:%s", cfg.ServerHost, cfg.ServerPort)
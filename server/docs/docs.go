@@ -24,47 +24,53 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
-            "post": {
-                "description": "플레이어 로그인을 처리하고 JWT 토큰을 반환합니다",
-                "consumes": [
-                    "application/json"
+        "/activity/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "걸음 수 기록이 있는 최근 날짜들의 목표 단계별 달성 여부와 수령 여부를 최신 날짜부터 조회합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "activity"
                 ],
-                "summary": "로그인",
+                "summary": "걸음 수 목표 기록",
                 "parameters": [
                     {
-                        "description": "로그인 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_api_handlers.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "조회할 날짜 수 (기본 30, 최대 90)",
+                        "name": "days",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "로그인 성공",
+                        "description": "날짜별 목표 상태 (days)",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "잘못된 요청",
+                    "401": {
+                        "description": "인증 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "인증 실패",
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -73,22 +79,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/refresh": {
-            "post": {
-                "description": "JWT 토큰을 갱신합니다",
-                "consumes": [
-                    "application/json"
+        "/activity/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "플레이어 시간대 기준 오늘을 포함한 최근 7일(week), 30일(month), 365일(year)의 날짜별 걸음 수와 칼로리, 합계와 하루 평균, 기간 내 최고 기록, 연속 달성 일수(가장 낮은 일일 목표 이상), 전체 기간 개인 최고 기록을 조회합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "activity"
+                ],
+                "summary": "활동 통계",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "조회 범위 (week, month, year / 기본 week)",
+                        "name": "range",
+                        "in": "query"
+                    }
                 ],
-                "summary": "토큰 갱신",
                 "responses": {
                     "200": {
-                        "description": "토큰 갱신 성공",
+                        "description": "활동 통계",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 조회 범위",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -100,13 +122,32 @@ const docTemplate = `{
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "/auth/register": {
+        "/activity/steps": {
             "post": {
-                "description": "새로운 플레이어를 등록합니다",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "휴대폰/워치가 측정한 구간별 걸음 수 샘플을 시간순으로 모아 보내면 플레이어 시간대 기준 날짜별 활동 기록에 더합니다. timezone을 함께 보내면 플레이어 시간대를 갱신하며, 시간대를 바꿔도 이미 지난 날짜가 다시 열리지 않습니다. 미래 시각, 동기화 허용 기간보다 오래된 샘플, 시간순이 아니거나 이미 반영된 구간과 겹치는 샘플, 초당 걸음 수나 하루 최대 걸음 수를 넘는 샘플은 반영하지 않고 검토용으로 기록합니다. 이미 반영된 샘플을 다시 보내면 건너뜁니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -114,26 +155,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "activity"
                 ],
-                "summary": "회원가입",
+                "summary": "걸음 수 동기화",
                 "parameters": [
                     {
-                        "description": "회원가입 정보",
+                        "description": "걸음 수 샘플",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_api_handlers.RegisterRequest"
+                            "$ref": "#/definitions/internal_api_handlers.SyncStepsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "회원가입 성공",
+                    "200": {
+                        "description": "동기화 결과 (거부된 샘플 포함)",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepSyncResponse"
                         }
                     },
                     "400": {
@@ -143,6 +183,27 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "다른 동기화 요청과 충돌 (다시 시도)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -153,35 +214,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/dungeons/active": {
+        "/activity/today": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "활성화된 던전 목록만 조회합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "플레이어 시간대 기준 오늘의 걸음 수와 목표 단계별(예: 3천/5천/1만 보) 보상, 달성 여부, 수령 여부를 조회합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dungeons"
+                    "activity"
                 ],
-                "summary": "활성 던전 목록 조회",
+                "summary": "오늘의 걸음 수 목표",
                 "responses": {
                     "200": {
-                        "description": "던전 목록",
+                        "description": "오늘의 목표 상태",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
-                                }
-                            }
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalDayResponse"
                         }
                     },
                     "401": {
@@ -191,6 +243,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -201,35 +260,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/dungeons/all": {
-            "get": {
+        "/activity/today/claim": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "전체 던전 목록을 조회합니다 (활성/비활성 포함)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "오늘 달성했지만 아직 받지 않은 목표 단계의 골드와 활력의 불씨를 한 번에 수령합니다. 목표 단계마다 하루에 한 번만 지급되며, 다시 요청하면 claimed가 빈 배열로 반환됩니다. 지난 날짜의 보상은 수령할 수 없습니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dungeons"
+                    "activity"
                 ],
-                "summary": "전체 던전 목록 조회",
+                "summary": "오늘의 걸음 수 목표 보상 수령",
                 "responses": {
                     "200": {
-                        "description": "던전 목록",
+                        "description": "수령 결과",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
-                                }
-                            }
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalClaimResultResponse"
                         }
                     },
                     "401": {
@@ -239,6 +289,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -249,56 +306,83 @@ const docTemplate = `{
                 }
             }
         },
-        "/dungeons/{id}": {
+        "/admin/audit-logs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "특정 던전의 상세 정보를 조회합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "운영 작업 감사 로그를 최신순으로 조회합니다 (admin 전용)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dungeons"
+                    "admin"
                 ],
-                "summary": "던전 상세 조회",
+                "summary": "감사 로그 조회",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "던전 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "작업자 ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "동작 (예: player.ban, grant.gold)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "대상 종류 (player, dungeon)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "대상 ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본값: 50, 최대: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "던전 정보",
+                        "description": "감사 로그 목록",
                         "schema": {
-                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "잘못된 요청",
+                    "401": {
+                        "description": "인증 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "인증 실패",
+                    "403": {
+                        "description": "권한 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "던전을 찾을 수 없음",
+                    "500": {
+                        "description": "서버 오류",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -307,66 +391,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/dungeons/{id}/clear": {
-            "post": {
+        "/admin/dungeons": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "던전을 클리어하고 보상을 획득합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "비활성 던전을 포함한 전체 던전 목록을 조회합니다 (operator 이상)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dungeons"
-                ],
-                "summary": "던전 클리어",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "던전 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "던전 목록 조회 (운영)",
                 "responses": {
                     "200": {
-                        "description": "클리어 성공",
+                        "description": "던전 목록",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "잘못된 요청",
+                    "401": {
+                        "description": "인증 실패",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "인증 실패",
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            }
-        },
-        "/dungeons/{id}/enter": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "던전에 입장합니다",
+                "description": "새로운 던전을 생성합니다 (operator 이상, 감사 로그 기록)",
                 "consumes": [
                     "application/json"
                 ],
@@ -374,24 +451,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dungeons"
+                    "admin"
                 ],
-                "summary": "던전 입장",
+                "summary": "던전 생성",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "던전 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "던전 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.DungeonRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "입장 성공",
+                    "201": {
+                        "description": "생성된 던전",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
                         }
                     },
                     "400": {
@@ -408,7 +486,14 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "500": {
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
                         "description": "서버 오류",
                         "schema": {
                             "type": "object",
@@ -418,14 +503,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/players/leaderboard": {
-            "get": {
+        "/admin/dungeons/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "레벨이 높은 상위 플레이어 목록을 조회합니다",
+                "description": "던전 정보를 수정합니다 (operator 이상, 감사 로그 기록)",
                 "consumes": [
                     "application/json"
                 ],
@@ -433,20 +518,57 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "admin"
                 ],
-                "summary": "리더보드 조회",
+                "summary": "던전 수정",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "조회할 플레이어 수 (기본값: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "던전 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "던전 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.DungeonRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "리더보드",
+                        "description": "수정된 던전",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "던전을 찾을 수 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -460,31 +582,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/players/me": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "현재 로그인한 플레이어의 정보를 조회합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "던전을 삭제합니다 (operator 이상, 감사 로그 기록)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "admin"
+                ],
+                "summary": "던전 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "던전 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "내 정보 조회",
                 "responses": {
                     "200": {
-                        "description": "플레이어 정보",
+                        "description": "삭제 성공",
                         "schema": {
-                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerResponse"
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "401": {
@@ -494,42 +628,22 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "플레이어를 찾을 수 없음",
+                    "403": {
+                        "description": "권한 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "현재 로그인한 플레이어의 정보를 수정합니다",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "players"
-                ],
-                "summary": "내 정보 수정",
-                "responses": {
-                    "200": {
-                        "description": "수정 성공",
+                    },
+                    "404": {
+                        "description": "던전을 찾을 수 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "인증 실패",
+                    "500": {
+                        "description": "서버 오류",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -538,27 +652,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/weapons": {
+        "/admin/players": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "현재 로그인한 플레이어의 무기 목록을 조회합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "사용자명으로 플레이어를 조회합니다 (operator 이상)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "weapons"
+                    "admin"
+                ],
+                "summary": "플레이어 검색",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자명",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "무기 목록 조회",
                 "responses": {
                     "200": {
-                        "description": "무기 목록",
+                        "description": "플레이어 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.AdminPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -571,35 +697,56 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "서버 오류",
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/players/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "새로운 무기를 생성합니다",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "ID로 플레이어 상세 정보(이용 정지 상태 포함)를 조회합니다 (operator 이상)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "weapons"
+                    "admin"
+                ],
+                "summary": "플레이어 상세 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "무기 생성",
                 "responses": {
-                    "201": {
-                        "description": "무기 생성 성공",
+                    "200": {
+                        "description": "플레이어 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.AdminPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -611,18 +758,32 @@ const docTemplate = `{
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "/weapons/{id}/equip": {
-            "put": {
+        "/admin/players/{id}/experience": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "무기를 장착하여 현재 무기로 설정합니다",
+                "description": "플레이어에게 경험치를 지급합니다. 레벨이 오르면 레벨업 보상도 함께 지급되고 오른 레벨마다 레벨업 이벤트를 반환합니다 (admin 전용, 감사 로그 기록)",
                 "consumes": [
                     "application/json"
                 ],
@@ -630,24 +791,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "weapons"
+                    "admin"
                 ],
-                "summary": "무기 장착",
+                "summary": "경험치 지급",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "무기 ID",
+                        "description": "플레이어 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "지급량과 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GrantExperienceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "장착 성공",
+                        "description": "지급 후 레벨, 경험치와 레벨업 이벤트",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ExperienceGrantResponse"
                         }
                     },
                     "400": {
@@ -664,6 +833,27 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "동시에 레벨이 바뀜 (다시 시도)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -674,14 +864,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/weapons/{id}/upgrade": {
-            "put": {
+        "/admin/players/{id}/gold": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "무기를 강화하여 공격력을 증가시킵니다",
+                "description": "플레이어에게 골드를 지급합니다. 음수면 회수합니다 (admin 전용, 감사 로그 기록)",
                 "consumes": [
                     "application/json"
                 ],
@@ -689,23 +879,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "weapons"
+                    "admin"
                 ],
-                "summary": "무기 강화",
+                "summary": "골드 지급",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "무기 ID",
+                        "description": "플레이어 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "지급량과 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GrantGoldRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "강화된 무기 정보",
+                        "description": "지급 후 잔액",
                         "schema": {
-                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -722,6 +922,27 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "회수할 골드 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -731,105 +952,5321 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "game_eating_pizza_internal_api_dto.DungeonResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "difficulty": {
+        },
+        "/admin/players/{id}/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어에게 재료·소모품 아이템을 지급합니다. 음수면 회수합니다 (admin 전용, 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "아이템 지급",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "아이템 ID, 지급량과 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GrantItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "지급 후 수량",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어 또는 아이템을 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "최대 보유 수량 초과 또는 회수할 수량 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어의 역할(player, operator, admin)을 변경합니다 (admin 전용, 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "플레이어 역할 변경",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "새 역할",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.ChangeRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "변경된 플레이어",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.AdminPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/suspensions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어가 받은 이용 정지 이력(해제, 만료된 정지 포함)을 최신순으로 조회합니다 (operator 이상)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "플레이어 이용 정지 이력 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "이용 정지 이력",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어를 이용 정지합니다. 정지 기간 동안 로그인, 토큰 갱신, 기존 토큰을 사용한 API 호출이 모두 403 ACCOUNT_SUSPENDED로 거부되며, 만료 시각을 생략하면 영구 정지입니다 (operator 이상, 자신보다 낮은 권한만 가능, 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "플레이어 이용 정지",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "정지 사유와 만료 시각",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.SuspendPlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "발급된 이용 정지",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SuspensionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 이용 정지 중",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/wallet": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어에게 골드, 활력의 불씨, 젬을 지급합니다. 음수면 회수합니다 (admin 전용, 재화 원장과 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "재화 지급",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "재화 종류, 지급량과 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GrantCurrencyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "지급 후 잔액",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "회수할 재화 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/players/{id}/weapons": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "플레이어에게 무기를 지급합니다. 무기 종류와 등급은 현재 콘텐츠의 무기 템플릿과 등급 표에 있는 것만, 단계는 강화 비용 표가 다루는 단계까지만 지급할 수 있습니다. 보관함이 가득 찬 플레이어에게는 지급하지 않습니다 (admin 전용, 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "무기 지급",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "무기 정보와 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GrantWeaponRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "지급된 무기",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청, 콘텐츠에 없는 무기 종류·등급, 0 이하의 능력치 또는 강화 표 밖의 단계",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "무기 보관함이 가득 참",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/step-rejections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "걸음 수 동기화에서 검증에 실패해 반영되지 않은 샘플을 최신순으로 조회합니다 (operator 이상, 부정 행위 검토용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "거부된 걸음 수 샘플 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "player_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "거부 사유 (invalid, future, too_old, spans_days, non_monotonic, overlap, rate_exceeded, daily_limit)",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본값: 50, 최대: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "거부된 샘플 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/suspensions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "전체 이용 정지 목록을 최신순으로 조회합니다 (operator 이상)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "이용 정지 목록 조회",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "true면 현재 유효한 정지만 조회",
+                        "name": "active",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "플레이어 ID",
+                        "name": "player_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본값: 50, 최대: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "이용 정지 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/suspensions/{id}/lift": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "유효한 이용 정지를 만료 전에 해제합니다. 해제된 기록은 이력으로 남습니다 (operator 이상, 자신보다 낮은 권한만 가능, 감사 로그 기록)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "이용 정지 해제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "이용 정지 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "해제 사유",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.LiftSuspensionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "해제된 이용 정지",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SuspensionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "이용 정지를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 해제되었거나 만료된 정지",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/guest": {
+            "post": {
+                "description": "기기 식별자로 게스트 계정을 생성하거나, 이미 있으면 해당 게스트 계정으로 로그인합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "게스트 로그인",
+                "parameters": [
+                    {
+                        "description": "기기 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.GuestLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그인 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "이용 정지된 계정 (사유, 만료 시각 포함)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/link": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 게스트 계정에 아이디/비밀번호를 연결하여 정식 계정으로 전환합니다. 무기, 골드, 진행도는 그대로 유지됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "게스트 계정 연결",
+                "parameters": [
+                    {
+                        "description": "연결할 계정 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.LinkAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "계정 연결 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 사용 중인 사용자명 또는 게스트가 아닌 계정",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "플레이어 로그인을 처리하고 JWT 토큰을 반환합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "로그인",
+                "parameters": [
+                    {
+                        "description": "로그인 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그인 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "이용 정지된 계정 (사유, 만료 시각 포함)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "로그인 시도 횟수 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "현재 기기의 세션을 종료하고 리프레시 토큰(같은 로그인에서 회전된 토큰 포함)을 폐기합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "로그아웃",
+                "parameters": [
+                    {
+                        "description": "리프레시 토큰",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그아웃 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 플레이어의 모든 세션과 리프레시 토큰을 폐기합니다 (분실한 기기의 세션 종료용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "전체 기기 로그아웃",
+                "responses": {
+                    "200": {
+                        "description": "로그아웃 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password/forgot": {
+            "post": {
+                "description": "재설정 코드를 발급하여 등록된 알림 채널로 전달합니다. 계정 존재 여부와 관계없이 항상 같은 응답을 반환합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "비밀번호 재설정 코드 발급",
+                "parameters": [
+                    {
+                        "description": "사용자명",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "요청 접수",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "시도 횟수 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password/reset": {
+            "post": {
+                "description": "재설정 코드로 새 비밀번호를 설정합니다. 코드는 한 번만 사용할 수 있으며, 성공 시 모든 기기에서 로그아웃됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "비밀번호 재설정",
+                "parameters": [
+                    {
+                        "description": "사용자명, 재설정 코드, 새 비밀번호",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "재설정 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못되었거나 만료된 코드",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "시도 횟수 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "리프레시 토큰을 회전시키고 새로운 액세스/리프레시 토큰을 발급합니다. 이미 사용된 리프레시 토큰을 재사용하면 해당 로그인의 모든 토큰이 폐기됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "토큰 갱신",
+                "parameters": [
+                    {
+                        "description": "리프레시 토큰",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "토큰 갱신 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "이용 정지된 계정 (사유, 만료 시각 포함)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "새로운 플레이어를 등록합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "회원가입",
+                "parameters": [
+                    {
+                        "description": "회원가입 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "회원가입 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 사용 중인 사용자명",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/content/version": {
+            "get": {
+                "description": "서버가 현재 적용 중인 게임 콘텐츠 버전과 불러온 시각을 조회합니다. 모든 응답의 X-Content-Version 헤더에도 같은 버전이 담깁니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "content"
+                ],
+                "summary": "게임 콘텐츠 버전 조회",
+                "responses": {
+                    "200": {
+                        "description": "콘텐츠 버전",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ContentVersionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dungeons/active": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "활성화된 던전 목록만 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dungeons"
+                ],
+                "summary": "활성 던전 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "던전 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/dungeons/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "전체 던전 목록을 조회합니다 (활성/비활성 포함)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dungeons"
+                ],
+                "summary": "전체 던전 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "던전 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/dungeons/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 던전의 상세 정보를 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dungeons"
+                ],
+                "summary": "던전 상세 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "던전 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "던전 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "던전을 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/dungeons/{id}/clear": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "던전을 클리어하고 보상을 획득합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dungeons"
+                ],
+                "summary": "던전 클리어",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "던전 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "클리어 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/dungeons/{id}/enter": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "던전에 입장합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dungeons"
+                ],
+                "summary": "던전 입장",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "던전 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "입장 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/forge/boost": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "오늘 걸음 수로 계산한 대장간 부스트 배율을 조회합니다. 3,000보 이상 1.5배, 5,000보 이상 2배이며, 제작 시작 시점의 배율만큼 제작 시간이 짧아지고 rare 이상 등급 확률이 올라갑니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "대장간 부스트 조회",
+                "responses": {
+                    "200": {
+                        "description": "현재 부스트",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ForgeBoostResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/forge/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "아직 수령하지 않은 제작 작업(제작 중, 수령 대기)을 완료 시각 순으로 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "제작 작업 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "제작 작업 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "레시피로 무기 제작을 시작합니다. 골드는 즉시 차감되며, 제작 시간이 지난 뒤 수령하면 서버가 결과 무기의 등급과 능력치를 결정합니다. 시작 시점의 걸음 수 부스트가 작업에 고정되어 제작 시간과 등급 확률에 적용됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "무기 제작 시작",
+                "parameters": [
+                    {
+                        "description": "레시피 ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.StartForgeJobRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "시작된 제작 작업",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ForgeJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "골드 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "레벨 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "레시피를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "제작 슬롯 또는 무기 보관함 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/forge/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "제작 작업의 상태와 남은 시간을 조회합니다 (폴링용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "제작 작업 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "제작 작업 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "제작 작업",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ForgeJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "제작 작업을 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/forge/jobs/{id}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "제작이 끝난 작업의 무기를 수령합니다. 결과 무기의 등급과 능력치는 이 시점에 작업을 시작할 때 고정한 레시피(무기 템플릿, 등급 확률)로 서버에서 결정됩니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "제작 무기 수령",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "제작 작업 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수령한 제작 작업과 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "제작 작업을 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "제작 중이거나 이미 수령함, 또는 무기 보관함 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "410": {
+                        "description": "레시피가 사라져 제작 비용을 돌려줌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/forge/recipes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "대장간에서 제작할 수 있는 무기 레시피(비용, 제작 시간, 필요 레벨, 등급 확률)와 오늘 걸음 수로 계산한 부스트를 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "제작 레시피 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "레시피 목록과 현재 부스트",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/idle/sync": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "앱을 다시 열 때 호출합니다. 마지막 정산 이후 흐른 시간(최대 정산 시간까지) 동안 장착한 무기(영구 강화 보너스 반영)로 현재 스테이지 몬스터를 처치했다고 보고 서버가 처치 수, 골드와 경험치를 계산해 지급합니다. 처음 정산하면 지급 없이 정산 시각만 기록합니다. 경험치로 레벨이 오르면 레벨업 보상도 함께 지급합니다. stage를 보내면 정산 후 그 스테이지로 옮기며, 한 번에 한 단계씩만 올라갈 수 있고 올라가려면 현재 스테이지에서 콘텐츠의 clear_kills만큼 처치(이번 정산 포함)해야 합니다. 조건을 채우지 못하면 아무것도 반영하지 않습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "idle"
+                ],
+                "summary": "방치 사냥 정산",
+                "parameters": [
+                    {
+                        "description": "옮겨 갈 스테이지",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.SyncIdleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "정산 결과",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.IdleSyncResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 또는 옮길 수 없는 스테이지",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "현재 스테이지를 아직 클리어하지 않음 또는 다른 정산 요청과 충돌 (다시 시도)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어가 보유한 재료·소모품 아이템을 아이템 ID 순으로 조회합니다 (수량이 0인 아이템은 제외)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "인벤토리 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "아이템 종류 (ore, gem_shard, scroll, 여러 개는 쉼표로 구분, 생략하면 전체)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본값: 50, 최대: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "보유 아이템 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 아이템 종류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/items": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게임에 존재하는 재료·소모품 아이템(광석, 보석 파편, 주문서) 정의를 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "아이템 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "아이템 종류 (ore, gem_shard, scroll, 여러 개는 쉼표로 구분, 생략하면 전체)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "아이템 정의 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 아이템 종류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/levels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "1레벨부터 최고 레벨까지 다음 레벨에 필요한 경험치와 레벨 도달 보상(골드, 아이템, 해금 던전)을 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "levels"
+                ],
+                "summary": "레벨 표 조회",
+                "responses": {
+                    "200": {
+                        "description": "레벨 표",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelTableResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/leaderboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "레벨이 높은 상위 플레이어 목록을 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "리더보드 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "조회할 플레이어 수 (기본값: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "리더보드",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어의 정보를 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "내 정보 조회",
+                "responses": {
+                    "200": {
+                        "description": "플레이어 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어의 프로필(표시 이름, 아바타, 언어, 시간대, 알림 설정)을 부분 수정합니다. 골드, 레벨, 경험치 등 서버가 관리하는 필드가 포함되면 거부합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "내 정보 수정",
+                "parameters": [
+                    {
+                        "description": "수정할 필드만 포함",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "수정된 플레이어 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 (수정 불가 필드 포함, 필드 검증 실패)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "계정 삭제를 예약하고 모든 기기에서 로그아웃합니다. 유예 기간 내에 다시 로그인하면 삭제가 취소되며, 유예 기간이 지나면 모든 데이터가 완전 삭제됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "계정 삭제",
+                "parameters": [
+                    {
+                        "description": "비밀번호 확인 (정식 계정만)",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.DeleteAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "삭제 예약됨",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "비밀번호 불일치",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 삭제가 예약됨",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "비밀번호 확인 시도 횟수 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 플레이어에 대해 저장된 모든 데이터(프로필, 무기, 로그인 기기, 걸음 수 기록, 레이드 참여 기록, 이용 정지 이력, 거부된 걸음 수 샘플, 걸음 수 목표 보상 수령 기록)을 JSON 파일로 내려받습니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "개인 데이터 내보내기",
+                "responses": {
+                    "200": {
+                        "description": "개인 데이터",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerDataExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me/level": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 레벨과 경험치, 다음 레벨까지 필요한 경험치, 다음 레벨업 보상과 해금한 던전을 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "levels"
+                ],
+                "summary": "내 레벨 진행 상황 조회",
+                "responses": {
+                    "200": {
+                        "description": "레벨 진행 상황",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelProgressResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 비밀번호를 확인한 뒤 새 비밀번호로 변경합니다. 현재 기기를 제외한 모든 세션이 종료됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "비밀번호 변경",
+                "parameters": [
+                    {
+                        "description": "현재 비밀번호와 새 비밀번호",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "변경 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 (현재 비밀번호 불일치, 동일한 비밀번호)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "게스트 계정 (계정 연결 필요)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "시도 횟수 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인되어 있는 기기(휴대폰, 워치 등) 세션 목록을 최근 접속순으로 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "내 세션 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "세션 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SessionResponse"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/players/me/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "지정한 기기 세션을 종료합니다. 종료된 세션의 액세스/리프레시 토큰은 즉시 사용할 수 없게 됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "세션 종료",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "세션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "세션 종료 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "세션을 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/upgrades": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "영구 강화 노드별 구매 단계, 다음 단계 비용, 잠금 여부와 합산된 능력치 보너스를 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upgrades"
+                ],
+                "summary": "영구 강화 트리 조회",
+                "responses": {
+                    "200": {
+                        "description": "영구 강화 트리",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.UpgradeTreeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/upgrades/{id}/purchase": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "활력의 불씨를 써서 영구 강화 노드의 다음 단계를 구매합니다. 불씨 차감과 단계 상승은 함께 처리됩니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upgrades"
+                ],
+                "summary": "영구 강화 구매",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "강화 노드 ID (예: quick_hands)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "구매 후 영구 강화 트리",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.UpgradeTreeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "활력의 불씨 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "선행 강화 조건 미충족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "강화 노드를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "최대 단계이거나 동시 구매와 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/wallet": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어의 재화별 잔액(골드, 활력의 불씨, 젬, 무기 파편)을 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "지갑 조회",
+                "responses": {
+                    "200": {
+                        "description": "재화별 잔액",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WalletResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/wallet/ledger": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어의 재화 지급/차감 내역을 최신순으로 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "재화 원장 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "재화 종류 (gold, embers, gems, scrap, 생략하면 전체)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본값: 50, 최대: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "원장 항목 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 재화 종류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인한 플레이어의 무기 목록을 조회합니다. 영구 강화 보너스를 적용한 능력치(effective_*)를 함께 반환합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "무기 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "레시피로 무기 제작을 시작합니다. 골드는 즉시 차감되며, 제작 시간이 지난 뒤 수령하면 서버가 결과 무기의 등급과 능력치를 결정합니다. 시작 시점의 걸음 수 부스트가 작업에 고정되어 제작 시간과 등급 확률에 적용됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "forge"
+                ],
+                "summary": "무기 제작 시작",
+                "parameters": [
+                    {
+                        "description": "레시피 ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.StartForgeJobRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "시작된 제작 작업",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ForgeJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "골드 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "레벨 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "레시피를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "제작 슬롯 또는 무기 보관함 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/dismantle": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 무기를 분해하고 무기 파편(scrap)을 받습니다. 파편 수는 등급별 기본 수에 강화 단계마다 1개를 더한 값입니다. weapon_ids로 무기를 지정하거나 rarities로 등급을 골라 한 번에 분해합니다. 잠긴 무기와 장착 중인 무기는 분해할 수 없습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 분해",
+                "parameters": [
+                    {
+                        "description": "분해할 무기",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.DisposeWeaponsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "분해 결과",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponDisposalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "무기 ID와 등급 중 하나만 지정하지 않았거나 잘못된 등급",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "잠겼거나 장착 중인 무기, 또는 동시 요청과 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "보유한 무기 수, 보관 가능 수, 최대 보관 수와 다음 확장 비용을 조회합니다. 보관함이 가득 차면 대장간에서 새 무기를 제작하거나 수령할 수 없습니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 보관함 조회",
+                "responses": {
+                    "200": {
+                        "description": "무기 보관함 상태",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponInventoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/inventory/expand": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "보석을 내서 무기 보관함을 한 단계 확장합니다. 보석 차감과 확장은 함께 처리됩니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 보관함 확장",
+                "responses": {
+                    "200": {
+                        "description": "확장 후 무기 보관함 상태",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponInventoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "보석 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "플레이어를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "이미 최대 크기이거나 동시 요청과 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/promotions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "콘텐츠의 등급별 공격력 배율, 판매·분해 기본 보상, 특수 효과 수와 각 등급으로 승급하는 조건(성공 확률, 천장, 소모 무기 수, 골드)을 낮은 등급부터 조회합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 등급 표 조회",
+                "responses": {
+                    "200": {
+                        "description": "등급 표",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/sell": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 무기를 판매하고 골드를 받습니다. 가격은 등급별 기본 가격에 강화에 쓴 골드의 일부(콘텐츠의 weapon_upgrade.sell_refund, 기본 25%)를 더한 값입니다. weapon_ids로 무기를 지정하거나 rarities로 등급을 골라 한 번에 판매합니다. 잠긴 무기와 장착 중인 무기는 판매할 수 없습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 판매",
+                "parameters": [
+                    {
+                        "description": "판매할 무기",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.DisposeWeaponsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "판매 결과",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponDisposalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "무기 ID와 등급 중 하나만 지정하지 않았거나 잘못된 등급",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "잠겼거나 장착 중인 무기, 또는 동시 요청과 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/{id}/enchant": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "활력의 불씨를 내서 본인 무기에 특수 효과를 붙입니다. 효과 종류와 비용, 효과별 수치 범위는 게임 콘텐츠의 enchant 섹션을 따릅니다. 같은 종류의 효과가 이미 있으면 효과량과 발동 확률을 다시 굴리고, 없으면 등급별 슬롯(콘텐츠 등급 표의 effect_slots)에 여유가 있을 때만 새로 붙입니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 특수 효과 부여",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "무기 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "특수 효과 종류",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.EnchantWeaponRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "효과를 부여한 무기",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 효과 종류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "활력의 불씨 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "특수 효과 슬롯이 가득 참",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/{id}/equip": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "무기를 장착하여 현재 무기로 설정합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 장착",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "무기 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "장착 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/{id}/lock": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 무기를 잠그거나 잠금을 풉니다. 잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 잠금",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "무기 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "잠금 여부",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.LockWeaponRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "잠금 여부를 바꾼 무기",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/{id}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "같은 종류·등급의 무기를 재료로 소모하고 골드를 내서 본인 무기의 등급을 한 단계 올립니다. 성공 확률에 따라 실패할 수 있으며 실패해도 재료와 골드는 소모됩니다. 연속 실패가 천장에 이르면 다음 시도는 반드시 성공합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 등급 승급",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "무기 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "재료 무기",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_api_handlers.PromoteWeaponRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "승급 결과 (실패 포함)",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponPromotionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "재료 무기가 조건에 맞지 않음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "골드 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "최고 등급이거나 동시 요청과 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/weapons/{id}/upgrade": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 무기를 한 단계 강화하여 공격력을 증가시킵니다. 비용(현재 레벨 × 100 골드) 차감과 강화는 함께 처리됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weapons"
+                ],
+                "summary": "무기 강화",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "무기 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "강화된 무기 정보",
+                        "schema": {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "402": {
+                        "description": "골드 부족",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "다른 플레이어의 무기",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "무기를 찾을 수 없음",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "동시 강화 요청과 충돌",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "game_eating_pizza_internal_api_dto.ActivityBestsResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "description": "소모 칼로리가 가장 많은 날",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityStatsDayResponse"
+                        }
+                    ]
+                },
+                "steps": {
+                    "description": "걸음 수가 가장 많은 날 (기록이 없으면 null)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityStatsDayResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ActivityResponse": {
+            "type": "object",
+            "properties": {
+                "bonus_applied": {
+                    "type": "boolean"
+                },
+                "calories": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "last_synced_at": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "날짜를 연 시점의 시간대",
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ActivityStatsDayResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ActivityStatsResponse": {
+            "type": "object",
+            "properties": {
+                "active_days": {
+                    "type": "integer"
+                },
+                "average_calories": {
+                    "type": "number"
+                },
+                "average_steps": {
+                    "description": "기간 전체 날짜 수로 나눈 하루 평균",
+                    "type": "number"
+                },
+                "days": {
+                    "description": "날짜별 기록 (기록이 없는 날은 0)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityStatsDayResponse"
+                    }
+                },
+                "from": {
+                    "description": "조회 시작 날짜 (포함)",
+                    "type": "string"
+                },
+                "max_calories": {
+                    "type": "number"
+                },
+                "max_steps": {
+                    "description": "기간 내 하루 최대",
+                    "type": "integer"
+                },
+                "personal_bests": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityBestsResponse"
+                },
+                "range": {
+                    "description": "week, month, year",
+                    "type": "string"
+                },
+                "streak": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityStreakResponse"
+                },
+                "to": {
+                    "description": "오늘 (포함)",
+                    "type": "string"
+                },
+                "total_calories": {
+                    "type": "number"
+                },
+                "total_steps": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ActivityStreakResponse": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "description": "오늘 또는 어제까지 이어지는 연속 일수",
+                    "type": "integer"
+                },
+                "goal_steps": {
+                    "description": "하루 이 걸음 수 이상이면 달성",
+                    "type": "integer"
+                },
+                "longest": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.AdminPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "active_suspension": {
+                    "description": "현재 유효한 이용 정지",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SuspensionResponse"
+                        }
+                    ]
+                },
+                "avatar_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deletion_scheduled_at": {
+                    "description": "계정 삭제 예정 시각 (삭제 유예 중일 때만)",
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "embers": {
+                    "type": "integer"
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "gems": {
+                    "type": "integer"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_guest": {
+                    "type": "boolean"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "max_distance": {
+                    "type": "number"
+                },
+                "notification_preferences": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.NotificationPreferencesResponse"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "stage": {
+                    "description": "방치 사냥 중인 스테이지",
+                    "type": "integer"
+                },
+                "suspension_count": {
+                    "description": "지금까지 받은 이용 정지 횟수",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "total_kills": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ContentVersionResponse": {
+            "type": "object",
+            "properties": {
+                "loaded_at": {
+                    "description": "서버가 이 버전을 불러온 시각",
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.DungeonResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.DungeonUnlockResponse": {
+            "type": "object",
+            "properties": {
+                "dungeon_id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "description": "해금 보상을 받은 레벨",
+                    "type": "integer"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ExperienceGrantResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "description": "오른 레벨마다 하나씩, 낮은 레벨부터",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelUpEventResponse"
+                    }
+                },
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ForgeBoostResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "걸음 수 기록 날짜",
+                    "type": "string"
+                },
+                "multiplier": {
+                    "description": "1.0 = 부스트 없음",
+                    "type": "number"
+                },
+                "steps": {
+                    "description": "부스트 산정에 사용한 걸음 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ForgeJobResponse": {
+            "type": "object",
+            "properties": {
+                "boost_steps": {
+                    "description": "부스트 산정에 사용한 걸음 수",
+                    "type": "integer"
+                },
+                "claimed_at": {
+                    "type": "string"
+                },
+                "completes_at": {
+                    "type": "string"
+                },
+                "forge_boost": {
+                    "description": "시작 시점에 고정된 걸음 수 부스트 배율",
+                    "type": "number"
+                },
+                "gold_cost": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "recipe_id": {
+                    "type": "string"
+                },
+                "remaining_seconds": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "crafting, ready, claimed, refunded",
+                    "type": "string"
+                },
+                "weapon_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.IdleSyncResponse": {
+            "type": "object",
+            "properties": {
+                "capped": {
+                    "description": "최대 정산 시간을 넘어 버린 시간이 있는지",
+                    "type": "boolean"
+                },
+                "clear_kills": {
+                    "description": "다음 스테이지로 올라가는 데 필요한 처치 수",
+                    "type": "integer"
+                },
+                "credited_seconds": {
+                    "description": "그중 인정한 시간 (최대 정산 시간까지)",
+                    "type": "integer"
+                },
+                "damage_per_second": {
+                    "type": "number"
+                },
+                "elapsed_seconds": {
+                    "description": "직전 정산 이후 흐른 시간",
+                    "type": "integer"
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "kills": {
+                    "type": "integer"
+                },
+                "last_synced_at": {
+                    "description": "직전 정산 시각 (처음 정산하면 생략)",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "반영 후 레벨",
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "description": "처치 경험치로 오른 레벨마다 하나씩, 낮은 레벨부터",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelUpEventResponse"
+                    }
+                },
+                "next_stage": {
+                    "description": "앞으로 사냥할 스테이지",
+                    "type": "integer"
+                },
+                "stage": {
+                    "description": "정산에 쓴 스테이지",
+                    "type": "integer"
+                },
+                "stage_kills": {
+                    "description": "앞으로 사냥할 스테이지에서 처치한 몬스터 수",
+                    "type": "integer"
+                },
+                "synced_at": {
+                    "description": "새 정산 시각 (처치 하나를 채우지 못한 남은 시간은 다음 정산으로 넘어감)",
+                    "type": "string"
+                },
+                "total_kills": {
+                    "type": "integer"
+                },
+                "weapon_id": {
+                    "description": "정산에 쓴 무기 (장착한 무기가 없으면 생략)",
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.InventoryItemResponse": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "string"
+                },
+                "max_stack": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.ItemRewardResponse": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.LevelProgressResponse": {
+            "type": "object",
+            "properties": {
+                "exp_to_next": {
+                    "description": "다음 레벨까지 필요한 경험치 (최고 레벨이면 0)",
+                    "type": "integer"
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "max_level": {
+                    "type": "integer"
+                },
+                "next_reward": {
+                    "description": "다음으로 보상을 받는 레벨",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelResponse"
+                        }
+                    ]
+                },
+                "unlocked_dungeons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonUnlockResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.LevelResponse": {
+            "type": "object",
+            "properties": {
+                "exp_to_next": {
+                    "description": "다음 레벨까지 필요한 경험치 (최고 레벨이면 0)",
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "reward": {
+                    "description": "이 레벨에 도달할 때 받는 보상",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelRewardResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.LevelRewardResponse": {
+            "type": "object",
+            "properties": {
+                "dungeons": {
+                    "description": "해금하는 던전 ID",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ItemRewardResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.LevelTableResponse": {
+            "type": "object",
+            "properties": {
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.LevelResponse"
+                    }
+                },
+                "max_level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.LevelUpEventResponse": {
+            "type": "object",
+            "properties": {
+                "dungeons": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ItemRewardResponse"
+                    }
+                },
+                "level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.NotificationPreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "boolean"
+                },
+                "forge_complete": {
+                    "type": "boolean"
+                },
+                "raid": {
+                    "type": "boolean"
+                },
+                "step_goal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.PlayerDataExportResponse": {
+            "type": "object",
+            "properties": {
+                "dungeon_unlocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.DungeonUnlockResponse"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "forge_jobs": {
+                    "description": "수령·환불한 작업 포함",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ForgeJobResponse"
+                    }
+                },
+                "inventory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.InventoryItemResponse"
+                    }
+                },
+                "player": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerResponse"
+                },
+                "raid_participations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.RaidParticipationResponse"
+                    }
+                },
+                "rejected_step_samples": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.RejectedStepSampleResponse"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SessionResponse"
+                    }
+                },
+                "step_goal_claims": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalClaimResponse"
+                    }
+                },
+                "step_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityResponse"
+                    }
+                },
+                "suspensions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.SuspensionResponse"
+                    }
+                },
+                "upgrades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.PlayerUpgradeResponse"
+                    }
+                },
+                "wallet": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WalletResponse"
+                },
+                "wallet_ledger": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WalletLedgerEntryResponse"
+                    }
+                },
+                "weapons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.PlayerResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deletion_scheduled_at": {
+                    "description": "계정 삭제 예정 시각 (삭제 유예 중일 때만)",
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "embers": {
+                    "type": "integer"
+                },
+                "experience": {
+                    "type": "integer"
+                },
+                "gems": {
+                    "type": "integer"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_guest": {
+                    "type": "boolean"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "max_distance": {
+                    "type": "number"
+                },
+                "notification_preferences": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.NotificationPreferencesResponse"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "stage": {
+                    "description": "방치 사냥 중인 스테이지",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "total_kills": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.PlayerUpgradeResponse": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.RaidParticipationResponse": {
+            "type": "object",
+            "properties": {
+                "joined_at": {
+                    "type": "string"
+                },
+                "raid_session_id": {
+                    "type": "string"
+                },
+                "steps_contributed": {
+                    "type": "integer"
+                },
+                "total_damage": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.RejectedStepSampleResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "description": "현재 요청을 보낸 세션인지 여부",
+                    "type": "boolean"
+                },
+                "device_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_ip": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "description": "종료된 세션의 종료 시각",
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StatBonusesResponse": {
+            "type": "object",
+            "properties": {
+                "attack_power": {
+                    "type": "number"
+                },
+                "attack_speed": {
+                    "description": "0.1 = +10%",
+                    "type": "number"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepGoalClaimResponse": {
+            "type": "object",
+            "properties": {
+                "claimed_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "embers": {
+                    "type": "integer"
+                },
+                "goal_steps": {
+                    "type": "integer"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "steps": {
+                    "description": "수령 시점의 걸음 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepGoalClaimResultResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "description": "이번 요청으로 새로 수령한 목표 (이미 모두 수령했으면 빈 배열)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalClaimResponse"
+                    }
+                },
+                "embers": {
+                    "description": "이번 요청으로 지급한 활력의 불씨",
+                    "type": "integer"
+                },
+                "gold": {
+                    "description": "이번 요청으로 지급한 골드",
+                    "type": "integer"
+                },
+                "today": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalDayResponse"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepGoalDayResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "description": "목표 보상을 하나 이상 수령했는지 여부",
+                    "type": "boolean"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepGoalResponse"
+                    }
+                },
+                "steps": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepGoalResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "type": "boolean"
+                },
+                "claimed_at": {
+                    "type": "string"
+                },
+                "embers": {
+                    "type": "integer"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "reached": {
+                    "type": "boolean"
+                },
+                "steps": {
+                    "description": "목표 걸음 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepSampleRejectionResponse": {
+            "type": "object",
+            "properties": {
+                "ended_at": {
+                    "type": "string"
+                },
+                "index": {
+                    "description": "요청 내 샘플 순서 (0부터)",
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.StepSyncResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_samples": {
+                    "type": "integer"
+                },
+                "accepted_steps": {
+                    "type": "integer"
+                },
+                "days": {
+                    "description": "이번 동기화로 갱신된 날짜별 기록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.ActivityResponse"
+                    }
+                },
+                "duplicate_samples": {
+                    "description": "이미 반영되어 건너뛴 샘플 수",
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StepSampleRejectionResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.SuspensionResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "null이면 영구 정지",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issued_by_id": {
+                    "type": "integer"
+                },
+                "lift_reason": {
+                    "type": "string"
+                },
+                "lifted_at": {
+                    "type": "string"
+                },
+                "lifted_by_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.UpgradeNodeResponse": {
+            "type": "object",
+            "properties": {
+                "bonus_per_level": {
+                    "description": "0.02 = +2%",
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "locked": {
+                    "type": "boolean"
+                },
+                "max_level": {
+                    "type": "integer"
+                },
+                "maxed": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "next_cost": {
+                    "description": "다음 단계 비용 (활력의 불씨, 최대 단계면 0)",
+                    "type": "integer"
+                },
+                "requires": {
+                    "type": "string"
+                },
+                "requires_level": {
+                    "type": "integer"
+                },
+                "stat": {
+                    "description": "attack_speed, attack_power",
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.UpgradeTreeResponse": {
+            "type": "object",
+            "properties": {
+                "bonuses": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.StatBonusesResponse"
+                },
+                "embers": {
+                    "description": "현재 보유한 활력의 불씨",
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.UpgradeNodeResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WalletBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "gold, embers, gems, scrap",
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WalletLedgerEntryResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "지급은 양수, 차감은 음수",
+                    "type": "integer"
+                },
+                "balance_after": {
+                    "description": "반영 후 잔액",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "forge.craft, weapon.upgrade, step_goal, upgrade.purchase, admin.grant",
+                    "type": "string"
+                },
+                "ref_id": {
+                    "type": "string"
+                },
+                "ref_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WalletResponse": {
+            "type": "object",
+            "properties": {
+                "balances": {
+                    "description": "모든 재화 종류 (받은 적 없는 재화는 0)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WalletBalanceResponse"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WeaponDisposalResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "받은 재화 (판매는 gold, 분해는 scrap)",
+                    "type": "string"
+                },
+                "inventory": {
+                    "description": "처분 후 보관함 상태",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponInventoryResponse"
+                        }
+                    ]
+                },
+                "skipped": {
+                    "description": "등급 필터로 골랐지만 잠겼거나 장착 중이라 건너뛴 무기 수",
+                    "type": "integer"
+                },
+                "weapon_ids": {
+                    "description": "처분한 무기",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WeaponEffectResponse": {
+            "type": "object",
+            "properties": {
+                "magnitude": {
+                    "description": "발동 시 공격력 대비 추가 피해 비율",
+                    "type": "number"
+                },
+                "proc_chance": {
+                    "description": "공격마다 발동할 확률 (0~1)",
+                    "type": "number"
+                },
+                "type": {
+                    "description": "콘텐츠 특수 효과 ID (예: fire)",
+                    "type": "string"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WeaponInventoryResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "현재 보관 가능한 무기 수",
+                    "type": "integer"
+                },
+                "expand_cost": {
+                    "description": "다음 확장 비용 (보석, 최대 크기면 0)",
+                    "type": "integer"
+                },
+                "expand_step": {
+                    "description": "다음 확장으로 늘어나는 보관 수 (최대 크기면 0)",
+                    "type": "integer"
+                },
+                "max_capacity": {
+                    "description": "확장으로 늘릴 수 있는 최대 보관 수",
+                    "type": "integer"
+                },
+                "used": {
+                    "description": "보유한 무기 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WeaponPromotionResponse": {
+            "type": "object",
+            "properties": {
+                "consumed_weapon_ids": {
+                    "description": "소모한 재료 무기 (실패해도 소모)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "from_rarity": {
+                    "type": "string"
+                },
+                "gold_spent": {
+                    "type": "integer"
+                },
+                "guaranteed": {
+                    "description": "천장에 도달해 확정으로 성공했는지 여부",
+                    "type": "boolean"
+                },
+                "pity": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "success_rate": {
+                    "type": "number"
+                },
+                "weapon": {
+                    "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponResponse"
+                }
+            }
+        },
+        "game_eating_pizza_internal_api_dto.WeaponResponse": {
+            "type": "object",
+            "properties": {
+                "attack_power": {
                     "type": "integer"
                 },
+                "attack_speed": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "damage_per_second": {
+                    "description": "특수 효과의 기대 추가 피해까지 반영한 초당 피해량",
+                    "type": "number"
+                },
+                "effective_attack_power": {
+                    "description": "영구 강화 보너스를 적용한 능력치 (본인 무기를 조회할 때만 포함)",
+                    "type": "integer"
+                },
+                "effective_attack_speed": {
+                    "type": "number"
+                },
+                "effects": {
+                    "description": "특수 효과 (없으면 빈 목록)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/game_eating_pizza_internal_api_dto.WeaponEffectResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "locked": {
+                    "description": "잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없음",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "promotion_failures": {
+                    "description": "현재 등급에서 연속으로 실패한 승급 횟수 (천장 계산용)",
+                    "type": "integer"
+                },
+                "rarity": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_api_handlers.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "internal_api_handlers.ChangeRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "player",
+                        "operator",
+                        "admin"
+                    ]
+                }
+            }
+        },
+        "internal_api_handlers.DeleteAccountRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "정식 계정은 필수, 게스트 계정은 생략",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_api_handlers.DisposeWeaponsRequest": {
+            "type": "object",
+            "properties": {
+                "rarities": {
+                    "description": "이 등급의 무기를 모두 처분 (잠긴 무기와 장착 중인 무기는 건너뜀)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weapon_ids": {
+                    "description": "처분할 무기 (하나라도 잠겼거나 장착 중이면 전체 거부)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "internal_api_handlers.DungeonRequest": {
+            "type": "object",
+            "required": [
+                "difficulty",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "difficulty": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
                 "end_time": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
                 "is_active": {
+                    "description": "생략하면 true",
                     "type": "boolean"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "start_time": {
                     "type": "string"
                 },
                 "type": {
-                    "type": "string"
-                },
-                "updated_at": {
+                    "type": "string",
+                    "enum": [
+                        "normal",
+                        "event",
+                        "boss"
+                    ]
+                }
+            }
+        },
+        "internal_api_handlers.EnchantWeaponRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "type": {
+                    "description": "콘텐츠 특수 효과 ID (예: fire)",
                     "type": "string"
                 }
             }
         },
-        "game_eating_pizza_internal_api_dto.PlayerResponse": {
+        "internal_api_handlers.ForgotPasswordRequest": {
             "type": "object",
+            "required": [
+                "username"
+            ],
             "properties": {
-                "created_at": {
+                "username": {
                     "type": "string"
-                },
-                "experience": {
+                }
+            }
+        },
+        "internal_api_handlers.GrantCurrencyRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "currency",
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "음수면 회수",
                     "type": "integer"
                 },
-                "gold": {
-                    "type": "integer"
+                "currency": {
+                    "description": "gold, embers, gems, scrap",
+                    "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "internal_api_handlers.GrantExperienceRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "level": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "internal_api_handlers.GrantGoldRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "음수면 회수",
                     "type": "integer"
                 },
-                "max_distance": {
-                    "type": "number"
-                },
-                "total_kills": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "internal_api_handlers.GrantItemRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "item_id",
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "음수면 회수",
                     "type": "integer"
                 },
-                "updated_at": {
+                "item_id": {
                     "type": "string"
                 },
-                "username": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "game_eating_pizza_internal_api_dto.WeaponResponse": {
+        "internal_api_handlers.GrantWeaponRequest": {
             "type": "object",
+            "required": [
+                "attack_power",
+                "attack_speed",
+                "level",
+                "name",
+                "rarity",
+                "reason",
+                "type"
+            ],
             "properties": {
                 "attack_power": {
-                    "type": "integer"
+                    "type": "integer",
+                    "minimum": 1
                 },
                 "attack_speed": {
                     "type": "number"
                 },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
                 "level": {
-                    "type": "integer"
+                    "description": "현재 콘텐츠의 강화 비용 표가 다루는 단계까지 (gold_costs 길이 + 1)",
+                    "type": "integer",
+                    "minimum": 1
                 },
                 "name": {
-                    "type": "string"
-                },
-                "player_id": {
-                    "type": "integer"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "rarity": {
-                    "type": "string"
+                    "description": "현재 콘텐츠의 등급 표에 있는 등급",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "type": {
+                    "description": "현재 콘텐츠의 무기 템플릿에 있는 종류",
                     "type": "string"
+                }
+            }
+        },
+        "internal_api_handlers.GuestLoginRequest": {
+            "type": "object",
+            "required": [
+                "device_id"
+            ],
+            "properties": {
+                "device_id": {
+                    "description": "앱 설치 시 생성한 기기 고유 식별자 (UUID 등)",
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
                 },
-                "updated_at": {
-                    "type": "string"
+                "device_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "ios",
+                        "android",
+                        "watchos",
+                        "wearos",
+                        "web"
+                    ]
+                }
+            }
+        },
+        "internal_api_handlers.LiftSuspensionRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "internal_api_handlers.LinkAccountRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                }
+            }
+        },
+        "internal_api_handlers.LockWeaponRequest": {
+            "type": "object",
+            "required": [
+                "locked"
+            ],
+            "properties": {
+                "locked": {
+                    "type": "boolean"
                 }
             }
         },
@@ -840,14 +6277,70 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
+                "device_name": {
+                    "description": "예: \"Galaxy S24\", \"Apple Watch\"",
+                    "type": "string",
+                    "maxLength": 100
+                },
                 "password": {
                     "type": "string"
                 },
+                "platform": {
+                    "description": "기기 플랫폼",
+                    "type": "string",
+                    "enum": [
+                        "ios",
+                        "android",
+                        "watchos",
+                        "wearos",
+                        "web"
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "internal_api_handlers.NotificationPreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "boolean"
+                },
+                "forge_complete": {
+                    "type": "boolean"
+                },
+                "raid": {
+                    "type": "boolean"
+                },
+                "step_goal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "internal_api_handlers.PromoteWeaponRequest": {
+            "type": "object",
+            "properties": {
+                "material_ids": {
+                    "description": "소모할 같은 종류·등급 무기 (개수는 등급 표의 duplicates)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "internal_api_handlers.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
         "internal_api_handlers.RegisterRequest": {
             "type": "object",
             "required": [
@@ -865,6 +6358,137 @@ const docTemplate = `{
                     "minLength": 3
                 }
             }
+        },
+        "internal_api_handlers.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "new_password",
+                "username"
+            ],
+            "properties": {
+                "code": {
+                    "description": "알림으로 받은 재설정 코드 (대소문자, 하이픈 무시)",
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_api_handlers.StartForgeJobRequest": {
+            "type": "object",
+            "required": [
+                "recipe_id"
+            ],
+            "properties": {
+                "recipe_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_api_handlers.StepSampleRequest": {
+            "type": "object",
+            "required": [
+                "ended_at",
+                "started_at"
+            ],
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "internal_api_handlers.SuspendPlayerRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "생략하면 영구 정지",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "internal_api_handlers.SyncIdleRequest": {
+            "type": "object",
+            "properties": {
+                "stage": {
+                    "description": "정산 후 사냥할 스테이지 (0이거나 생략하면 현재 스테이지 유지)",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "internal_api_handlers.SyncStepsRequest": {
+            "type": "object",
+            "required": [
+                "samples"
+            ],
+            "properties": {
+                "samples": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/internal_api_handlers.StepSampleRequest"
+                    }
+                },
+                "source": {
+                    "description": "샘플을 보낸 기기/앱 (예: \"ios_healthkit\", \"watch\")",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "timezone": {
+                    "description": "기기의 현재 IANA 시간대 (보내면 플레이어 시간대를 갱신)",
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "internal_api_handlers.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "avatar_id": {
+                    "description": "예: \"knight_01\"",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "2-20자, 빈 문자열이면 표시 이름 삭제",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "ko, en, ja",
+                    "type": "string"
+                },
+                "notification_preferences": {
+                    "$ref": "#/definitions/internal_api_handlers.NotificationPreferencesRequest"
+                },
+                "timezone": {
+                    "description": "IANA 시간대 이름 (예: \"Asia/Seoul\"), 하루 걸음 수 집계 기준",
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -883,8 +6507,8 @@ var SwaggerInfo = &swag.Spec{
 	Host:             "localhost:8080",
 	BasePath:         "/api/v1",
 	Schemes:          []string{},
-	Title:            "Game Eating Pizza API",
-	Description:      "횡스크롤 방치형 게임 백엔드 API 서버",
+	Title:            "Tiny Breakers API",
+	Description:      "Tiny Breakers: The Beating World - 횡스크롤 방치형 게임 백엔드 API 서버 (걸음 수 연동, 멀티플레이 레이드 지원)",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // PlayerResponse는 플레이어 정보 응답 DTO입니다
 type PlayerResponse struct {
@@ -9,12 +12,31 @@ type PlayerResponse struct {
 	Level       int     `json:"level"`
 	Experience  int64   `json:"experience"`
 	Gold        int64   `json:"gold"`
+	Embers      int64   `json:"embers"`
+	Gems        int64   `json:"gems"`
 	MaxDistance float64 `json:"max_distance"`
 	TotalKills  int     `json:"total_kills"`
+	Stage       int     `json:"stage"` // 방치 사냥 중인 스테이지
+	IsGuest     bool    `json:"is_guest"`
+	Role        string  `json:"role"`
+	DisplayName string  `json:"display_name"`
+	AvatarID    string  `json:"avatar_id"`
+	Locale      string  `json:"locale"`
+	Timezone    string  `json:"timezone"`
+	NotificationPreferences NotificationPreferencesResponse `json:"notification_preferences"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"` // 계정 삭제 예정 시각 (삭제 유예 중일 때만)
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// NotificationPreferencesResponse는 알림 수신 설정 응답 DTO입니다
+type NotificationPreferencesResponse struct {
+	ForgeComplete bool `json:"forge_complete"`
+	StepGoal      bool `json:"step_goal"`
+	Raid          bool `json:"raid"`
+	Events        bool `json:"events"`
+}
+
 // WeaponResponse는 무기 정보 응답 DTO입니다
 type WeaponResponse struct {
 	ID          uint    `json:"id"`
@@ -27,6 +49,27 @@ type WeaponResponse struct {
 	Level       int     `json:"level"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	// 현재 등급에서 연속으로 실패한 승급 횟수 (천장 계산용)
+	PromotionFailures int `json:"promotion_failures"`
+
+	// 잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없음
+	Locked bool `json:"locked"`
+
+	// 특수 효과 (없으면 빈 목록)
+	Effects []WeaponEffectResponse `json:"effects"`
+
+	// 영구 강화 보너스를 적용한 능력치 (본인 무기를 조회할 때만 포함)
+	EffectiveAttackPower int     `json:"effective_attack_power,omitempty"`
+	EffectiveAttackSpeed float64 `json:"effective_attack_speed,omitempty"`
+	DamagePerSecond      float64 `json:"damage_per_second,omitempty"` // 특수 효과의 기대 추가 피해까지 반영한 초당 피해량
+}
+
+// WeaponEffectResponse는 무기 특수 효과 응답 DTO입니다
+type WeaponEffectResponse struct {
+	Type       string  `json:"type"`        // 콘텐츠 특수 효과 ID (예: fire)
+	Magnitude  float64 `json:"magnitude"`   // 발동 시 공격력 대비 추가 피해 비율
+	ProcChance float64 `json:"proc_chance"` // 공격마다 발동할 확률 (0~1)
 }
 
 // DungeonResponse는 던전 정보 응답 DTO입니다
@@ -41,3 +84,445 @@ type DungeonResponse struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 }
+
+// SessionResponse는 기기별 세션 정보 응답 DTO입니다
+type SessionResponse struct {
+	ID         uint       `json:"id"`
+	DeviceName string     `json:"device_name"`
+	Platform   string     `json:"platform"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	LastIP     string     `json:"last_ip"`
+	Current    bool       `json:"current"`              // 현재 요청을 보낸 세션인지 여부
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"` // 종료된 세션의 종료 시각
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ActivityResponse는 일일 활동(걸음 수) 기록 응답 DTO입니다
+type ActivityResponse struct {
+	Date         string    `json:"date"`
+	Timezone     string    `json:"timezone,omitempty"` // 날짜를 연 시점의 시간대
+	Steps        int       `json:"steps"`
+	Calories     float64   `json:"calories"`
+	BonusApplied bool      `json:"bonus_applied"`
+	LastSyncedAt time.Time `json:"last_synced_at"`
+}
+
+// RaidParticipationResponse는 레이드 참여 기록 응답 DTO입니다
+type RaidParticipationResponse struct {
+	RaidSessionID    string    `json:"raid_session_id"`
+	TotalDamage      uint64    `json:"total_damage"`
+	StepsContributed int       `json:"steps_contributed"`
+	JoinedAt         time.Time `json:"joined_at"`
+}
+
+// PlayerDataExportResponse는 플레이어 개인 데이터 내보내기 응답 DTO입니다
+type PlayerDataExportResponse struct {
+	ExportedAt         time.Time                    `json:"exported_at"`
+	Player             PlayerResponse               `json:"player"`
+	Weapons            []WeaponResponse             `json:"weapons"`
+	Sessions           []SessionResponse            `json:"sessions"`
+	StepHistory        []ActivityResponse           `json:"step_history"`
+	RaidParticipations []RaidParticipationResponse  `json:"raid_participations"`
+	Suspensions        []SuspensionResponse         `json:"suspensions"`
+	RejectedSteps      []RejectedStepSampleResponse `json:"rejected_step_samples"`
+	StepGoalClaims     []StepGoalClaimResponse      `json:"step_goal_claims"`
+	Wallet             WalletResponse               `json:"wallet"`
+	WalletLedger       []WalletLedgerEntryResponse  `json:"wallet_ledger"`
+	Upgrades           []PlayerUpgradeResponse      `json:"upgrades"`
+	Inventory          []InventoryItemResponse      `json:"inventory"`
+	DungeonUnlocks     []DungeonUnlockResponse      `json:"dungeon_unlocks"`
+	ForgeJobs          []ForgeJobResponse           `json:"forge_jobs"` // 수령·환불한 작업 포함
+}
+
+// SuspensionResponse는 이용 정지 응답 DTO입니다
+type SuspensionResponse struct {
+	ID         uint       `json:"id"`
+	PlayerID   uint       `json:"player_id"`
+	Reason     string     `json:"reason"`
+	IssuedByID uint       `json:"issued_by_id"`
+	ExpiresAt  *time.Time `json:"expires_at"` // null이면 영구 정지
+	Active     bool       `json:"active"`
+	LiftedAt   *time.Time `json:"lifted_at,omitempty"`
+	LiftedByID *uint      `json:"lifted_by_id,omitempty"`
+	LiftReason string     `json:"lift_reason,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// AdminPlayerResponse는 운영자용 플레이어 상세 응답 DTO입니다
+type AdminPlayerResponse struct {
+	PlayerResponse
+	ActiveSuspension *SuspensionResponse `json:"active_suspension,omitempty"` // 현재 유효한 이용 정지
+	SuspensionCount  int                 `json:"suspension_count"`            // 지금까지 받은 이용 정지 횟수
+}
+
+// AuditLogResponse는 감사 로그 응답 DTO입니다
+type AuditLogResponse struct {
+	ID         uint            `json:"id"`
+	ActorID    uint            `json:"actor_id"`
+	ActorRole  string          `json:"actor_role"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   uint            `json:"target_id"`
+	Details    json.RawMessage `json:"details" swaggertype:"object"`
+	IP         string          `json:"ip"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// ForgeRecipeResponse는 대장간 제작 레시피 응답 DTO입니다
+type ForgeRecipeResponse struct {
+	ID                     string                 `json:"id"`
+	Name                   string                 `json:"name"`
+	WeaponType             string                 `json:"weapon_type"`
+	GoldCost               int64                  `json:"gold_cost"`
+	DurationSeconds        int64                  `json:"duration_seconds"`         // 기본 제작 시간
+	BoostedDurationSeconds int64                  `json:"boosted_duration_seconds"` // 현재 걸음 수 부스트를 적용한 제작 시간
+	MinLevel               int                    `json:"min_level"`
+	AttackPowerMin         int                    `json:"attack_power_min"` // 가장 낮은 등급 기준 (높은 등급은 배율 적용)
+	AttackPowerMax         int                    `json:"attack_power_max"`
+	AttackSpeedMin         float64                `json:"attack_speed_min"`
+	AttackSpeedMax         float64                `json:"attack_speed_max"`
+	RarityChances          []RarityChanceResponse `json:"rarity_chances"`         // 기본 등급 확률
+	BoostedRarityChances   []RarityChanceResponse `json:"boosted_rarity_chances"` // 현재 걸음 수 부스트를 적용한 등급 확률
+}
+
+// ForgeBoostResponse는 걸음 수 대장간 부스트 응답 DTO입니다
+type ForgeBoostResponse struct {
+	Multiplier float64 `json:"multiplier"` // 1.0 = 부스트 없음
+	Steps      int     `json:"steps"`      // 부스트 산정에 사용한 걸음 수
+	Date       string  `json:"date"`       // 걸음 수 기록 날짜
+}
+
+// RarityChanceResponse는 제작 결과 등급 확률 응답 DTO입니다
+type RarityChanceResponse struct {
+	Rarity  string  `json:"rarity"`
+	Percent float64 `json:"percent"`
+}
+
+// ForgeJobResponse는 대장간 제작 작업 응답 DTO입니다
+type ForgeJobResponse struct {
+	ID               uint       `json:"id"`
+	RecipeID         string     `json:"recipe_id"`
+	Status           string     `json:"status"` // crafting, ready, claimed, refunded
+	GoldCost         int64      `json:"gold_cost"`
+	ForgeBoost       float64    `json:"forge_boost"` // 시작 시점에 고정된 걸음 수 부스트 배율
+	BoostSteps       int        `json:"boost_steps"` // 부스트 산정에 사용한 걸음 수
+	StartedAt        time.Time  `json:"started_at"`
+	CompletesAt      time.Time  `json:"completes_at"`
+	RemainingSeconds int64      `json:"remaining_seconds"`
+	ClaimedAt        *time.Time `json:"claimed_at,omitempty"`
+	WeaponID         *uint      `json:"weapon_id,omitempty"`
+}
+
+// StepSyncResponse는 걸음 수 동기화 결과 응답 DTO입니다
+type StepSyncResponse struct {
+	AcceptedSamples  int                           `json:"accepted_samples"`
+	AcceptedSteps    int                           `json:"accepted_steps"`
+	DuplicateSamples int                           `json:"duplicate_samples"` // 이미 반영되어 건너뛴 샘플 수
+	Rejected         []StepSampleRejectionResponse `json:"rejected"`
+	Days             []ActivityResponse            `json:"days"` // 이번 동기화로 갱신된 날짜별 기록
+}
+
+// StepSampleRejectionResponse는 동기화 요청에서 거부된 샘플 응답 DTO입니다
+type StepSampleRejectionResponse struct {
+	Index     int       `json:"index"` // 요청 내 샘플 순서 (0부터)
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Steps     int       `json:"steps"`
+	Reason    string    `json:"reason"`
+}
+
+// RejectedStepSampleResponse는 운영자용 거부된 걸음 수 샘플 응답 DTO입니다
+type RejectedStepSampleResponse struct {
+	ID        uint      `json:"id"`
+	PlayerID  uint      `json:"player_id"`
+	Source    string    `json:"source,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Steps     int       `json:"steps"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StepGoalResponse는 하루 걸음 수 목표 한 단계의 상태 응답 DTO입니다
+type StepGoalResponse struct {
+	Steps     int        `json:"steps"` // 목표 걸음 수
+	Gold      int64      `json:"gold"`
+	Embers    int64      `json:"embers"`
+	Reached   bool       `json:"reached"`
+	Claimed   bool       `json:"claimed"`
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+}
+
+// StepGoalDayResponse는 하루의 걸음 수와 목표 단계별 상태 응답 DTO입니다
+type StepGoalDayResponse struct {
+	Date     string             `json:"date"`
+	Timezone string             `json:"timezone,omitempty"`
+	Steps    int                `json:"steps"`
+	Claimed  bool               `json:"claimed"` // 목표 보상을 하나 이상 수령했는지 여부
+	Goals    []StepGoalResponse `json:"goals"`
+}
+
+// StepGoalClaimResponse는 목표 보상 수령 기록 응답 DTO입니다
+type StepGoalClaimResponse struct {
+	Date      string    `json:"date"`
+	GoalSteps int       `json:"goal_steps"`
+	Steps     int       `json:"steps"` // 수령 시점의 걸음 수
+	Gold      int64     `json:"gold"`
+	Embers    int64     `json:"embers"`
+	ClaimedAt time.Time `json:"claimed_at"`
+}
+
+// StepGoalClaimResultResponse는 오늘 목표 보상 수령 결과 응답 DTO입니다
+type StepGoalClaimResultResponse struct {
+	Claimed []StepGoalClaimResponse `json:"claimed"` // 이번 요청으로 새로 수령한 목표 (이미 모두 수령했으면 빈 배열)
+	Gold    int64                   `json:"gold"`    // 이번 요청으로 지급한 골드
+	Embers  int64                   `json:"embers"`  // 이번 요청으로 지급한 활력의 불씨
+	Today   StepGoalDayResponse     `json:"today"`
+}
+
+// ActivityStatsDayResponse는 통계의 하루 걸음 수와 소모 칼로리 응답 DTO입니다
+type ActivityStatsDayResponse struct {
+	Date     string  `json:"date"`
+	Steps    int     `json:"steps"`
+	Calories float64 `json:"calories"`
+}
+
+// ActivityStatsResponse는 기간별 활동 통계 응답 DTO입니다
+type ActivityStatsResponse struct {
+	Range           string                     `json:"range"` // week, month, year
+	From            string                     `json:"from"`  // 조회 시작 날짜 (포함)
+	To              string                     `json:"to"`    // 오늘 (포함)
+	Days            []ActivityStatsDayResponse `json:"days"`  // 날짜별 기록 (기록이 없는 날은 0)
+	ActiveDays      int                        `json:"active_days"`
+	TotalSteps      int64                      `json:"total_steps"`
+	TotalCalories   float64                    `json:"total_calories"`
+	AverageSteps    float64                    `json:"average_steps"` // 기간 전체 날짜 수로 나눈 하루 평균
+	AverageCalories float64                    `json:"average_calories"`
+	MaxSteps        int                        `json:"max_steps"` // 기간 내 하루 최대
+	MaxCalories     float64                    `json:"max_calories"`
+	Streak          ActivityStreakResponse     `json:"streak"`
+	PersonalBests   ActivityBestsResponse      `json:"personal_bests"`
+}
+
+// ActivityStreakResponse는 연속 달성 일수 응답 DTO입니다
+type ActivityStreakResponse struct {
+	GoalSteps int `json:"goal_steps"` // 하루 이 걸음 수 이상이면 달성
+	Current   int `json:"current"`    // 오늘 또는 어제까지 이어지는 연속 일수
+	Longest   int `json:"longest"`
+}
+
+// ActivityBestsResponse는 전체 기간 개인 최고 기록 응답 DTO입니다
+type ActivityBestsResponse struct {
+	Steps    *ActivityStatsDayResponse `json:"steps"`    // 걸음 수가 가장 많은 날 (기록이 없으면 null)
+	Calories *ActivityStatsDayResponse `json:"calories"` // 소모 칼로리가 가장 많은 날
+}
+
+// WalletBalanceResponse는 재화 한 종류의 잔액 응답 DTO입니다
+type WalletBalanceResponse struct {
+	Currency string `json:"currency"` // gold, embers, gems, scrap
+	Balance  int64  `json:"balance"`
+}
+
+// WalletResponse는 플레이어 지갑 응답 DTO입니다
+type WalletResponse struct {
+	Balances []WalletBalanceResponse `json:"balances"` // 모든 재화 종류 (받은 적 없는 재화는 0)
+}
+
+// WalletLedgerEntryResponse는 재화 원장 항목 응답 DTO입니다
+type WalletLedgerEntryResponse struct {
+	ID           uint      `json:"id"`
+	Currency     string    `json:"currency"`
+	Amount       int64     `json:"amount"`        // 지급은 양수, 차감은 음수
+	BalanceAfter int64     `json:"balance_after"` // 반영 후 잔액
+	Reason       string    `json:"reason"`        // forge.craft, weapon.upgrade, step_goal, upgrade.purchase, admin.grant
+	RefType      string    `json:"ref_type,omitempty"`
+	RefID        string    `json:"ref_id,omitempty"`
+	Note         string    `json:"note,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// UpgradeNodeResponse는 영구 강화 노드 응답 DTO입니다
+type UpgradeNodeResponse struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description"`
+	Stat          string  `json:"stat"`            // attack_speed, attack_power
+	BonusPerLevel float64 `json:"bonus_per_level"` // 0.02 = +2%
+	Level         int     `json:"level"`
+	MaxLevel      int     `json:"max_level"`
+	NextCost      int64   `json:"next_cost"` // 다음 단계 비용 (활력의 불씨, 최대 단계면 0)
+	Requires      string  `json:"requires,omitempty"`
+	RequiresLevel int     `json:"requires_level,omitempty"`
+	Locked        bool    `json:"locked"`
+	Maxed         bool    `json:"maxed"`
+}
+
+// StatBonusesResponse는 영구 강화 능력치 보너스 합계 응답 DTO입니다
+type StatBonusesResponse struct {
+	AttackSpeed float64 `json:"attack_speed"` // 0.1 = +10%
+	AttackPower float64 `json:"attack_power"`
+}
+
+// UpgradeTreeResponse는 영구 강화 트리 응답 DTO입니다
+type UpgradeTreeResponse struct {
+	Nodes   []UpgradeNodeResponse `json:"nodes"`
+	Bonuses StatBonusesResponse   `json:"bonuses"`
+	Embers  int64                 `json:"embers"` // 현재 보유한 활력의 불씨
+}
+
+// PlayerUpgradeResponse는 구매한 영구 강화 단계 응답 DTO입니다
+type PlayerUpgradeResponse struct {
+	NodeID    string    `json:"node_id"`
+	Level     int       `json:"level"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RarityTierResponse는 무기 등급과 이 등급으로 승급하는 조건 응답 DTO입니다
+type RarityTierResponse struct {
+	Rarity            string  `json:"rarity"`
+	StatMultiplier    float64 `json:"stat_multiplier"`        // 가장 낮은 등급 대비 공격력 배율
+	SellPrice         int64   `json:"sell_price"`             // 판매 기본 가격 (골드, 강화 단계에 따라 더해짐)
+	DismantleScrap    int64   `json:"dismantle_scrap"`        // 분해 기본 파편 수 (강화 단계에 따라 더해짐)
+	EffectSlots       int     `json:"effect_slots"`           // 붙일 수 있는 특수 효과 수
+	ForgeEffectChance float64 `json:"forge_effect_chance"`    // 대장간에서 제작할 때 무작위 특수 효과가 붙을 확률
+	SuccessRate       float64 `json:"success_rate,omitempty"` // 이 등급으로 승급할 때의 성공 확률 (가장 낮은 등급은 생략)
+	Pity              int     `json:"pity,omitempty"`         // 연속 실패가 이 횟수에 이르면 다음 시도는 확정 성공
+	Duplicates        int     `json:"duplicates,omitempty"`   // 소모하는 같은 종류·등급 무기 수
+	GoldCost          int64   `json:"gold_cost,omitempty"`
+}
+
+// WeaponPromotionResponse는 무기 등급 승급 결과 응답 DTO입니다
+type WeaponPromotionResponse struct {
+	Success     bool           `json:"success"`
+	Guaranteed  bool           `json:"guaranteed"` // 천장에 도달해 확정으로 성공했는지 여부
+	FromRarity  string         `json:"from_rarity"`
+	SuccessRate float64        `json:"success_rate"`
+	Pity        int            `json:"pity"`
+	ConsumedIDs []uint         `json:"consumed_weapon_ids"` // 소모한 재료 무기 (실패해도 소모)
+	GoldSpent   int64          `json:"gold_spent"`
+	Weapon      WeaponResponse `json:"weapon"`
+}
+
+// WeaponInventoryResponse는 무기 보관함 상태 응답 DTO입니다
+type WeaponInventoryResponse struct {
+	Used        int   `json:"used"`         // 보유한 무기 수
+	Capacity    int   `json:"capacity"`     // 현재 보관 가능한 무기 수
+	MaxCapacity int   `json:"max_capacity"` // 확장으로 늘릴 수 있는 최대 보관 수
+	ExpandStep  int   `json:"expand_step"`  // 다음 확장으로 늘어나는 보관 수 (최대 크기면 0)
+	ExpandCost  int64 `json:"expand_cost"`  // 다음 확장 비용 (보석, 최대 크기면 0)
+}
+
+// WeaponDisposalResponse는 무기 판매·분해 결과 응답 DTO입니다
+type WeaponDisposalResponse struct {
+	WeaponIDs []uint                  `json:"weapon_ids"` // 처분한 무기
+	Skipped   int                     `json:"skipped"`    // 등급 필터로 골랐지만 잠겼거나 장착 중이라 건너뛴 무기 수
+	Currency  string                  `json:"currency"`   // 받은 재화 (판매는 gold, 분해는 scrap)
+	Amount    int64                   `json:"amount"`
+	Inventory WeaponInventoryResponse `json:"inventory"` // 처분 후 보관함 상태
+}
+
+// ItemDefinitionResponse는 재료·소모품 아이템 정의 응답 DTO입니다
+type ItemDefinitionResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"` // ore, gem_shard, scroll
+	Description string `json:"description"`
+	MaxStack    int64  `json:"max_stack"` // 최대 보유 수량
+}
+
+// InventoryItemResponse는 보유 아이템 응답 DTO입니다
+type InventoryItemResponse struct {
+	ItemID    string    `json:"item_id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Quantity  int64     `json:"quantity"`
+	MaxStack  int64     `json:"max_stack"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ContentVersionResponse는 현재 적용 중인 게임 콘텐츠 버전 응답 DTO입니다
+type ContentVersionResponse struct {
+	Version  string    `json:"version"`
+	LoadedAt time.Time `json:"loaded_at"` // 서버가 이 버전을 불러온 시각
+}
+
+// ItemRewardResponse는 보상으로 지급하는 아이템 응답 DTO입니다
+type ItemRewardResponse struct {
+	ItemID   string `json:"item_id"`
+	Quantity int64  `json:"quantity"`
+}
+
+// LevelRewardResponse는 레벨 도달 보상 응답 DTO입니다
+type LevelRewardResponse struct {
+	Gold     int64                `json:"gold"`
+	Items    []ItemRewardResponse `json:"items"`
+	Dungeons []uint               `json:"dungeons"` // 해금하는 던전 ID
+}
+
+// LevelResponse는 레벨 하나의 필요 경험치와 도달 보상 응답 DTO입니다
+type LevelResponse struct {
+	Level     int                  `json:"level"`
+	ExpToNext int64                `json:"exp_to_next"`      // 다음 레벨까지 필요한 경험치 (최고 레벨이면 0)
+	Reward    *LevelRewardResponse `json:"reward,omitempty"` // 이 레벨에 도달할 때 받는 보상
+}
+
+// LevelTableResponse는 레벨 표 응답 DTO입니다
+type LevelTableResponse struct {
+	MaxLevel int             `json:"max_level"`
+	Levels   []LevelResponse `json:"levels"`
+}
+
+// DungeonUnlockResponse는 레벨업 보상으로 해금한 던전 응답 DTO입니다
+type DungeonUnlockResponse struct {
+	DungeonID  uint      `json:"dungeon_id"`
+	Level      int       `json:"level"` // 해금 보상을 받은 레벨
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// LevelProgressResponse는 플레이어 레벨 진행 상황 응답 DTO입니다
+type LevelProgressResponse struct {
+	Level            int                     `json:"level"`
+	MaxLevel         int                     `json:"max_level"`
+	Experience       int64                   `json:"experience"`
+	ExpToNext        int64                   `json:"exp_to_next"`           // 다음 레벨까지 필요한 경험치 (최고 레벨이면 0)
+	NextReward       *LevelResponse          `json:"next_reward,omitempty"` // 다음으로 보상을 받는 레벨
+	UnlockedDungeons []DungeonUnlockResponse `json:"unlocked_dungeons"`
+}
+
+// LevelUpEventResponse는 레벨업 한 번과 그 레벨에서 받은 보상 응답 DTO입니다 (클라이언트 레벨업 연출용)
+type LevelUpEventResponse struct {
+	Level    int                  `json:"level"`
+	Gold     int64                `json:"gold"`
+	Items    []ItemRewardResponse `json:"items"`
+	Dungeons []uint               `json:"dungeons"`
+}
+
+// ExperienceGrantResponse는 경험치 지급 결과 응답 DTO입니다
+type ExperienceGrantResponse struct {
+	PlayerID   uint                   `json:"player_id"`
+	Amount     int64                  `json:"amount"`
+	Level      int                    `json:"level"`
+	Experience int64                  `json:"experience"`
+	LevelUps   []LevelUpEventResponse `json:"level_ups"` // 오른 레벨마다 하나씩, 낮은 레벨부터
+}
+
+// IdleSyncResponse는 방치 사냥 정산 결과 응답 DTO입니다 (클라이언트 "다시 오신 것을 환영합니다" 화면용)
+type IdleSyncResponse struct {
+	LastSyncedAt    *time.Time             `json:"last_synced_at,omitempty"` // 직전 정산 시각 (처음 정산하면 생략)
+	SyncedAt        time.Time              `json:"synced_at"`                // 새 정산 시각 (처치 하나를 채우지 못한 남은 시간은 다음 정산으로 넘어감)
+	ElapsedSeconds  int64                  `json:"elapsed_seconds"`          // 직전 정산 이후 흐른 시간
+	CreditedSeconds int64                  `json:"credited_seconds"`         // 그중 인정한 시간 (최대 정산 시간까지)
+	Capped          bool                   `json:"capped"`                   // 최대 정산 시간을 넘어 버린 시간이 있는지
+	Stage           int                    `json:"stage"`                    // 정산에 쓴 스테이지
+	NextStage       int                    `json:"next_stage"`               // 앞으로 사냥할 스테이지
+	StageKills      int                    `json:"stage_kills"`              // 앞으로 사냥할 스테이지에서 처치한 몬스터 수
+	ClearKills      int                    `json:"clear_kills"`              // 다음 스테이지로 올라가는 데 필요한 처치 수
+	WeaponID        *uint                  `json:"weapon_id,omitempty"`      // 정산에 쓴 무기 (장착한 무기가 없으면 생략)
+	DamagePerSecond float64                `json:"damage_per_second"`
+	Kills           int                    `json:"kills"`
+	Gold            int64                  `json:"gold"`
+	Experience      int64                  `json:"experience"`
+	Level           int                    `json:"level"` // 반영 후 레벨
+	TotalKills      int                    `json:"total_kills"`
+	LevelUps        []LevelUpEventResponse `json:"level_ups"` // 처치 경험치로 오른 레벨마다 하나씩, 낮은 레벨부터
+}
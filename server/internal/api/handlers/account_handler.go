@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountHandler는 계정 삭제와 개인 데이터 내보내기 핸들러입니다
+type AccountHandler struct {
+	accountService *services.AccountService
+}
+
+// NewAccountHandler는 새로운 AccountHandler를 생성합니다
+func NewAccountHandler(accountService *services.AccountService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+	}
+}
+
+// DeleteAccountRequest는 계정 삭제 요청 구조체입니다
+type DeleteAccountRequest struct {
+	Password string `json:"password"` // 정식 계정은 필수, 게스트 계정은 생략
+}
+
+// DeleteMe 계정 삭제
+// @Summary      계정 삭제
+// @Description  계정 삭제를 예약하고 모든 기기에서 로그아웃합니다. 유예 기간 내에 다시 로그인하면 삭제가 취소되며, 유예 기간이 지나면 모든 데이터가 완전 삭제됩니다
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      DeleteAccountRequest  false  "비밀번호 확인 (정식 계정만)"
+// @Success      202      {object}  map[string]interface{}  "삭제 예약됨"
+// @Failure      400      {object}  map[string]interface{}  "비밀번호 불일치"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "이미 삭제가 예약됨"
+// @Failure      429      {object}  map[string]interface{}  "비밀번호 확인 시도 횟수 초과 (Retry-After 헤더 참고)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me [delete]
+func (h *AccountHandler) DeleteMe(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	// 게스트 계정은 본문 없이 요청할 수 있으므로 빈 본문을 허용합니다
+	var req DeleteAccountRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	scheduledAt, err := h.accountService.RequestDeletion(playerID, req.Password, c.ClientIP())
+	if respondLoginLocked(c, err) {
+		return
+	}
+	switch {
+	case err == nil:
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":               "Account deletion scheduled, log in again before the scheduled time to cancel",
+			"deletion_scheduled_at": scheduledAt,
+		})
+	case errors.Is(err, services.ErrIncorrectPassword):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Password is incorrect",
+			"code":  "PASSWORD_INCORRECT",
+		})
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+	case errors.Is(err, services.ErrDeletionAlreadyScheduled):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Account deletion already scheduled",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to delete account",
+			"details": err.Error(),
+		})
+	}
+}
+
+// ExportMe 개인 데이터 내보내기
+// @Summary      개인 데이터 내보내기
+// @Description  현재 플레이어에 대해 저장된 모든 데이터(프로필, 무기, 로그인 기기, 걸음 수 기록, 레이드 참여 기록, 이용 정지 이력, 거부된 걸음 수 샘플, 걸음 수 목표 보상 수령 기록)을 JSON 파일로 내려받습니다
+// @Tags         players
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  dto.PlayerDataExportResponse  "개인 데이터"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me/export [get]
+func (h *AccountHandler) ExportMe(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	archive, err := h.accountService.ExportData(playerID)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to export data",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// DTO로 변환
+	response := dto.PlayerDataExportResponse{
+		ExportedAt:         archive.ExportedAt,
+		Player:             newPlayerResponse(archive.Player),
+		Weapons:            make([]dto.WeaponResponse, len(archive.Weapons)),
+		Sessions:           make([]dto.SessionResponse, len(archive.Sessions)),
+		StepHistory:        make([]dto.ActivityResponse, len(archive.Activities)),
+		RaidParticipations: make([]dto.RaidParticipationResponse, len(archive.RaidParticipations)),
+		Suspensions:        newSuspensionResponses(archive.Suspensions),
+		RejectedSteps:      newRejectedStepSampleResponses(archive.RejectedSteps),
+		StepGoalClaims:     newStepGoalClaimResponses(archive.StepGoalClaims),
+		Wallet:             newWalletResponse(archive.Wallet),
+		WalletLedger:       newWalletLedgerEntryResponses(archive.WalletLedger),
+		Upgrades:           make([]dto.PlayerUpgradeResponse, len(archive.Upgrades)),
+		Inventory:          newInventoryItemResponses(archive.Inventory),
+		DungeonUnlocks:     newDungeonUnlockResponses(archive.DungeonUnlocks),
+		ForgeJobs:          make([]dto.ForgeJobResponse, len(archive.ForgeJobs)),
+	}
+	for i := range archive.ForgeJobs {
+		response.ForgeJobs[i] = newForgeJobResponse(&archive.ForgeJobs[i], archive.ExportedAt)
+	}
+	for i, upgrade := range archive.Upgrades {
+		response.Upgrades[i] = dto.PlayerUpgradeResponse{
+			NodeID:    upgrade.NodeID,
+			Level:     upgrade.Level,
+			UpdatedAt: upgrade.UpdatedAt,
+		}
+	}
+	for i := range archive.Weapons {
+		response.Weapons[i] = newWeaponResponse(&archive.Weapons[i])
+	}
+	for i, session := range archive.Sessions {
+		response.Sessions[i] = dto.SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			Platform:   session.Platform,
+			LastSeenAt: session.LastSeenAt,
+			LastIP:     session.LastIP,
+			RevokedAt:  session.RevokedAt,
+			CreatedAt:  session.CreatedAt,
+		}
+	}
+	for i := range archive.Activities {
+		response.StepHistory[i] = newActivityResponse(&archive.Activities[i])
+	}
+	for i, participant := range archive.RaidParticipations {
+		response.RaidParticipations[i] = dto.RaidParticipationResponse{
+			RaidSessionID:    participant.RaidSessionID,
+			TotalDamage:      participant.TotalDamage,
+			StepsContributed: participant.StepsContributed,
+			JoinedAt:         participant.JoinedAt,
+		}
+	}
+
+	filename := fmt.Sprintf("player-%d-export-%s.json", playerID, archive.ExportedAt.Format("20060102"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.JSON(http.StatusOK, response)
+}
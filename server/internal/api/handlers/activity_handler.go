@@ -0,0 +1,418 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/services"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ActivityHandler는 걸음 수 동기화, 일일 걸음 수 목표, 활동 통계 관련 핸들러입니다
+type ActivityHandler struct {
+	activityService *services.ActivityService
+}
+
+// NewActivityHandler는 새로운 ActivityHandler를 생성합니다
+func NewActivityHandler(activityService *services.ActivityService) *ActivityHandler {
+	return &ActivityHandler{
+		activityService: activityService,
+	}
+}
+
+// StepSampleRequest는 기기가 측정한 구간별 걸음 수 샘플입니다
+type StepSampleRequest struct {
+	StartedAt time.Time `json:"started_at" binding:"required"`
+	EndedAt   time.Time `json:"ended_at" binding:"required"`
+	Steps     int       `json:"steps" binding:"min=0"`
+	Calories  float64   `json:"calories" binding:"min=0"`
+}
+
+// SyncStepsRequest는 걸음 수 동기화 요청 구조체입니다
+type SyncStepsRequest struct {
+	Source   string              `json:"source" binding:"max=50"`   // 샘플을 보낸 기기/앱 (예: "ios_healthkit", "watch")
+	Timezone string              `json:"timezone" binding:"max=64"` // 기기의 현재 IANA 시간대 (보내면 플레이어 시간대를 갱신)
+	Samples  []StepSampleRequest `json:"samples" binding:"required,min=1,dive"`
+}
+
+// SyncSteps 걸음 수 동기화
+// @Summary      걸음 수 동기화
+// @Description  휴대폰/워치가 측정한 구간별 걸음 수 샘플을 시간순으로 모아 보내면 플레이어 시간대 기준 날짜별 활동 기록에 더합니다. timezone을 함께 보내면 플레이어 시간대를 갱신하며, 시간대를 바꿔도 이미 지난 날짜가 다시 열리지 않습니다. 미래 시각, 동기화 허용 기간보다 오래된 샘플, 시간순이 아니거나 이미 반영된 구간과 겹치는 샘플, 초당 걸음 수나 하루 최대 걸음 수를 넘는 샘플은 반영하지 않고 검토용으로 기록합니다. 이미 반영된 샘플을 다시 보내면 건너뜁니다
+// @Tags         activity
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      SyncStepsRequest      true  "걸음 수 샘플"
+// @Success      200      {object}  dto.StepSyncResponse  "동기화 결과 (거부된 샘플 포함)"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "다른 동기화 요청과 충돌 (다시 시도)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /activity/steps [post]
+func (h *ActivityHandler) SyncSteps(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	var req SyncStepsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	samples := make([]services.StepSample, len(req.Samples))
+	for i, sample := range req.Samples {
+		samples[i] = services.StepSample{
+			StartedAt: sample.StartedAt,
+			EndedAt:   sample.EndedAt,
+			Steps:     sample.Steps,
+			Calories:  sample.Calories,
+		}
+	}
+
+	result, err := h.activityService.SyncSteps(playerID, req.Source, req.Timezone, samples)
+	var tooManyErr *services.TooManyStepSamplesError
+	switch {
+	case errors.As(err, &tooManyErr):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":       "Too many step samples",
+			"code":        "TOO_MANY_SAMPLES",
+			"max_samples": tooManyErr.Max,
+		})
+		return
+	case errors.Is(err, services.ErrInvalidTimezone):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Timezone must be an IANA time zone name such as Asia/Seoul",
+			"code":  "INVALID_TIMEZONE",
+		})
+		return
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	case errors.Is(err, services.ErrNoStepSamples):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "No step samples",
+		})
+		return
+	case errors.Is(err, services.ErrStepSyncConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Step samples were applied by another sync, retry",
+			"code":  "STEP_SYNC_CONFLICT",
+		})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to sync steps",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response := dto.StepSyncResponse{
+		AcceptedSamples:  result.AcceptedSamples,
+		AcceptedSteps:    result.AcceptedSteps,
+		DuplicateSamples: result.DuplicateSamples,
+		Rejected:         make([]dto.StepSampleRejectionResponse, len(result.Rejected)),
+		Days:             make([]dto.ActivityResponse, len(result.Days)),
+	}
+	for i, rejected := range result.Rejected {
+		response.Rejected[i] = dto.StepSampleRejectionResponse{
+			Index:     rejected.Index,
+			StartedAt: rejected.Sample.StartedAt,
+			EndedAt:   rejected.Sample.EndedAt,
+			Steps:     rejected.Sample.Steps,
+			Reason:    rejected.Reason,
+		}
+	}
+	for i := range result.Days {
+		response.Days[i] = newActivityResponse(&result.Days[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// newActivityResponse는 일일 활동 기록을 응답 DTO로 변환합니다
+func newActivityResponse(activity *models.UserActivity) dto.ActivityResponse {
+	return dto.ActivityResponse{
+		Date:         activity.Date,
+		Timezone:     activity.Timezone,
+		Steps:        activity.Steps,
+		Calories:     activity.Calories,
+		BonusApplied: activity.BonusApplied,
+		LastSyncedAt: activity.LastSyncedAt,
+	}
+}
+
+// GetToday 오늘의 걸음 수 목표
+// @Summary      오늘의 걸음 수 목표
+// @Description  플레이어 시간대 기준 오늘의 걸음 수와 목표 단계별(예: 3천/5천/1만 보) 보상, 달성 여부, 수령 여부를 조회합니다
+// @Tags         activity
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  dto.StepGoalDayResponse  "오늘의 목표 상태"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /activity/today [get]
+func (h *ActivityHandler) GetToday(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	day, err := h.activityService.GetTodayGoals(playerID)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get today's step goals",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newStepGoalDayResponse(day))
+}
+
+// ClaimToday 오늘의 걸음 수 목표 보상 수령
+// @Summary      오늘의 걸음 수 목표 보상 수령
+// @Description  오늘 달성했지만 아직 받지 않은 목표 단계의 골드와 활력의 불씨를 한 번에 수령합니다. 목표 단계마다 하루에 한 번만 지급되며, 다시 요청하면 claimed가 빈 배열로 반환됩니다. 지난 날짜의 보상은 수령할 수 없습니다
+// @Tags         activity
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  dto.StepGoalClaimResultResponse  "수령 결과"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /activity/today/claim [post]
+func (h *ActivityHandler) ClaimToday(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	result, err := h.activityService.ClaimTodayGoals(playerID)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to claim step goal rewards",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.StepGoalClaimResultResponse{
+		Claimed: newStepGoalClaimResponses(result.Claimed),
+		Gold:    result.Gold,
+		Embers:  result.Embers,
+		Today:   newStepGoalDayResponse(&result.Day),
+	})
+}
+
+// newStepGoalClaimResponses는 목표 보상 수령 기록 목록을 응답 DTO로 변환합니다
+func newStepGoalClaimResponses(claims []models.StepGoalClaim) []dto.StepGoalClaimResponse {
+	responses := make([]dto.StepGoalClaimResponse, len(claims))
+	for i, claim := range claims {
+		responses[i] = dto.StepGoalClaimResponse{
+			Date:      claim.Date,
+			GoalSteps: claim.GoalSteps,
+			Steps:     claim.Steps,
+			Gold:      claim.Gold,
+			Embers:    claim.Embers,
+			ClaimedAt: claim.ClaimedAt,
+		}
+	}
+	return responses
+}
+
+// GetHistory 걸음 수 목표 기록
+// @Summary      걸음 수 목표 기록
+// @Description  걸음 수 기록이 있는 최근 날짜들의 목표 단계별 달성 여부와 수령 여부를 최신 날짜부터 조회합니다
+// @Tags         activity
+// @Produce      json
+// @Security     BearerAuth
+// @Param        days     query     int  false  "조회할 날짜 수 (기본 30, 최대 90)"
+// @Success      200      {object}  map[string]interface{}  "날짜별 목표 상태 (days)"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /activity/history [get]
+func (h *ActivityHandler) GetHistory(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 {
+		days = 30
+	}
+	if days > 90 {
+		days = 90
+	}
+
+	history, err := h.activityService.GetGoalHistory(playerID, days)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get step goal history",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response := make([]dto.StepGoalDayResponse, len(history))
+	for i := range history {
+		response[i] = newStepGoalDayResponse(&history[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"days": response,
+	})
+}
+
+// GetStats 활동 통계
+// @Summary      활동 통계
+// @Description  플레이어 시간대 기준 오늘을 포함한 최근 7일(week), 30일(month), 365일(year)의 날짜별 걸음 수와 칼로리, 합계와 하루 평균, 기간 내 최고 기록, 연속 달성 일수(가장 낮은 일일 목표 이상), 전체 기간 개인 최고 기록을 조회합니다
+// @Tags         activity
+// @Produce      json
+// @Security     BearerAuth
+// @Param        range    query     string  false  "조회 범위 (week, month, year / 기본 week)"
+// @Success      200      {object}  dto.ActivityStatsResponse  "활동 통계"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 조회 범위"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /activity/stats [get]
+func (h *ActivityHandler) GetStats(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	stats, err := h.activityService.GetStats(playerID, c.DefaultQuery("range", services.ActivityStatsRangeWeek))
+	switch {
+	case errors.Is(err, services.ErrInvalidStatsRange):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Range must be one of week, month, year",
+			"code":  "INVALID_RANGE",
+		})
+		return
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get activity stats",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response := dto.ActivityStatsResponse{
+		Range:           stats.Range,
+		From:            stats.From,
+		To:              stats.To,
+		Days:            make([]dto.ActivityStatsDayResponse, len(stats.Days)),
+		ActiveDays:      stats.ActiveDays,
+		TotalSteps:      stats.TotalSteps,
+		TotalCalories:   stats.TotalCalories,
+		AverageSteps:    stats.AverageSteps,
+		AverageCalories: stats.AverageCalories,
+		MaxSteps:        stats.MaxSteps,
+		MaxCalories:     stats.MaxCalories,
+		Streak: dto.ActivityStreakResponse{
+			GoalSteps: stats.StreakGoalSteps,
+			Current:   stats.CurrentStreak,
+			Longest:   stats.LongestStreak,
+		},
+		PersonalBests: dto.ActivityBestsResponse{
+			Steps:    newActivityStatsDayResponse(stats.BestStepsDay),
+			Calories: newActivityStatsDayResponse(stats.BestCaloriesDay),
+		},
+	}
+	for i := range stats.Days {
+		response.Days[i] = *newActivityStatsDayResponse(&stats.Days[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// newActivityStatsDayResponse는 통계의 하루 기록을 응답 DTO로 변환합니다 (nil이면 nil)
+func newActivityStatsDayResponse(day *services.ActivityStatsDay) *dto.ActivityStatsDayResponse {
+	if day == nil {
+		return nil
+	}
+	return &dto.ActivityStatsDayResponse{
+		Date:     day.Date,
+		Steps:    day.Steps,
+		Calories: day.Calories,
+	}
+}
+
+// newStepGoalDayResponse는 하루의 목표 상태를 응답 DTO로 변환합니다
+func newStepGoalDayResponse(day *services.StepGoalDay) dto.StepGoalDayResponse {
+	response := dto.StepGoalDayResponse{
+		Date:     day.Date,
+		Timezone: day.Timezone,
+		Steps:    day.Steps,
+		Goals:    make([]dto.StepGoalResponse, len(day.Goals)),
+	}
+	for i, goal := range day.Goals {
+		response.Goals[i] = dto.StepGoalResponse{
+			Steps:     goal.Tier.Steps,
+			Gold:      goal.Tier.Gold,
+			Embers:    goal.Tier.Embers,
+			Reached:   goal.Reached,
+			Claimed:   goal.Claimed,
+			ClaimedAt: goal.ClaimedAt,
+		}
+		if goal.Claimed {
+			response.Claimed = true
+		}
+	}
+	return response
+}
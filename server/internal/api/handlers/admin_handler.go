@@ -0,0 +1,1073 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"game_eating_pizza/internal/services"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 운영 목록 조회(감사 로그, 이용 정지) 페이지 크기 제한입니다
+const (
+	defaultAdminPageLimit = 50
+	maxAdminPageLimit     = 200
+)
+
+// AdminHandler는 운영자/관리자 전용 핸들러입니다
+type AdminHandler struct {
+	adminService *services.AdminService
+}
+
+// NewAdminHandler는 새로운 AdminHandler를 생성합니다
+func NewAdminHandler(adminService *services.AdminService) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+	}
+}
+
+// DungeonRequest는 던전 생성/수정 요청 구조체입니다
+type DungeonRequest struct {
+	Name       string     `json:"name" binding:"required,max=100"`
+	Type       string     `json:"type" binding:"required,oneof=normal event boss"`
+	Difficulty int        `json:"difficulty" binding:"required,min=1,max=100"`
+	IsActive   *bool      `json:"is_active"` // 생략하면 true
+	StartTime  *time.Time `json:"start_time"`
+	EndTime    *time.Time `json:"end_time"`
+}
+
+// SuspendPlayerRequest는 플레이어 이용 정지 요청 구조체입니다
+type SuspendPlayerRequest struct {
+	Reason    string     `json:"reason" binding:"required,max=255"`
+	ExpiresAt *time.Time `json:"expires_at"` // 생략하면 영구 정지
+}
+
+// LiftSuspensionRequest는 이용 정지 해제 요청 구조체입니다
+type LiftSuspensionRequest struct {
+	Reason string `json:"reason" binding:"max=255"`
+}
+
+// ChangeRoleRequest는 플레이어 역할 변경 요청 구조체입니다
+type ChangeRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=player operator admin"`
+}
+
+// GrantGoldRequest는 골드 지급 요청 구조체입니다
+type GrantGoldRequest struct {
+	Amount int64  `json:"amount" binding:"required"` // 음수면 회수
+	Reason string `json:"reason" binding:"required,max=255"`
+}
+
+// GrantCurrencyRequest는 재화 지급 요청 구조체입니다
+type GrantCurrencyRequest struct {
+	Currency string `json:"currency" binding:"required"` // gold, embers, gems, scrap
+	Amount   int64  `json:"amount" binding:"required"`   // 음수면 회수
+	Reason   string `json:"reason" binding:"required,max=255"`
+}
+
+// GrantWeaponRequest는 무기 지급 요청 구조체입니다
+type GrantWeaponRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	Type        string  `json:"type" binding:"required"` // 현재 콘텐츠의 무기 템플릿에 있는 종류
+	AttackPower int     `json:"attack_power" binding:"required,min=1"`
+	AttackSpeed float64 `json:"attack_speed" binding:"required,gt=0"`
+	Rarity      string  `json:"rarity" binding:"required,max=20"` // 현재 콘텐츠의 등급 표에 있는 등급
+	Level       int     `json:"level" binding:"required,min=1"`   // 현재 콘텐츠의 강화 비용 표가 다루는 단계까지 (gold_costs 길이 + 1)
+	Reason      string  `json:"reason" binding:"required,max=255"`
+}
+
+// GrantItemRequest는 아이템 지급 요청 구조체입니다
+type GrantItemRequest struct {
+	ItemID string `json:"item_id" binding:"required"`
+	Amount int64  `json:"amount" binding:"required"` // 음수면 회수
+	Reason string `json:"reason" binding:"required,max=255"`
+}
+
+// GrantExperienceRequest는 경험치 지급 요청 구조체입니다
+type GrantExperienceRequest struct {
+	Amount int64  `json:"amount" binding:"required,min=1"`
+	Reason string `json:"reason" binding:"required,max=255"`
+}
+
+// GetDungeons 던전 목록 조회 (운영)
+// @Summary      던전 목록 조회 (운영)
+// @Description  비활성 던전을 포함한 전체 던전 목록을 조회합니다 (operator 이상)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  map[string]interface{}  "던전 목록"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/dungeons [get]
+func (h *AdminHandler) GetDungeons(c *gin.Context) {
+	dungeons, err := h.adminService.GetDungeons()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get dungeons",
+		})
+		return
+	}
+
+	responses := make([]dto.DungeonResponse, len(dungeons))
+	for i := range dungeons {
+		responses[i] = newDungeonResponse(&dungeons[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"dungeons": responses,
+	})
+}
+
+// CreateDungeon 던전 생성
+// @Summary      던전 생성
+// @Description  새로운 던전을 생성합니다 (operator 이상, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      DungeonRequest  true  "던전 정보"
+// @Success      201      {object}  dto.DungeonResponse  "생성된 던전"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/dungeons [post]
+func (h *AdminHandler) CreateDungeon(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+
+	var req DungeonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	dungeon, err := h.adminService.CreateDungeon(actor, req.toInput())
+	if err != nil {
+		respondAdminError(c, err, "Failed to create dungeon")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newDungeonResponse(dungeon))
+}
+
+// UpdateDungeon 던전 수정
+// @Summary      던전 수정
+// @Description  던전 정보를 수정합니다 (operator 이상, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int             true  "던전 ID"
+// @Param        request  body      DungeonRequest  true  "던전 정보"
+// @Success      200      {object}  dto.DungeonResponse  "수정된 던전"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "던전을 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/dungeons/{id} [put]
+func (h *AdminHandler) UpdateDungeon(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	dungeonID, ok := parseIDParam(c, "Invalid dungeon ID")
+	if !ok {
+		return
+	}
+
+	var req DungeonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	dungeon, err := h.adminService.UpdateDungeon(actor, dungeonID, req.toInput())
+	if err != nil {
+		respondAdminError(c, err, "Failed to update dungeon")
+		return
+	}
+
+	c.JSON(http.StatusOK, newDungeonResponse(dungeon))
+}
+
+// DeleteDungeon 던전 삭제
+// @Summary      던전 삭제
+// @Description  던전을 삭제합니다 (operator 이상, 감사 로그 기록)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "던전 ID"
+// @Success      200  {object}  map[string]interface{}  "삭제 성공"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      403  {object}  map[string]interface{}  "권한 없음"
+// @Failure      404  {object}  map[string]interface{}  "던전을 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/dungeons/{id} [delete]
+func (h *AdminHandler) DeleteDungeon(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	dungeonID, ok := parseIDParam(c, "Invalid dungeon ID")
+	if !ok {
+		return
+	}
+
+	if err := h.adminService.DeleteDungeon(actor, dungeonID); err != nil {
+		respondAdminError(c, err, "Failed to delete dungeon")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Dungeon deleted successfully",
+	})
+}
+
+// FindPlayer 플레이어 검색
+// @Summary      플레이어 검색
+// @Description  사용자명으로 플레이어를 조회합니다 (operator 이상)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        username  query     string  true  "사용자명"
+// @Success      200       {object}  dto.AdminPlayerResponse  "플레이어 정보"
+// @Failure      400       {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401       {object}  map[string]interface{}  "인증 실패"
+// @Failure      403       {object}  map[string]interface{}  "권한 없음"
+// @Failure      404       {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Router       /admin/players [get]
+func (h *AdminHandler) FindPlayer(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "username query parameter is required",
+		})
+		return
+	}
+
+	player, err := h.adminService.FindPlayerByUsername(username)
+	if err != nil {
+		respondAdminError(c, err, "Failed to find player")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAdminPlayerResponse(player))
+}
+
+// GetPlayer 플레이어 상세 조회
+// @Summary      플레이어 상세 조회
+// @Description  ID로 플레이어 상세 정보(이용 정지 상태 포함)를 조회합니다 (operator 이상)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "플레이어 ID"
+// @Success      200  {object}  dto.AdminPlayerResponse  "플레이어 정보"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      403  {object}  map[string]interface{}  "권한 없음"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Router       /admin/players/{id} [get]
+func (h *AdminHandler) GetPlayer(c *gin.Context) {
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	player, err := h.adminService.GetPlayer(playerID)
+	if err != nil {
+		respondAdminError(c, err, "Failed to get player")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAdminPlayerResponse(player))
+}
+
+// SuspendPlayer 플레이어 이용 정지
+// @Summary      플레이어 이용 정지
+// @Description  플레이어를 이용 정지합니다. 정지 기간 동안 로그인, 토큰 갱신, 기존 토큰을 사용한 API 호출이 모두 403 ACCOUNT_SUSPENDED로 거부되며, 만료 시각을 생략하면 영구 정지입니다 (operator 이상, 자신보다 낮은 권한만 가능, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                   true  "플레이어 ID"
+// @Param        request  body      SuspendPlayerRequest  true  "정지 사유와 만료 시각"
+// @Success      201      {object}  dto.SuspensionResponse  "발급된 이용 정지"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "이미 이용 정지 중"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/suspensions [post]
+func (h *AdminHandler) SuspendPlayer(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req SuspendPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	suspension, err := h.adminService.SuspendPlayer(actor, playerID, req.Reason, req.ExpiresAt)
+	if err != nil {
+		respondAdminError(c, err, "Failed to suspend player")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSuspensionResponse(suspension, time.Now()))
+}
+
+// GetPlayerSuspensions 플레이어 이용 정지 이력 조회
+// @Summary      플레이어 이용 정지 이력 조회
+// @Description  플레이어가 받은 이용 정지 이력(해제, 만료된 정지 포함)을 최신순으로 조회합니다 (operator 이상)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "플레이어 ID"
+// @Success      200  {object}  map[string]interface{}  "이용 정지 이력"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      403  {object}  map[string]interface{}  "권한 없음"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/suspensions [get]
+func (h *AdminHandler) GetPlayerSuspensions(c *gin.Context) {
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	suspensions, err := h.adminService.GetPlayerSuspensions(playerID)
+	if err != nil {
+		respondAdminError(c, err, "Failed to get suspensions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"suspensions": newSuspensionResponses(suspensions),
+	})
+}
+
+// GetSuspensions 이용 정지 목록 조회
+// @Summary      이용 정지 목록 조회
+// @Description  전체 이용 정지 목록을 최신순으로 조회합니다 (operator 이상)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        active     query     bool  false  "true면 현재 유효한 정지만 조회"
+// @Param        player_id  query     int   false  "플레이어 ID"
+// @Param        limit      query     int   false  "조회 개수 (기본값: 50, 최대: 200)"
+// @Param        offset     query     int   false  "건너뛸 개수"
+// @Success      200        {object}  map[string]interface{}  "이용 정지 목록"
+// @Failure      401        {object}  map[string]interface{}  "인증 실패"
+// @Failure      403        {object}  map[string]interface{}  "권한 없음"
+// @Failure      500        {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/suspensions [get]
+func (h *AdminHandler) GetSuspensions(c *gin.Context) {
+	var playerID uint
+	if id, err := strconv.ParseUint(c.Query("player_id"), 10, 32); err == nil {
+		playerID = uint(id)
+	}
+	activeOnly := c.Query("active") == "true"
+	limit, offset := parsePagination(c)
+
+	suspensions, total, err := h.adminService.GetSuspensions(playerID, activeOnly, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get suspensions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"suspensions": newSuspensionResponses(suspensions),
+		"total":       total,
+		"limit":       limit,
+		"offset":      offset,
+	})
+}
+
+// GetRejectedStepSamples 거부된 걸음 수 샘플 조회
+// @Summary      거부된 걸음 수 샘플 조회
+// @Description  걸음 수 동기화에서 검증에 실패해 반영되지 않은 샘플을 최신순으로 조회합니다 (operator 이상, 부정 행위 검토용)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        player_id  query     int     false  "플레이어 ID"
+// @Param        reason     query     string  false  "거부 사유 (invalid, future, too_old, spans_days, non_monotonic, overlap, rate_exceeded, daily_limit)"
+// @Param        limit      query     int     false  "조회 개수 (기본값: 50, 최대: 200)"
+// @Param        offset     query     int     false  "건너뛸 개수"
+// @Success      200        {object}  map[string]interface{}  "거부된 샘플 목록"
+// @Failure      401        {object}  map[string]interface{}  "인증 실패"
+// @Failure      403        {object}  map[string]interface{}  "권한 없음"
+// @Failure      500        {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/step-rejections [get]
+func (h *AdminHandler) GetRejectedStepSamples(c *gin.Context) {
+	var playerID uint
+	if id, err := strconv.ParseUint(c.Query("player_id"), 10, 32); err == nil {
+		playerID = uint(id)
+	}
+	limit, offset := parsePagination(c)
+
+	samples, total, err := h.adminService.GetRejectedStepSamples(playerID, c.Query("reason"), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get rejected step samples",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"samples": newRejectedStepSampleResponses(samples),
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// LiftSuspension 이용 정지 해제
+// @Summary      이용 정지 해제
+// @Description  유효한 이용 정지를 만료 전에 해제합니다. 해제된 기록은 이력으로 남습니다 (operator 이상, 자신보다 낮은 권한만 가능, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                    true   "이용 정지 ID"
+// @Param        request  body      LiftSuspensionRequest  false  "해제 사유"
+// @Success      200      {object}  dto.SuspensionResponse  "해제된 이용 정지"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "이용 정지를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "이미 해제되었거나 만료된 정지"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/suspensions/{id}/lift [post]
+func (h *AdminHandler) LiftSuspension(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	suspensionID, ok := parseIDParam(c, "Invalid suspension ID")
+	if !ok {
+		return
+	}
+
+	// 해제 사유는 선택이므로 빈 본문을 허용합니다
+	var req LiftSuspensionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	suspension, err := h.adminService.LiftSuspension(actor, suspensionID, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to lift suspension")
+		return
+	}
+
+	c.JSON(http.StatusOK, newSuspensionResponse(suspension, time.Now()))
+}
+
+// ChangeRole 플레이어 역할 변경
+// @Summary      플레이어 역할 변경
+// @Description  플레이어의 역할(player, operator, admin)을 변경합니다 (admin 전용, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                true  "플레이어 ID"
+// @Param        request  body      ChangeRoleRequest  true  "새 역할"
+// @Success      200      {object}  dto.AdminPlayerResponse  "변경된 플레이어"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/role [put]
+func (h *AdminHandler) ChangeRole(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req ChangeRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	player, err := h.adminService.ChangeRole(actor, playerID, req.Role)
+	if err != nil {
+		respondAdminError(c, err, "Failed to change role")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAdminPlayerResponse(player))
+}
+
+// GrantGold 골드 지급
+// @Summary      골드 지급
+// @Description  플레이어에게 골드를 지급합니다. 음수면 회수합니다 (admin 전용, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int               true  "플레이어 ID"
+// @Param        request  body      GrantGoldRequest  true  "지급량과 사유"
+// @Success      200      {object}  map[string]interface{}  "지급 후 잔액"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "회수할 골드 부족"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/gold [post]
+func (h *AdminHandler) GrantGold(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req GrantGoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	balance, err := h.adminService.GrantCurrency(actor, playerID, models.CurrencyGold, req.Amount, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to grant gold")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"player_id": playerID,
+		"amount":    req.Amount,
+		"gold":      balance,
+	})
+}
+
+// GrantCurrency 재화 지급
+// @Summary      재화 지급
+// @Description  플레이어에게 골드, 활력의 불씨, 젬을 지급합니다. 음수면 회수합니다 (admin 전용, 재화 원장과 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                   true  "플레이어 ID"
+// @Param        request  body      GrantCurrencyRequest  true  "재화 종류, 지급량과 사유"
+// @Success      200      {object}  map[string]interface{}  "지급 후 잔액"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "회수할 재화 부족"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/wallet [post]
+func (h *AdminHandler) GrantCurrency(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req GrantCurrencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	balance, err := h.adminService.GrantCurrency(actor, playerID, req.Currency, req.Amount, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to grant currency")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"player_id": playerID,
+		"currency":  req.Currency,
+		"amount":    req.Amount,
+		"balance":   balance,
+	})
+}
+
+// GrantWeapon 무기 지급
+// @Summary      무기 지급
+// @Description  플레이어에게 무기를 지급합니다. 무기 종류와 등급은 현재 콘텐츠의 무기 템플릿과 등급 표에 있는 것만, 단계는 강화 비용 표가 다루는 단계까지만 지급할 수 있습니다. 보관함이 가득 찬 플레이어에게는 지급하지 않습니다 (admin 전용, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                 true  "플레이어 ID"
+// @Param        request  body      GrantWeaponRequest  true  "무기 정보와 사유"
+// @Success      201      {object}  dto.WeaponResponse  "지급된 무기"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청, 콘텐츠에 없는 무기 종류·등급, 0 이하의 능력치 또는 강화 표 밖의 단계"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "무기 보관함이 가득 참"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/weapons [post]
+func (h *AdminHandler) GrantWeapon(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req GrantWeaponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	grant := services.WeaponGrant{
+		Name:        req.Name,
+		Type:        req.Type,
+		AttackPower: req.AttackPower,
+		AttackSpeed: req.AttackSpeed,
+		Rarity:      req.Rarity,
+		Level:       req.Level,
+	}
+	weapon, err := h.adminService.GrantWeapon(actor, playerID, grant, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to grant weapon")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newWeaponResponse(weapon))
+}
+
+// GrantItem 아이템 지급
+// @Summary      아이템 지급
+// @Description  플레이어에게 재료·소모품 아이템을 지급합니다. 음수면 회수합니다 (admin 전용, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int               true  "플레이어 ID"
+// @Param        request  body      GrantItemRequest  true  "아이템 ID, 지급량과 사유"
+// @Success      200      {object}  map[string]interface{}  "지급 후 수량"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어 또는 아이템을 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "최대 보유 수량 초과 또는 회수할 수량 부족"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/items [post]
+func (h *AdminHandler) GrantItem(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req GrantItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	quantity, err := h.adminService.GrantItem(actor, playerID, req.ItemID, req.Amount, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to grant item")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"player_id": playerID,
+		"item_id":   req.ItemID,
+		"amount":    req.Amount,
+		"quantity":  quantity,
+	})
+}
+
+// GrantExperience 경험치 지급
+// @Summary      경험치 지급
+// @Description  플레이어에게 경험치를 지급합니다. 레벨이 오르면 레벨업 보상도 함께 지급되고 오른 레벨마다 레벨업 이벤트를 반환합니다 (admin 전용, 감사 로그 기록)
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                     true  "플레이어 ID"
+// @Param        request  body      GrantExperienceRequest  true  "지급량과 사유"
+// @Success      200      {object}  dto.ExperienceGrantResponse  "지급 후 레벨, 경험치와 레벨업 이벤트"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "권한 없음"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "동시에 레벨이 바뀜 (다시 시도)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/players/{id}/experience [post]
+func (h *AdminHandler) GrantExperience(c *gin.Context) {
+	actor, ok := adminActor(c)
+	if !ok {
+		return
+	}
+	playerID, ok := parseIDParam(c, "Invalid player ID")
+	if !ok {
+		return
+	}
+
+	var req GrantExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	result, err := h.adminService.GrantExperience(actor, playerID, req.Amount, req.Reason)
+	if err != nil {
+		respondAdminError(c, err, "Failed to grant experience")
+		return
+	}
+
+	c.JSON(http.StatusOK, newExperienceGrantResponse(result))
+}
+
+// GetAuditLogs 감사 로그 조회
+// @Summary      감사 로그 조회
+// @Description  운영 작업 감사 로그를 최신순으로 조회합니다 (admin 전용)
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        actor_id     query     int     false  "작업자 ID"
+// @Param        action       query     string  false  "동작 (예: player.ban, grant.gold)"
+// @Param        target_type  query     string  false  "대상 종류 (player, dungeon)"
+// @Param        target_id    query     int     false  "대상 ID"
+// @Param        limit        query     int     false  "조회 개수 (기본값: 50, 최대: 200)"
+// @Param        offset       query     int     false  "건너뛸 개수"
+// @Success      200          {object}  map[string]interface{}  "감사 로그 목록"
+// @Failure      401          {object}  map[string]interface{}  "인증 실패"
+// @Failure      403          {object}  map[string]interface{}  "권한 없음"
+// @Failure      500          {object}  map[string]interface{}  "서버 오류"
+// @Router       /admin/audit-logs [get]
+func (h *AdminHandler) GetAuditLogs(c *gin.Context) {
+	filter := repository.AuditLogFilter{
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+	}
+	if actorID, err := strconv.ParseUint(c.Query("actor_id"), 10, 32); err == nil {
+		filter.ActorID = uint(actorID)
+	}
+	if targetID, err := strconv.ParseUint(c.Query("target_id"), 10, 32); err == nil {
+		filter.TargetID = uint(targetID)
+	}
+
+	limit, offset := parsePagination(c)
+
+	logs, total, err := h.adminService.GetAuditLogs(filter, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get audit logs",
+		})
+		return
+	}
+
+	responses := make([]dto.AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = dto.AuditLogResponse{
+			ID:         log.ID,
+			ActorID:    log.ActorID,
+			ActorRole:  log.ActorRole,
+			Action:     log.Action,
+			TargetType: log.TargetType,
+			TargetID:   log.TargetID,
+			Details:    json.RawMessage(log.Details),
+			IP:         log.IP,
+			CreatedAt:  log.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"audit_logs": responses,
+		"total":      total,
+		"limit":      limit,
+		"offset":     offset,
+	})
+}
+
+// toInput은 요청을 서비스 입력값으로 변환합니다
+func (req *DungeonRequest) toInput() services.DungeonInput {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	return services.DungeonInput{
+		Name:       req.Name,
+		Type:       req.Type,
+		Difficulty: req.Difficulty,
+		IsActive:   isActive,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+	}
+}
+
+// adminActor는 감사 로그에 남길 작업자 정보를 컨텍스트에서 꺼냅니다
+// 실패하면 401 응답을 내려주고 false를 반환합니다
+func adminActor(c *gin.Context) (services.AdminActor, bool) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return services.AdminActor{}, false
+	}
+	role, _ := middleware.GetPlayerRole(c)
+
+	return services.AdminActor{
+		PlayerID: playerID,
+		Role:     role,
+		IP:       c.ClientIP(),
+	}, true
+}
+
+// parsePagination은 limit/offset 쿼리를 읽어 기본값과 최대값을 적용합니다
+func parsePagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultAdminPageLimit)))
+	if err != nil || limit <= 0 {
+		limit = defaultAdminPageLimit
+	}
+	if limit > maxAdminPageLimit {
+		limit = maxAdminPageLimit
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// parseIDParam은 경로의 :id 값을 uint로 변환합니다
+// 실패하면 400 응답을 내려주고 false를 반환합니다
+func parseIDParam(c *gin.Context, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondAdminError는 운영 작업 에러를 HTTP 상태 코드로 변환합니다
+func respondAdminError(c *gin.Context, err error, message string) {
+	var fundsErr *services.InsufficientFundsError
+	var itemsErr *services.InsufficientItemsError
+	var stackErr *services.ItemStackFullError
+	switch {
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+	case errors.Is(err, services.ErrDungeonNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Dungeon not found",
+		})
+	case errors.Is(err, services.ErrSuspensionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Suspension not found",
+		})
+	case errors.Is(err, services.ErrItemNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Item not found",
+		})
+	case errors.Is(err, services.ErrAlreadySuspended):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Player is already suspended",
+			"code":  "ALREADY_SUSPENDED",
+		})
+	case errors.Is(err, services.ErrExperienceConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+			"code":  "EXPERIENCE_CONFLICT",
+		})
+	case errors.Is(err, services.ErrSuspensionNotActive):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Suspension is already lifted or expired",
+			"code":  "SUSPENSION_NOT_ACTIVE",
+		})
+	case errors.Is(err, services.ErrCannotModifySelf), errors.Is(err, services.ErrInsufficientPrivilege):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": err.Error(),
+			"code":  middleware.ErrCodeForbidden,
+		})
+	case errors.Is(err, services.ErrInvalidRole), errors.Is(err, services.ErrInvalidDungeonSchedule),
+		errors.Is(err, services.ErrInvalidSuspensionExpiry), errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, services.ErrInvalidExperience), errors.Is(err, services.ErrInvalidWeaponType),
+		errors.Is(err, services.ErrInvalidRarity), errors.Is(err, services.ErrInvalidWeaponStats),
+		errors.Is(err, services.ErrInvalidWeaponLevel):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	case errors.Is(err, services.ErrInventoryFull):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon inventory is full",
+			"code":  "INVENTORY_FULL",
+		})
+	case errors.As(err, &fundsErr):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Insufficient " + fundsErr.Currency,
+			"code":  "INSUFFICIENT_" + strings.ToUpper(fundsErr.Currency),
+		})
+	case errors.As(err, &itemsErr):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Insufficient " + itemsErr.ItemID,
+			"code":  "INSUFFICIENT_ITEMS",
+		})
+	case errors.As(err, &stackErr):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+			"code":  "ITEM_STACK_FULL",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   message,
+			"details": err.Error(),
+		})
+	}
+}
+
+// newDungeonResponse는 던전 모델을 응답 DTO로 변환합니다
+func newDungeonResponse(dungeon *models.Dungeon) dto.DungeonResponse {
+	return dto.DungeonResponse{
+		ID:         dungeon.ID,
+		Name:       dungeon.Name,
+		Type:       dungeon.Type,
+		Difficulty: dungeon.Difficulty,
+		IsActive:   dungeon.IsActive,
+		StartTime:  dungeon.StartTime,
+		EndTime:    dungeon.EndTime,
+		CreatedAt:  dungeon.CreatedAt,
+		UpdatedAt:  dungeon.UpdatedAt,
+	}
+}
+
+// newAdminPlayerResponse는 플레이어 모델을 운영자용 응답 DTO로 변환합니다
+// player.Suspensions가 로드되어 있어야 정지 상태가 채워집니다
+func newAdminPlayerResponse(player *models.Player) dto.AdminPlayerResponse {
+	now := time.Now()
+	response := dto.AdminPlayerResponse{
+		PlayerResponse:  newPlayerResponse(player),
+		SuspensionCount: len(player.Suspensions),
+	}
+	if active := player.ActiveSuspension(now); active != nil {
+		activeResponse := newSuspensionResponse(active, now)
+		response.ActiveSuspension = &activeResponse
+	}
+	return response
+}
+
+// newSuspensionResponse는 이용 정지 모델을 응답 DTO로 변환합니다
+func newSuspensionResponse(suspension *models.Suspension, now time.Time) dto.SuspensionResponse {
+	return dto.SuspensionResponse{
+		ID:         suspension.ID,
+		PlayerID:   suspension.PlayerID,
+		Reason:     suspension.Reason,
+		IssuedByID: suspension.IssuedByID,
+		ExpiresAt:  suspension.ExpiresAt,
+		Active:     suspension.IsActive(now),
+		LiftedAt:   suspension.LiftedAt,
+		LiftedByID: suspension.LiftedByID,
+		LiftReason: suspension.LiftReason,
+		CreatedAt:  suspension.CreatedAt,
+	}
+}
+
+// newSuspensionResponses는 이용 정지 목록을 응답 DTO 목록으로 변환합니다
+func newSuspensionResponses(suspensions []models.Suspension) []dto.SuspensionResponse {
+	now := time.Now()
+	responses := make([]dto.SuspensionResponse, len(suspensions))
+	for i := range suspensions {
+		responses[i] = newSuspensionResponse(&suspensions[i], now)
+	}
+	return responses
+}
+
+// newRejectedStepSampleResponses는 거부된 걸음 수 샘플 목록을 응답 DTO로 변환합니다
+func newRejectedStepSampleResponses(samples []models.RejectedStepSample) []dto.RejectedStepSampleResponse {
+	responses := make([]dto.RejectedStepSampleResponse, len(samples))
+	for i, sample := range samples {
+		responses[i] = dto.RejectedStepSampleResponse{
+			ID:        sample.ID,
+			PlayerID:  sample.PlayerID,
+			Source:    sample.Source,
+			StartedAt: sample.StartedAt,
+			EndedAt:   sample.EndedAt,
+			Steps:     sample.Steps,
+			Reason:    sample.Reason,
+			CreatedAt: sample.CreatedAt,
+		}
+	}
+	return responses
+}
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"errors"
+	"game_eating_pizza/internal/api/middleware"
 	"game_eating_pizza/internal/services"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -27,8 +31,28 @@ type RegisterRequest struct {
 
 // LoginRequest는 로그인 요청 구조체입니다
 type LoginRequest struct {
-	Username string `json:"username" binding:"required"`
-	Password string `json:"password" binding:"required"`
+	Username   string `json:"username" binding:"required"`
+	Password   string `json:"password" binding:"required"`
+	DeviceName string `json:"device_name" binding:"max=100"`                                     // 예: "Galaxy S24", "Apple Watch"
+	Platform   string `json:"platform" binding:"omitempty,oneof=ios android watchos wearos web"` // 기기 플랫폼
+}
+
+// GuestLoginRequest는 게스트 로그인 요청 구조체입니다
+type GuestLoginRequest struct {
+	DeviceID   string `json:"device_id" binding:"required,min=16,max=128"` // 앱 설치 시 생성한 기기 고유 식별자 (UUID 등)
+	DeviceName string `json:"device_name" binding:"max=100"`
+	Platform   string `json:"platform" binding:"omitempty,oneof=ios android watchos wearos web"`
+}
+
+// LinkAccountRequest는 게스트 계정 연결 요청 구조체입니다
+type LinkAccountRequest struct {
+	Username string `json:"username" binding:"required,min=3,max=50"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+// RefreshTokenRequest는 토큰 갱신/로그아웃 요청 구조체입니다
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
 // Register 회원가입
@@ -40,6 +64,7 @@ type LoginRequest struct {
 // @Param        request  body      RegisterRequest  true  "회원가입 정보"
 // @Success      201      {object}  map[string]interface{}  "회원가입 성공"
 // @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      409      {object}  map[string]interface{}  "이미 사용 중인 사용자명"
 // @Failure      500      {object}  map[string]interface{}  "서버 오류"
 // @Router       /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -54,6 +79,12 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 	// TODO: AuthService.Register 구현 필요
 	player, err := h.authService.Register(req.Username, req.Password)
+	if errors.Is(err, services.ErrUsernameTaken) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Username already exists",
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to register",
@@ -78,6 +109,8 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // @Success      200      {object}  map[string]interface{}  "로그인 성공"
 // @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
 // @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "이용 정지된 계정 (사유, 만료 시각 포함)"
+// @Failure      429      {object}  map[string]interface{}  "로그인 시도 횟수 초과 (Retry-After 헤더 참고)"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
@@ -89,8 +122,19 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// TODO: AuthService.Login 구현 필요
-	token, player, err := h.authService.Login(req.Username, req.Password)
+	device := services.DeviceInfo{
+		Name:     req.DeviceName,
+		Platform: req.Platform,
+		IP:       c.ClientIP(),
+	}
+
+	tokens, player, err := h.authService.Login(req.Username, req.Password, device)
+	if respondLoginLocked(c, err) {
+		return
+	}
+	if respondAccountSuspended(c, err) {
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "Invalid credentials",
@@ -99,24 +143,282 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	response := tokenPairResponse(tokens)
+	response["player"] = player
+	c.JSON(http.StatusOK, response)
+}
+
+// GuestLogin 게스트 로그인
+// @Summary      게스트 로그인
+// @Description  기기 식별자로 게스트 계정을 생성하거나, 이미 있으면 해당 게스트 계정으로 로그인합니다
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      GuestLoginRequest  true  "기기 정보"
+// @Success      200      {object}  map[string]interface{}  "로그인 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      403      {object}  map[string]interface{}  "이용 정지된 계정 (사유, 만료 시각 포함)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/guest [post]
+func (h *AuthHandler) GuestLogin(c *gin.Context) {
+	var req GuestLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	device := services.DeviceInfo{
+		Name:     req.DeviceName,
+		Platform: req.Platform,
+		IP:       c.ClientIP(),
+	}
+
+	tokens, player, err := h.authService.GuestLogin(req.DeviceID, device)
+	if respondAccountSuspended(c, err) {
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to login as guest",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response := tokenPairResponse(tokens)
+	response["player"] = player
+	c.JSON(http.StatusOK, response)
+}
+
+// LinkAccount 게스트 계정 연결
+// @Summary      게스트 계정 연결
+// @Description  현재 게스트 계정에 아이디/비밀번호를 연결하여 정식 계정으로 전환합니다. 무기, 골드, 진행도는 그대로 유지됩니다
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      LinkAccountRequest  true  "연결할 계정 정보"
+// @Success      200      {object}  map[string]interface{}  "계정 연결 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      409      {object}  map[string]interface{}  "이미 사용 중인 사용자명 또는 게스트가 아닌 계정"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/link [post]
+func (h *AuthHandler) LinkAccount(c *gin.Context) {
+	var req LinkAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	player, err := h.authService.LinkAccount(playerID, req.Username, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUsernameTaken):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "Username already exists",
+			})
+		case errors.Is(err, services.ErrNotGuestAccount):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "Account is already linked",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to link account",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"token": token,
-		"player": player,
+		"message": "Account linked successfully",
+		"player":  player,
 	})
 }
 
 // RefreshToken 토큰 갱신
 // @Summary      토큰 갱신
-// @Description  JWT 토큰을 갱신합니다
+// @Description  리프레시 토큰을 회전시키고 새로운 액세스/리프레시 토큰을 발급합니다. 이미 사용된 리프레시 토큰을 재사용하면 해당 로그인의 모든 토큰이 폐기됩니다
 // @Tags         auth
 // @Accept       json
 // @Produce      json
+// @Param        request  body      RefreshTokenRequest  true  "리프레시 토큰"
 // @Success      200      {object}  map[string]interface{}  "토큰 갱신 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
 // @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "이용 정지된 계정 (사유, 만료 시각 포함)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
 // @Router       /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
-	// TODO: 토큰 갱신 로직 구현
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error": "Not implemented yet",
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	tokens, err := h.authService.Refresh(req.RefreshToken, c.ClientIP())
+	if respondAccountSuspended(c, err) {
+		return
+	}
+	if err != nil {
+		respondRefreshTokenError(c, err, "Failed to refresh token")
+		return
+	}
+
+	c.JSON(http.StatusOK, tokenPairResponse(tokens))
+}
+
+// Logout 로그아웃
+// @Summary      로그아웃
+// @Description  현재 기기의 세션을 종료하고 리프레시 토큰(같은 로그인에서 회전된 토큰 포함)을 폐기합니다
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      RefreshTokenRequest  true  "리프레시 토큰"
+// @Success      200      {object}  map[string]interface{}  "로그아웃 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.Logout(req.RefreshToken); err != nil {
+		respondRefreshTokenError(c, err, "Failed to logout")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Logged out successfully",
+	})
+}
+
+// LogoutAll 전체 기기 로그아웃
+// @Summary      전체 기기 로그아웃
+// @Description  현재 플레이어의 모든 세션과 리프레시 토큰을 폐기합니다 (분실한 기기의 세션 종료용)
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  map[string]interface{}  "로그아웃 성공"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	if err := h.authService.LogoutAll(playerID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to logout",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Logged out from all devices",
+	})
+}
+
+// tokenPairResponse는 발급된 토큰 쌍을 응답 형태로 변환합니다
+func tokenPairResponse(tokens *services.TokenPair) gin.H {
+	return gin.H{
+		"token":                    tokens.AccessToken,
+		"token_type":               "Bearer",
+		"expires_at":               tokens.AccessTokenExpiresAt,
+		"refresh_token":            tokens.RefreshToken,
+		"refresh_token_expires_at": tokens.RefreshTokenExpiresAt,
+	}
+}
+
+// respondLoginLocked는 err가 *services.LoginLockedError이면 429 응답을 내려주고 true를 반환합니다
+func respondLoginLocked(c *gin.Context, err error) bool {
+	var lockedErr *services.LoginLockedError
+	if !errors.As(err, &lockedErr) {
+		return false
+	}
+
+	// 남은 잠금 시간을 초 단위로 올림하여 Retry-After 헤더로 전달
+	retryAfter := int(math.Ceil(lockedErr.RetryAfter.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":       "Too many login attempts",
+		"code":        "LOGIN_LOCKED",
+		"retry_after": retryAfter,
+	})
+	return true
+}
+
+// respondAccountSuspended는 err가 *services.AccountSuspendedError이면 403 응답을 내려주고 true를 반환합니다
+// 응답 형식은 AuthMiddleware가 정지된 계정의 토큰을 거부할 때와 같습니다
+func respondAccountSuspended(c *gin.Context, err error) bool {
+	var suspendedErr *services.AccountSuspendedError
+	if !errors.As(err, &suspendedErr) {
+		return false
+	}
+
+	c.JSON(http.StatusForbidden, gin.H{
+		"error":      "Account is suspended",
+		"code":       middleware.ErrCodeAccountSuspended,
+		"reason":     suspendedErr.Suspension.Reason,
+		"expires_at": suspendedErr.Suspension.ExpiresAt, // null이면 영구 정지
+	})
+	return true
+}
+
+// respondRefreshTokenError는 리프레시 토큰 관련 에러를 401 에러 코드로 변환합니다
+func respondRefreshTokenError(c *gin.Context, err error, message string) {
+	var code string
+	switch {
+	case errors.Is(err, services.ErrInvalidRefreshToken):
+		code = "REFRESH_TOKEN_INVALID"
+	case errors.Is(err, services.ErrRefreshTokenExpired):
+		code = "REFRESH_TOKEN_EXPIRED"
+	case errors.Is(err, services.ErrRefreshTokenReused):
+		code = "REFRESH_TOKEN_REUSED"
+	case errors.Is(err, services.ErrSessionRevoked):
+		code = middleware.ErrCodeSessionRevoked
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   message,
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": message,
+		"code":  code,
 	})
 }
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/content"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentHandler는 게임 콘텐츠(레벨 표, 무기 템플릿, 제작 레시피 등) 버전 관련 핸들러입니다
+type ContentHandler struct {
+	contentStore *content.Store
+}
+
+// NewContentHandler는 새로운 ContentHandler를 생성합니다
+func NewContentHandler(contentStore *content.Store) *ContentHandler {
+	return &ContentHandler{
+		contentStore: contentStore,
+	}
+}
+
+// GetVersion 게임 콘텐츠 버전 조회
+// @Summary      게임 콘텐츠 버전 조회
+// @Description  서버가 현재 적용 중인 게임 콘텐츠 버전과 불러온 시각을 조회합니다. 모든 응답의 X-Content-Version 헤더에도 같은 버전이 담깁니다
+// @Tags         content
+// @Produce      json
+// @Success      200  {object}  dto.ContentVersionResponse  "콘텐츠 버전"
+// @Router       /content/version [get]
+func (h *ContentHandler) GetVersion(c *gin.Context) {
+	catalog := h.contentStore.Current()
+	c.JSON(http.StatusOK, dto.ContentVersionResponse{
+		Version:  catalog.Version,
+		LoadedAt: catalog.LoadedAt,
+	})
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
 	"game_eating_pizza/internal/services"
 	"net/http"
 	"strconv"
@@ -173,7 +174,7 @@ func (h *DungeonHandler) EnterDungeon(c *gin.Context) {
 		return
 	}
 
-	userID, exists := c.Get("userID")
+	playerID, exists := middleware.GetPlayerID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "User not authenticated",
@@ -181,15 +182,7 @@ func (h *DungeonHandler) EnterDungeon(c *gin.Context) {
 		return
 	}
 
-	playerID, err := strconv.ParseUint(userID.(string), 10, 32)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid user ID",
-		})
-		return
-	}
-
-	err = h.dungeonService.EnterDungeon(uint(playerID), uint(dungeonID))
+	err = h.dungeonService.EnterDungeon(playerID, uint(dungeonID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to enter dungeon",
@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/services"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForgeHandler는 대장간(무기 제작) 관련 핸들러입니다
+type ForgeHandler struct {
+	forgeService   *services.ForgeService
+	upgradeService *services.UpgradeService
+}
+
+// NewForgeHandler는 새로운 ForgeHandler를 생성합니다
+func NewForgeHandler(forgeService *services.ForgeService, upgradeService *services.UpgradeService) *ForgeHandler {
+	return &ForgeHandler{
+		forgeService:   forgeService,
+		upgradeService: upgradeService,
+	}
+}
+
+// StartForgeJobRequest는 제작 시작 요청 구조체입니다
+// 무기 능력치는 서버가 결정하므로 레시피만 받습니다
+type StartForgeJobRequest struct {
+	RecipeID string `json:"recipe_id" binding:"required"`
+}
+
+// GetRecipes 제작 레시피 목록 조회
+// @Summary      제작 레시피 목록 조회
+// @Description  대장간에서 제작할 수 있는 무기 레시피(비용, 제작 시간, 필요 레벨, 등급 확률)와 오늘 걸음 수로 계산한 부스트를 조회합니다
+// @Tags         forge
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}  "레시피 목록과 현재 부스트"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /forge/recipes [get]
+func (h *ForgeHandler) GetRecipes(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	boost, err := h.forgeService.CurrentBoost(playerID, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get forge boost",
+		})
+		return
+	}
+
+	recipes := h.forgeService.GetRecipes()
+	responses := make([]dto.ForgeRecipeResponse, len(recipes))
+	for i := range recipes {
+		responses[i] = newForgeRecipeResponse(&recipes[i], boost.Multiplier)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"recipes": responses,
+		"boost":   newForgeBoostResponse(boost),
+	})
+}
+
+// GetBoost 대장간 부스트 조회
+// @Summary      대장간 부스트 조회
+// @Description  오늘 걸음 수로 계산한 대장간 부스트 배율을 조회합니다. 3,000보 이상 1.5배, 5,000보 이상 2배이며, 제작 시작 시점의 배율만큼 제작 시간이 짧아지고 rare 이상 등급 확률이 올라갑니다
+// @Tags         forge
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ForgeBoostResponse  "현재 부스트"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /forge/boost [get]
+func (h *ForgeHandler) GetBoost(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	boost, err := h.forgeService.CurrentBoost(playerID, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get forge boost",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newForgeBoostResponse(boost))
+}
+
+// StartJob 무기 제작 시작
+// @Summary      무기 제작 시작
+// @Description  레시피로 무기 제작을 시작합니다. 골드는 즉시 차감되며, 제작 시간이 지난 뒤 수령하면 서버가 결과 무기의 등급과 능력치를 결정합니다. 시작 시점의 걸음 수 부스트가 작업에 고정되어 제작 시간과 등급 확률에 적용됩니다
+// @Tags         forge
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      StartForgeJobRequest  true  "레시피 ID"
+// @Success      201      {object}  dto.ForgeJobResponse  "시작된 제작 작업"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      402      {object}  map[string]interface{}  "골드 부족"
+// @Failure      403      {object}  map[string]interface{}  "레벨 부족"
+// @Failure      404      {object}  map[string]interface{}  "레시피를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "제작 슬롯 또는 무기 보관함 부족"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /forge/jobs [post]
+// @Router       /weapons [post]
+func (h *ForgeHandler) StartJob(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	var req StartForgeJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	job, err := h.forgeService.StartJob(playerID, req.RecipeID)
+	if err != nil {
+		respondForgeError(c, err, "Failed to start forge job")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newForgeJobResponse(job, time.Now()))
+}
+
+// GetJobs 제작 작업 목록 조회
+// @Summary      제작 작업 목록 조회
+// @Description  아직 수령하지 않은 제작 작업(제작 중, 수령 대기)을 완료 시각 순으로 조회합니다
+// @Tags         forge
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}  "제작 작업 목록"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /forge/jobs [get]
+func (h *ForgeHandler) GetJobs(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	jobs, err := h.forgeService.GetJobs(playerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get forge jobs",
+		})
+		return
+	}
+
+	now := time.Now()
+	responses := make([]dto.ForgeJobResponse, len(jobs))
+	for i := range jobs {
+		responses[i] = newForgeJobResponse(&jobs[i], now)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"jobs": responses,
+	})
+}
+
+// GetJob 제작 작업 조회
+// @Summary      제작 작업 조회
+// @Description  제작 작업의 상태와 남은 시간을 조회합니다 (폴링용)
+// @Tags         forge
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "제작 작업 ID"
+// @Success      200  {object}  dto.ForgeJobResponse  "제작 작업"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "제작 작업을 찾을 수 없음"
+// @Router       /forge/jobs/{id} [get]
+func (h *ForgeHandler) GetJob(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	jobID, ok := parseIDParam(c, "Invalid forge job ID")
+	if !ok {
+		return
+	}
+
+	job, err := h.forgeService.GetJob(playerID, jobID)
+	if err != nil {
+		respondForgeError(c, err, "Failed to get forge job")
+		return
+	}
+
+	c.JSON(http.StatusOK, newForgeJobResponse(job, time.Now()))
+}
+
+// ClaimJob 제작 무기 수령
+// @Summary      제작 무기 수령
+// @Description  제작이 끝난 작업의 무기를 수령합니다. 결과 무기의 등급과 능력치는 이 시점에 작업을 시작할 때 고정한 레시피(무기 템플릿, 등급 확률)로 서버에서 결정됩니다
+// @Tags         forge
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "제작 작업 ID"
+// @Success      200  {object}  map[string]interface{}  "수령한 제작 작업과 무기"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "제작 작업을 찾을 수 없음"
+// @Failure      409  {object}  map[string]interface{}  "제작 중이거나 이미 수령함, 또는 무기 보관함 부족"
+// @Failure      410  {object}  map[string]interface{}  "레시피가 사라져 제작 비용을 돌려줌"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /forge/jobs/{id}/claim [post]
+func (h *ForgeHandler) ClaimJob(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	jobID, ok := parseIDParam(c, "Invalid forge job ID")
+	if !ok {
+		return
+	}
+
+	job, weapon, err := h.forgeService.ClaimJob(playerID, jobID)
+	if err != nil {
+		respondForgeError(c, err, "Failed to claim forge job")
+		return
+	}
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
+	if err != nil {
+		respondForgeError(c, err, "Failed to claim forge job")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"job":    newForgeJobResponse(job, time.Now()),
+		"weapon": newOwnedWeaponResponse(weapon, bonuses),
+	})
+}
+
+// respondForgeError는 대장간 에러를 HTTP 상태 코드로 변환합니다
+func respondForgeError(c *gin.Context, err error, message string) {
+	var notReadyErr *services.ForgeJobNotReadyError
+	switch {
+	case errors.As(err, &notReadyErr):
+		remaining := int(math.Ceil(notReadyErr.Remaining.Seconds()))
+		c.Header("Retry-After", strconv.Itoa(remaining))
+		c.JSON(http.StatusConflict, gin.H{
+			"error":             "Forge job is not ready yet",
+			"code":              "FORGE_JOB_NOT_READY",
+			"remaining_seconds": remaining,
+		})
+	case errors.Is(err, services.ErrForgeJobRefunded):
+		c.JSON(http.StatusGone, gin.H{
+			"error": "Forge recipe was removed, the gold cost was refunded",
+			"code":  "FORGE_JOB_REFUNDED",
+		})
+	case errors.Is(err, services.ErrForgeJobAlreadyClaimed):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Forge job already claimed",
+			"code":  "FORGE_JOB_ALREADY_CLAIMED",
+		})
+	case errors.Is(err, services.ErrForgeSlotsFull):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "All forge slots are in use, claim a finished weapon first",
+			"code":  "FORGE_SLOTS_FULL",
+		})
+	case errors.Is(err, services.ErrInventoryFull):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon inventory is full, sell or dismantle weapons or expand the inventory first",
+			"code":  "INVENTORY_FULL",
+		})
+	case errors.Is(err, services.ErrInsufficientGold):
+		c.JSON(http.StatusPaymentRequired, gin.H{
+			"error": "Insufficient gold",
+			"code":  "INSUFFICIENT_GOLD",
+		})
+	case errors.Is(err, services.ErrLevelTooLow):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Player level too low for this recipe",
+			"code":  "LEVEL_TOO_LOW",
+		})
+	case errors.Is(err, services.ErrRecipeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Recipe not found",
+		})
+	case errors.Is(err, services.ErrForgeJobNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Forge job not found",
+		})
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   message,
+			"details": err.Error(),
+		})
+	}
+}
+
+// newForgeRecipeResponse는 레시피를 부스트 배율을 반영한 응답 DTO로 변환합니다
+func newForgeRecipeResponse(recipe *services.ForgeRecipe, boost float64) dto.ForgeRecipeResponse {
+	return dto.ForgeRecipeResponse{
+		ID:                     recipe.ID,
+		Name:                   recipe.Weapon.Name,
+		WeaponType:             recipe.Weapon.Type,
+		GoldCost:               recipe.GoldCost,
+		DurationSeconds:        int64(recipe.Duration.Seconds()),
+		BoostedDurationSeconds: int64(recipe.BoostedDuration(boost).Seconds()),
+		MinLevel:               recipe.MinLevel,
+		AttackPowerMin:         recipe.Weapon.AttackPower.Min,
+		AttackPowerMax:         recipe.Weapon.AttackPower.Max,
+		AttackSpeedMin:         recipe.Weapon.AttackSpeed.Min,
+		AttackSpeedMax:         recipe.Weapon.AttackSpeed.Max,
+		RarityChances:          newRarityChanceResponses(recipe.RarityChances),
+		BoostedRarityChances:   newRarityChanceResponses(recipe.BoostedRarityChances(boost)),
+	}
+}
+
+// newRarityChanceResponses는 등급 가중치를 백분율 응답 DTO로 변환합니다
+func newRarityChanceResponses(chances []services.RarityChance) []dto.RarityChanceResponse {
+	totalWeight := 0
+	for _, chance := range chances {
+		totalWeight += chance.Weight
+	}
+	responses := make([]dto.RarityChanceResponse, len(chances))
+	for i, chance := range chances {
+		responses[i] = dto.RarityChanceResponse{
+			Rarity:  chance.Rarity,
+			Percent: math.Round(float64(chance.Weight)/float64(totalWeight)*10000) / 100,
+		}
+	}
+	return responses
+}
+
+// newForgeBoostResponse는 대장간 부스트를 응답 DTO로 변환합니다
+func newForgeBoostResponse(boost services.ForgeBoost) dto.ForgeBoostResponse {
+	return dto.ForgeBoostResponse{
+		Multiplier: boost.Multiplier,
+		Steps:      boost.Steps,
+		Date:       boost.Date,
+	}
+}
+
+// newForgeJobResponse는 제작 작업을 now 기준 상태와 함께 응답 DTO로 변환합니다
+func newForgeJobResponse(job *models.ForgeJob, now time.Time) dto.ForgeJobResponse {
+	return dto.ForgeJobResponse{
+		ID:               job.ID,
+		RecipeID:         job.RecipeID,
+		Status:           job.StatusAt(now),
+		GoldCost:         job.GoldCost,
+		ForgeBoost:       job.ForgeBoost,
+		BoostSteps:       job.BoostSteps,
+		StartedAt:        job.StartedAt,
+		CompletesAt:      job.CompletesAt,
+		RemainingSeconds: int64(math.Ceil(job.RemainingAt(now).Seconds())),
+		ClaimedAt:        job.ClaimedAt,
+		WeaponID:         job.WeaponID,
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdleHandler는 방치(오프라인) 사냥 정산 관련 핸들러입니다
+type IdleHandler struct {
+	idleService *services.IdleService
+}
+
+// NewIdleHandler는 새로운 IdleHandler를 생성합니다
+func NewIdleHandler(idleService *services.IdleService) *IdleHandler {
+	return &IdleHandler{
+		idleService: idleService,
+	}
+}
+
+// SyncIdleRequest는 방치 사냥 정산 요청 구조체입니다 (본문 생략 가능)
+type SyncIdleRequest struct {
+	Stage int `json:"stage" binding:"min=0"` // 정산 후 사냥할 스테이지 (0이거나 생략하면 현재 스테이지 유지)
+}
+
+// SyncIdle 방치 사냥 정산
+// @Summary      방치 사냥 정산
+// @Description  앱을 다시 열 때 호출합니다. 마지막 정산 이후 흐른 시간(최대 정산 시간까지) 동안 장착한 무기(영구 강화 보너스 반영)로 현재 스테이지 몬스터를 처치했다고 보고 서버가 처치 수, 골드와 경험치를 계산해 지급합니다. 처음 정산하면 지급 없이 정산 시각만 기록합니다. 경험치로 레벨이 오르면 레벨업 보상도 함께 지급합니다. stage를 보내면 정산 후 그 스테이지로 옮기며, 한 번에 한 단계씩만 올라갈 수 있고 올라가려면 현재 스테이지에서 콘텐츠의 clear_kills만큼 처치(이번 정산 포함)해야 합니다. 조건을 채우지 못하면 아무것도 반영하지 않습니다
+// @Tags         idle
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      SyncIdleRequest       false  "옮겨 갈 스테이지"
+// @Success      200      {object}  dto.IdleSyncResponse  "정산 결과"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청 또는 옮길 수 없는 스테이지"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "현재 스테이지를 아직 클리어하지 않음 또는 다른 정산 요청과 충돌 (다시 시도)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /idle/sync [post]
+func (h *IdleHandler) SyncIdle(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	var req SyncIdleRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	earnings, err := h.idleService.Sync(playerID, req.Stage)
+	switch {
+	case errors.Is(err, services.ErrInvalidStage):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Stage must be between 1 and the stage after the current one",
+			"code":  "INVALID_STAGE",
+		})
+		return
+	case errors.Is(err, services.ErrStageNotCleared):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Defeat more monsters on the current stage before moving up",
+			"code":  "STAGE_NOT_CLEARED",
+		})
+		return
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	case errors.Is(err, services.ErrIdleSyncConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Idle progress was changed by another request, retry",
+			"code":  "IDLE_SYNC_CONFLICT",
+		})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to sync idle progress",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.IdleSyncResponse{
+		LastSyncedAt:    earnings.LastSyncedAt,
+		SyncedAt:        earnings.SyncedAt,
+		ElapsedSeconds:  int64(earnings.Elapsed.Seconds()),
+		CreditedSeconds: int64(earnings.Credited.Seconds()),
+		Capped:          earnings.Capped,
+		Stage:           earnings.Stage,
+		NextStage:       earnings.Player.Stage,
+		StageKills:      earnings.StageKills,
+		ClearKills:      earnings.ClearKills,
+		WeaponID:        earnings.WeaponID,
+		DamagePerSecond: earnings.DamagePerSecond,
+		Kills:           earnings.Kills,
+		Gold:            earnings.Gold,
+		Experience:      earnings.Experience,
+		Level:           earnings.Player.Level,
+		TotalKills:      earnings.Player.TotalKills,
+		LevelUps:        newLevelUpEventResponses(earnings.LevelUps),
+	})
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InventoryHandler는 재료·소모품 아이템 목록과 플레이어 인벤토리 관련 핸들러입니다
+type InventoryHandler struct {
+	inventoryService *services.InventoryService
+}
+
+// NewInventoryHandler는 새로운 InventoryHandler를 생성합니다
+func NewInventoryHandler(inventoryService *services.InventoryService) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+	}
+}
+
+// GetItems 아이템 목록 조회
+// @Summary      아이템 목록 조회
+// @Description  게임에 존재하는 재료·소모품 아이템(광석, 보석 파편, 주문서) 정의를 조회합니다
+// @Tags         inventory
+// @Produce      json
+// @Security     BearerAuth
+// @Param        type  query     string  false  "아이템 종류 (ore, gem_shard, scroll, 여러 개는 쉼표로 구분, 생략하면 전체)"
+// @Success      200   {object}  map[string]interface{}  "아이템 정의 목록"
+// @Failure      400   {object}  map[string]interface{}  "잘못된 아이템 종류"
+// @Failure      401   {object}  map[string]interface{}  "인증 실패"
+// @Failure      500   {object}  map[string]interface{}  "서버 오류"
+// @Router       /items [get]
+func (h *InventoryHandler) GetItems(c *gin.Context) {
+	items, err := h.inventoryService.GetCatalog(parseItemTypes(c))
+	if errors.Is(err, services.ErrInvalidItemType) {
+		respondInvalidItemType(c)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get items",
+		})
+		return
+	}
+
+	responses := make([]dto.ItemDefinitionResponse, len(items))
+	for i, item := range items {
+		responses[i] = dto.ItemDefinitionResponse{
+			ID:          item.ID,
+			Name:        item.Name,
+			Type:        item.Type,
+			Description: item.Description,
+			MaxStack:    item.MaxStack,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items": responses,
+	})
+}
+
+// GetInventory 인벤토리 조회
+// @Summary      인벤토리 조회
+// @Description  현재 로그인한 플레이어가 보유한 재료·소모품 아이템을 아이템 ID 순으로 조회합니다 (수량이 0인 아이템은 제외)
+// @Tags         inventory
+// @Produce      json
+// @Security     BearerAuth
+// @Param        type    query     string  false  "아이템 종류 (ore, gem_shard, scroll, 여러 개는 쉼표로 구분, 생략하면 전체)"
+// @Param        limit   query     int     false  "조회 개수 (기본값: 50, 최대: 200)"
+// @Param        offset  query     int     false  "건너뛸 개수"
+// @Success      200     {object}  map[string]interface{}  "보유 아이템 목록"
+// @Failure      400     {object}  map[string]interface{}  "잘못된 아이템 종류"
+// @Failure      401     {object}  map[string]interface{}  "인증 실패"
+// @Failure      404     {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500     {object}  map[string]interface{}  "서버 오류"
+// @Router       /inventory [get]
+func (h *InventoryHandler) GetInventory(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	limit, offset := parsePagination(c)
+
+	entries, total, err := h.inventoryService.GetInventory(playerID, parseItemTypes(c), limit, offset)
+	switch {
+	case errors.Is(err, services.ErrInvalidItemType):
+		respondInvalidItemType(c)
+		return
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get inventory",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items":  newInventoryItemResponses(entries),
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+// parseItemTypes는 type 쿼리 값을 아이템 종류 목록으로 변환합니다
+// ?type=ore,scroll 처럼 쉼표로 구분하거나 ?type=ore&type=scroll 처럼 여러 번 지정할 수 있습니다
+func parseItemTypes(c *gin.Context) []string {
+	var types []string
+	for _, value := range c.QueryArray("type") {
+		for _, t := range strings.Split(value, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, t)
+			}
+		}
+	}
+	return types
+}
+
+// respondInvalidItemType은 정의되지 않은 아이템 종류에 대한 400 응답을 내려줍니다
+func respondInvalidItemType(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error": "Invalid item type",
+		"code":  "INVALID_ITEM_TYPE",
+	})
+}
+
+// newInventoryItemResponses는 보유 아이템을 응답 DTO로 변환합니다
+func newInventoryItemResponses(entries []services.InventoryEntry) []dto.InventoryItemResponse {
+	responses := make([]dto.InventoryItemResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = dto.InventoryItemResponse{
+			ItemID:    entry.Item.ItemID,
+			Name:      entry.Definition.Name,
+			Type:      entry.Definition.Type,
+			Quantity:  entry.Item.Quantity,
+			MaxStack:  entry.Definition.MaxStack,
+			UpdatedAt: entry.Item.UpdatedAt,
+		}
+	}
+	return responses
+}
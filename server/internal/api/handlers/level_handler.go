@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LevelHandler는 플레이어 레벨 곡선, 레벨업 보상과 레벨 진행 상황 관련 핸들러입니다
+type LevelHandler struct {
+	levelService *services.LevelService
+}
+
+// NewLevelHandler는 새로운 LevelHandler를 생성합니다
+func NewLevelHandler(levelService *services.LevelService) *LevelHandler {
+	return &LevelHandler{
+		levelService: levelService,
+	}
+}
+
+// GetLevels 레벨 표 조회
+// @Summary      레벨 표 조회
+// @Description  1레벨부터 최고 레벨까지 다음 레벨에 필요한 경험치와 레벨 도달 보상(골드, 아이템, 해금 던전)을 조회합니다
+// @Tags         levels
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.LevelTableResponse  "레벨 표"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Router       /levels [get]
+func (h *LevelHandler) GetLevels(c *gin.Context) {
+	levels := h.levelService.GetLevels()
+
+	response := dto.LevelTableResponse{
+		MaxLevel: len(levels),
+		Levels:   make([]dto.LevelResponse, len(levels)),
+	}
+	for i, level := range levels {
+		response.Levels[i] = newLevelResponse(level)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyLevel 내 레벨 진행 상황 조회
+// @Summary      내 레벨 진행 상황 조회
+// @Description  현재 레벨과 경험치, 다음 레벨까지 필요한 경험치, 다음 레벨업 보상과 해금한 던전을 조회합니다
+// @Tags         levels
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.LevelProgressResponse  "레벨 진행 상황"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me/level [get]
+func (h *LevelHandler) GetMyLevel(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	progress, err := h.levelService.GetProgress(playerID)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get level progress",
+		})
+		return
+	}
+
+	response := dto.LevelProgressResponse{
+		Level:            progress.Level,
+		MaxLevel:         progress.MaxLevel,
+		Experience:       progress.Experience,
+		ExpToNext:        progress.ExpToNext,
+		UnlockedDungeons: newDungeonUnlockResponses(progress.Unlocks),
+	}
+	if progress.NextReward != nil {
+		next := newLevelResponse(*progress.NextReward)
+		response.NextReward = &next
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// newLevelResponse는 레벨 정보를 응답 DTO로 변환합니다
+func newLevelResponse(level services.LevelInfo) dto.LevelResponse {
+	response := dto.LevelResponse{
+		Level:     level.Level,
+		ExpToNext: level.ExpToNext,
+	}
+	if level.Reward != nil {
+		response.Reward = &dto.LevelRewardResponse{
+			Gold:     level.Reward.Gold,
+			Items:    newItemRewardResponses(level.Reward.Items),
+			Dungeons: dungeonIDs(level.Reward.Dungeons),
+		}
+	}
+	return response
+}
+
+// newExperienceGrantResponse는 경험치 지급 결과를 응답 DTO로 변환합니다
+func newExperienceGrantResponse(result *services.ExperienceResult) dto.ExperienceGrantResponse {
+	return dto.ExperienceGrantResponse{
+		PlayerID:   result.Player.ID,
+		Amount:     result.Amount,
+		Level:      result.Player.Level,
+		Experience: result.Player.Experience,
+		LevelUps:   newLevelUpEventResponses(result.LevelUps),
+	}
+}
+
+// newLevelUpEventResponses는 레벨업 이벤트 목록을 응답 DTO로 변환합니다
+func newLevelUpEventResponses(events []services.LevelUpEvent) []dto.LevelUpEventResponse {
+	responses := make([]dto.LevelUpEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = dto.LevelUpEventResponse{
+			Level:    event.Level,
+			Gold:     event.Gold,
+			Items:    newItemRewardResponses(event.Items),
+			Dungeons: dungeonIDs(event.Dungeons),
+		}
+	}
+	return responses
+}
+
+// newItemRewardResponses는 보상 아이템 목록을 응답 DTO로 변환합니다
+func newItemRewardResponses(items []content.ItemReward) []dto.ItemRewardResponse {
+	responses := make([]dto.ItemRewardResponse, len(items))
+	for i, item := range items {
+		responses[i] = dto.ItemRewardResponse{
+			ItemID:   item.ID,
+			Quantity: item.Quantity,
+		}
+	}
+	return responses
+}
+
+// dungeonIDs는 던전 ID 목록을 반환합니다 (JSON에서 null 대신 빈 배열이 되도록 nil이면 빈 슬라이스)
+func dungeonIDs(ids []uint) []uint {
+	if ids == nil {
+		return []uint{}
+	}
+	return ids
+}
+
+// newDungeonUnlockResponses는 해금한 던전 목록을 응답 DTO로 변환합니다
+func newDungeonUnlockResponses(unlocks []models.DungeonUnlock) []dto.DungeonUnlockResponse {
+	responses := make([]dto.DungeonUnlockResponse, len(unlocks))
+	for i, unlock := range unlocks {
+		responses[i] = dto.DungeonUnlockResponse{
+			DungeonID:  unlock.DungeonID,
+			Level:      unlock.Level,
+			UnlockedAt: unlock.UnlockedAt,
+		}
+	}
+	return responses
+}
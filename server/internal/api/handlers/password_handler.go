@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PasswordHandler는 비밀번호 변경/재설정 핸들러입니다
+type PasswordHandler struct {
+	passwordService *services.PasswordService
+}
+
+// NewPasswordHandler는 새로운 PasswordHandler를 생성합니다
+func NewPasswordHandler(passwordService *services.PasswordService) *PasswordHandler {
+	return &PasswordHandler{
+		passwordService: passwordService,
+	}
+}
+
+// ChangePasswordRequest는 비밀번호 변경 요청 구조체입니다
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=6"`
+}
+
+// ForgotPasswordRequest는 비밀번호 재설정 코드 발급 요청 구조체입니다
+type ForgotPasswordRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+// ResetPasswordRequest는 비밀번호 재설정 요청 구조체입니다
+type ResetPasswordRequest struct {
+	Username    string `json:"username" binding:"required"`
+	Code        string `json:"code" binding:"required,max=16"` // 알림으로 받은 재설정 코드 (대소문자, 하이픈 무시)
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+// ChangePassword 비밀번호 변경
+// @Summary      비밀번호 변경
+// @Description  현재 비밀번호를 확인한 뒤 새 비밀번호로 변경합니다. 현재 기기를 제외한 모든 세션이 종료됩니다
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      ChangePasswordRequest  true  "현재 비밀번호와 새 비밀번호"
+// @Success      200      {object}  map[string]interface{}  "변경 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청 (현재 비밀번호 불일치, 동일한 비밀번호)"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      409      {object}  map[string]interface{}  "게스트 계정 (계정 연결 필요)"
+// @Failure      429      {object}  map[string]interface{}  "시도 횟수 초과 (Retry-After 헤더 참고)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me/password [put]
+func (h *PasswordHandler) ChangePassword(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	sessionID, _ := middleware.GetSessionID(c)
+
+	var req ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err := h.passwordService.ChangePassword(playerID, sessionID, req.CurrentPassword, req.NewPassword, c.ClientIP())
+	if respondLoginLocked(c, err) {
+		return
+	}
+	switch {
+	case err == nil:
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Password changed successfully",
+		})
+	case errors.Is(err, services.ErrIncorrectPassword):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Current password is incorrect",
+			"code":  "PASSWORD_INCORRECT",
+		})
+	case errors.Is(err, services.ErrPasswordUnchanged):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "New password must differ from current password",
+			"code":  "PASSWORD_UNCHANGED",
+		})
+	case errors.Is(err, services.ErrGuestAccount):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Guest account has no password, link the account first",
+			"code":  "GUEST_ACCOUNT",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to change password",
+			"details": err.Error(),
+		})
+	}
+}
+
+// ForgotPassword 비밀번호 재설정 코드 발급
+// @Summary      비밀번호 재설정 코드 발급
+// @Description  재설정 코드를 발급하여 등록된 알림 채널로 전달합니다. 계정 존재 여부와 관계없이 항상 같은 응답을 반환합니다
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ForgotPasswordRequest  true  "사용자명"
+// @Success      202      {object}  map[string]interface{}  "요청 접수"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      429      {object}  map[string]interface{}  "시도 횟수 초과 (Retry-After 헤더 참고)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/password/forgot [post]
+func (h *PasswordHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err := h.passwordService.RequestPasswordReset(req.Username, c.ClientIP())
+	if respondLoginLocked(c, err) {
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to request password reset",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "If the account exists, a reset code has been sent",
+	})
+}
+
+// ResetPassword 비밀번호 재설정
+// @Summary      비밀번호 재설정
+// @Description  재설정 코드로 새 비밀번호를 설정합니다. 코드는 한 번만 사용할 수 있으며, 성공 시 모든 기기에서 로그아웃됩니다
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ResetPasswordRequest  true  "사용자명, 재설정 코드, 새 비밀번호"
+// @Success      200      {object}  map[string]interface{}  "재설정 성공"
+// @Failure      400      {object}  map[string]interface{}  "잘못되었거나 만료된 코드"
+// @Failure      429      {object}  map[string]interface{}  "시도 횟수 초과 (Retry-After 헤더 참고)"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /auth/password/reset [post]
+func (h *PasswordHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err := h.passwordService.ResetPassword(req.Username, req.Code, req.NewPassword, c.ClientIP())
+	if respondLoginLocked(c, err) {
+		return
+	}
+	if errors.Is(err, services.ErrInvalidResetCode) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid or expired reset code",
+			"code":  "RESET_CODE_INVALID",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reset password",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password has been reset, please log in again",
+	})
+}
@@ -1,8 +1,14 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
 	"game_eating_pizza/internal/services"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -33,7 +39,7 @@ func NewPlayerHandler(playerService *services.PlayerService) *PlayerHandler {
 // @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
 // @Router       /players/me [get]
 func (h *PlayerHandler) GetMe(c *gin.Context) {
-	userID, exists := c.Get("userID")
+	playerID, exists := middleware.GetPlayerID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "User not authenticated",
@@ -41,16 +47,7 @@ func (h *PlayerHandler) GetMe(c *gin.Context) {
 		return
 	}
 
-	// TODO: userID를 uint로 변환하는 로직 필요
-	playerID, err := strconv.ParseUint(userID.(string), 10, 32)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid user ID",
-		})
-		return
-	}
-
-	player, err := h.playerService.GetPlayerByID(uint(playerID))
+	player, err := h.playerService.GetPlayerByID(playerID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Player not found",
@@ -58,37 +55,140 @@ func (h *PlayerHandler) GetMe(c *gin.Context) {
 		return
 	}
 
-	// DTO로 변환
-	response := dto.PlayerResponse{
-		ID:          player.ID,
-		Username:    player.Username,
-		Level:       player.Level,
-		Experience:  player.Experience,
-		Gold:        player.Gold,
-		MaxDistance: player.MaxDistance,
-		TotalKills:  player.TotalKills,
-		CreatedAt:   player.CreatedAt,
-		UpdatedAt:   player.UpdatedAt,
-	}
+	c.JSON(http.StatusOK, newPlayerResponse(player))
+}
 
-	c.JSON(http.StatusOK, response)
+// UpdateProfileRequest는 프로필 부분 수정 요청 구조체입니다 (보내지 않은 필드는 변경하지 않음)
+type UpdateProfileRequest struct {
+	DisplayName             *string                         `json:"display_name"` // 2-20자, 빈 문자열이면 표시 이름 삭제
+	AvatarID                *string                         `json:"avatar_id"`    // 예: "knight_01"
+	Locale                  *string                         `json:"locale"`       // ko, en, ja
+	Timezone                *string                         `json:"timezone"`     // IANA 시간대 이름 (예: "Asia/Seoul"), 하루 걸음 수 집계 기준
+	NotificationPreferences *NotificationPreferencesRequest `json:"notification_preferences"`
+}
+
+// NotificationPreferencesRequest는 알림 설정 부분 수정 요청 구조체입니다
+type NotificationPreferencesRequest struct {
+	ForgeComplete *bool `json:"forge_complete"`
+	StepGoal      *bool `json:"step_goal"`
+	Raid          *bool `json:"raid"`
+	Events        *bool `json:"events"`
+}
+
+// serverAuthoritativeFields는 서버만 변경할 수 있어 프로필 수정 요청에 포함되면 거부하는 필드입니다
+var serverAuthoritativeFields = []string{
+	"id", "username", "password", "level", "experience", "gold",
+	"max_distance", "total_kills", "stage", "idle_synced_at", "is_guest", "current_weapon_id",
+	"created_at", "updated_at",
 }
 
 // UpdateMe 내 정보 수정
 // @Summary      내 정보 수정
-// @Description  현재 로그인한 플레이어의 정보를 수정합니다
+// @Description  현재 로그인한 플레이어의 프로필(표시 이름, 아바타, 언어, 시간대, 알림 설정)을 부분 수정합니다. 골드, 레벨, 경험치 등 서버가 관리하는 필드가 포함되면 거부합니다
 // @Tags         players
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Success      200      {object}  map[string]interface{}  "수정 성공"
+// @Param        request  body      UpdateProfileRequest  true  "수정할 필드만 포함"
+// @Success      200      {object}  dto.PlayerResponse  "수정된 플레이어 정보"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청 (수정 불가 필드 포함, 필드 검증 실패)"
 // @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      404      {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
 // @Router       /players/me [put]
 func (h *PlayerHandler) UpdateMe(c *gin.Context) {
-	// TODO: 구현 필요
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error": "Not implemented yet",
-	})
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// 서버가 관리하는 필드를 보내면 조용히 무시하지 않고 명시적으로 거부합니다
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+	var forbidden []string
+	for _, field := range serverAuthoritativeFields {
+		if _, ok := fields[field]; ok {
+			forbidden = append(forbidden, field)
+		}
+	}
+	if len(forbidden) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "Fields are not editable",
+			"code":   "FIELD_NOT_EDITABLE",
+			"fields": forbidden,
+		})
+		return
+	}
+
+	var req UpdateProfileRequest
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	update := services.ProfileUpdate{
+		DisplayName: req.DisplayName,
+		AvatarID:    req.AvatarID,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+	}
+	if prefs := req.NotificationPreferences; prefs != nil {
+		update.Notifications = services.NotificationPreferencesUpdate{
+			ForgeComplete: prefs.ForgeComplete,
+			StepGoal:      prefs.StepGoal,
+			Raid:          prefs.Raid,
+			Events:        prefs.Events,
+		}
+	}
+
+	player, err := h.playerService.UpdateProfile(playerID, update)
+	var fieldErr *services.ProfileFieldError
+	if errors.As(err, &fieldErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid field",
+			"code":    "FIELD_INVALID",
+			"field":   fieldErr.Field,
+			"details": fieldErr.Reason,
+		})
+		return
+	}
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update profile",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newPlayerResponse(player))
 }
 
 // GetLeaderboard 리더보드 조회
@@ -121,3 +221,34 @@ func (h *PlayerHandler) GetLeaderboard(c *gin.Context) {
 		"players": players,
 	})
 }
+
+// newPlayerResponse는 플레이어 모델을 응답 DTO로 변환합니다
+func newPlayerResponse(player *models.Player) dto.PlayerResponse {
+	return dto.PlayerResponse{
+		ID:          player.ID,
+		Username:    player.Username,
+		Level:       player.Level,
+		Experience:  player.Experience,
+		Gold:        player.Balance(models.CurrencyGold),
+		Embers:      player.Balance(models.CurrencyEmbers),
+		Gems:        player.Balance(models.CurrencyGems),
+		MaxDistance: player.MaxDistance,
+		TotalKills:  player.TotalKills,
+		Stage:       player.Stage,
+		IsGuest:     player.IsGuest,
+		Role:        player.Role,
+		DisplayName: player.DisplayName,
+		AvatarID:    player.AvatarID,
+		Locale:      player.Locale,
+		Timezone:    player.Timezone,
+		NotificationPreferences: dto.NotificationPreferencesResponse{
+			ForgeComplete: player.Notifications.ForgeComplete,
+			StepGoal:      player.Notifications.StepGoal,
+			Raid:          player.Notifications.Raid,
+			Events:        player.Notifications.Events,
+		},
+		DeletionScheduledAt: player.DeletionScheduledAt,
+		CreatedAt:           player.CreatedAt,
+		UpdatedAt:           player.UpdatedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionHandler는 기기별 세션 관리 핸들러입니다
+type SessionHandler struct {
+	sessionService *services.SessionService
+}
+
+// NewSessionHandler는 새로운 SessionHandler를 생성합니다
+func NewSessionHandler(sessionService *services.SessionService) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// GetMySessions 내 세션 목록 조회
+// @Summary      내 세션 목록 조회
+// @Description  현재 로그인되어 있는 기기(휴대폰, 워치 등) 세션 목록을 최근 접속순으로 조회합니다
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  map[string][]dto.SessionResponse  "세션 목록"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me/sessions [get]
+func (h *SessionHandler) GetMySessions(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	currentSessionID, _ := middleware.GetSessionID(c)
+
+	sessions, err := h.sessionService.GetActiveSessions(playerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get sessions",
+		})
+		return
+	}
+
+	// DTO로 변환
+	responses := make([]dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = dto.SessionResponse{
+			ID:         session.ID,
+			DeviceName: session.DeviceName,
+			Platform:   session.Platform,
+			LastSeenAt: session.LastSeenAt,
+			LastIP:     session.LastIP,
+			Current:    session.ID == currentSessionID,
+			CreatedAt:  session.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sessions": responses,
+	})
+}
+
+// RevokeMySession 세션 종료
+// @Summary      세션 종료
+// @Description  지정한 기기 세션을 종료합니다. 종료된 세션의 액세스/리프레시 토큰은 즉시 사용할 수 없게 됩니다
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      int  true  "세션 ID"
+// @Success      200  {object}  map[string]interface{}  "세션 종료 성공"
+// @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "세션을 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /players/me/sessions/{id} [delete]
+func (h *SessionHandler) RevokeMySession(c *gin.Context) {
+	sessionIDStr := c.Param("id")
+	sessionID, err := strconv.ParseUint(sessionIDStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid session ID",
+		})
+		return
+	}
+
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	err = h.sessionService.RevokeSession(playerID, uint(sessionID))
+	if err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Session not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to revoke session",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Session revoked successfully",
+	})
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpgradeHandler는 활력의 불씨로 구매하는 영구 강화 관련 핸들러입니다
+type UpgradeHandler struct {
+	upgradeService *services.UpgradeService
+}
+
+// NewUpgradeHandler는 새로운 UpgradeHandler를 생성합니다
+func NewUpgradeHandler(upgradeService *services.UpgradeService) *UpgradeHandler {
+	return &UpgradeHandler{
+		upgradeService: upgradeService,
+	}
+}
+
+// GetUpgrades 영구 강화 트리 조회
+// @Summary      영구 강화 트리 조회
+// @Description  영구 강화 노드별 구매 단계, 다음 단계 비용, 잠금 여부와 합산된 능력치 보너스를 조회합니다
+// @Tags         upgrades
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.UpgradeTreeResponse  "영구 강화 트리"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /upgrades [get]
+func (h *UpgradeHandler) GetUpgrades(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	tree, err := h.upgradeService.GetTree(playerID)
+	if err != nil {
+		respondUpgradeError(c, err, "Failed to get upgrades")
+		return
+	}
+
+	c.JSON(http.StatusOK, newUpgradeTreeResponse(tree))
+}
+
+// PurchaseUpgrade 영구 강화 구매
+// @Summary      영구 강화 구매
+// @Description  활력의 불씨를 써서 영구 강화 노드의 다음 단계를 구매합니다. 불씨 차감과 단계 상승은 함께 처리됩니다
+// @Tags         upgrades
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "강화 노드 ID (예: quick_hands)"
+// @Success      200  {object}  dto.UpgradeTreeResponse  "구매 후 영구 강화 트리"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      402  {object}  map[string]interface{}  "활력의 불씨 부족"
+// @Failure      403  {object}  map[string]interface{}  "선행 강화 조건 미충족"
+// @Failure      404  {object}  map[string]interface{}  "강화 노드를 찾을 수 없음"
+// @Failure      409  {object}  map[string]interface{}  "최대 단계이거나 동시 구매와 충돌"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /upgrades/{id}/purchase [post]
+func (h *UpgradeHandler) PurchaseUpgrade(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	tree, err := h.upgradeService.Purchase(playerID, c.Param("id"))
+	if err != nil {
+		respondUpgradeError(c, err, "Failed to purchase upgrade")
+		return
+	}
+
+	c.JSON(http.StatusOK, newUpgradeTreeResponse(tree))
+}
+
+// respondUpgradeError는 영구 강화 에러를 HTTP 상태 코드로 변환합니다
+func respondUpgradeError(c *gin.Context, err error, message string) {
+	var fundsErr *services.InsufficientFundsError
+	switch {
+	case errors.As(err, &fundsErr):
+		c.JSON(http.StatusPaymentRequired, gin.H{
+			"error": "Insufficient embers",
+			"code":  "INSUFFICIENT_EMBERS",
+		})
+	case errors.Is(err, services.ErrUpgradeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Upgrade not found",
+		})
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+	case errors.Is(err, services.ErrUpgradeLocked):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Upgrade is locked",
+			"code":  "UPGRADE_LOCKED",
+		})
+	case errors.Is(err, services.ErrUpgradeMaxed):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Upgrade is already at max level",
+			"code":  "UPGRADE_MAXED",
+		})
+	case errors.Is(err, services.ErrUpgradeConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Upgrade was purchased by another request, please retry",
+			"code":  "UPGRADE_CONFLICT",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": message,
+		})
+	}
+}
+
+// newUpgradeTreeResponse는 영구 강화 트리를 응답 DTO로 변환합니다
+func newUpgradeTreeResponse(tree *services.UpgradeTree) dto.UpgradeTreeResponse {
+	response := dto.UpgradeTreeResponse{
+		Nodes: make([]dto.UpgradeNodeResponse, len(tree.Nodes)),
+		Bonuses: dto.StatBonusesResponse{
+			AttackSpeed: tree.Bonuses.AttackSpeed,
+			AttackPower: tree.Bonuses.AttackPower,
+		},
+		Embers: tree.Embers,
+	}
+	for i, status := range tree.Nodes {
+		response.Nodes[i] = dto.UpgradeNodeResponse{
+			ID:            status.Node.ID,
+			Name:          status.Node.Name,
+			Description:   status.Node.Description,
+			Stat:          status.Node.Stat,
+			BonusPerLevel: status.Node.BonusPerLevel,
+			Level:         status.Level,
+			MaxLevel:      status.Node.MaxLevel,
+			NextCost:      status.NextCost,
+			Requires:      status.Node.Requires,
+			RequiresLevel: status.Node.RequiresLevel,
+			Locked:        status.Locked,
+			Maxed:         status.Maxed,
+		}
+	}
+	return response
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WalletHandler는 플레이어 지갑과 재화 원장 관련 핸들러입니다
+type WalletHandler struct {
+	walletService *services.WalletService
+}
+
+// NewWalletHandler는 새로운 WalletHandler를 생성합니다
+func NewWalletHandler(walletService *services.WalletService) *WalletHandler {
+	return &WalletHandler{
+		walletService: walletService,
+	}
+}
+
+// GetWallet 지갑 조회
+// @Summary      지갑 조회
+// @Description  현재 로그인한 플레이어의 재화별 잔액(골드, 활력의 불씨, 젬, 무기 파편)을 조회합니다
+// @Tags         wallet
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.WalletResponse  "재화별 잔액"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /wallet [get]
+func (h *WalletHandler) GetWallet(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	balances, err := h.walletService.GetBalances(playerID)
+	if errors.Is(err, services.ErrPlayerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get wallet",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newWalletResponse(balances))
+}
+
+// GetLedger 재화 원장 조회
+// @Summary      재화 원장 조회
+// @Description  현재 로그인한 플레이어의 재화 지급/차감 내역을 최신순으로 조회합니다
+// @Tags         wallet
+// @Produce      json
+// @Security     BearerAuth
+// @Param        currency  query     string  false  "재화 종류 (gold, embers, gems, scrap, 생략하면 전체)"
+// @Param        limit     query     int     false  "조회 개수 (기본값: 50, 최대: 200)"
+// @Param        offset    query     int     false  "건너뛸 개수"
+// @Success      200       {object}  map[string]interface{}  "원장 항목 목록"
+// @Failure      400       {object}  map[string]interface{}  "잘못된 재화 종류"
+// @Failure      401       {object}  map[string]interface{}  "인증 실패"
+// @Failure      500       {object}  map[string]interface{}  "서버 오류"
+// @Router       /wallet/ledger [get]
+func (h *WalletHandler) GetLedger(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	limit, offset := parsePagination(c)
+
+	entries, total, err := h.walletService.GetLedger(playerID, c.Query("currency"), limit, offset)
+	if errors.Is(err, services.ErrInvalidCurrency) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid currency",
+			"code":  "INVALID_CURRENCY",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get wallet ledger",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": newWalletLedgerEntryResponses(entries),
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// newWalletResponse는 재화별 잔액을 응답 DTO로 변환합니다
+func newWalletResponse(balances []models.WalletBalance) dto.WalletResponse {
+	response := dto.WalletResponse{Balances: make([]dto.WalletBalanceResponse, len(balances))}
+	for i, balance := range balances {
+		response.Balances[i] = dto.WalletBalanceResponse{
+			Currency: balance.Currency,
+			Balance:  balance.Balance,
+		}
+	}
+	return response
+}
+
+// newWalletLedgerEntryResponses는 원장 항목을 응답 DTO로 변환합니다
+func newWalletLedgerEntryResponses(entries []models.WalletLedgerEntry) []dto.WalletLedgerEntryResponse {
+	responses := make([]dto.WalletLedgerEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = dto.WalletLedgerEntryResponse{
+			ID:           entry.ID,
+			Currency:     entry.Currency,
+			Amount:       entry.Amount,
+			BalanceAfter: entry.BalanceAfter,
+			Reason:       entry.Reason,
+			RefType:      entry.RefType,
+			RefID:        entry.RefID,
+			Note:         entry.Note,
+			CreatedAt:    entry.CreatedAt,
+		}
+	}
+	return responses
+}
@@ -1,29 +1,36 @@
 package handlers
 
 import (
+	"errors"
 	"game_eating_pizza/internal/api/dto"
+	"game_eating_pizza/internal/api/middleware"
+	"game_eating_pizza/internal/models"
 	"game_eating_pizza/internal/services"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
 // WeaponHandler는 무기 관련 핸들러입니다
 type WeaponHandler struct {
-	weaponService *services.WeaponService
+	weaponService  *services.WeaponService
+	upgradeService *services.UpgradeService
 }
 
 // NewWeaponHandler는 새로운 WeaponHandler를 생성합니다
-func NewWeaponHandler(weaponService *services.WeaponService) *WeaponHandler {
+func NewWeaponHandler(weaponService *services.WeaponService, upgradeService *services.UpgradeService) *WeaponHandler {
 	return &WeaponHandler{
-		weaponService: weaponService,
+		weaponService:  weaponService,
+		upgradeService: upgradeService,
 	}
 }
 
 // GetWeapons 무기 목록 조회
 // @Summary      무기 목록 조회
-// @Description  현재 로그인한 플레이어의 무기 목록을 조회합니다
+// @Description  현재 로그인한 플레이어의 무기 목록을 조회합니다. 영구 강화 보너스를 적용한 능력치(effective_*)를 함께 반환합니다
 // @Tags         weapons
 // @Accept       json
 // @Produce      json
@@ -33,7 +40,7 @@ func NewWeaponHandler(weaponService *services.WeaponService) *WeaponHandler {
 // @Failure      500      {object}  map[string]interface{}  "서버 오류"
 // @Router       /weapons [get]
 func (h *WeaponHandler) GetWeapons(c *gin.Context) {
-	userID, exists := c.Get("userID")
+	playerID, exists := middleware.GetPlayerID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "User not authenticated",
@@ -41,16 +48,15 @@ func (h *WeaponHandler) GetWeapons(c *gin.Context) {
 		return
 	}
 
-	// TODO: userID를 uint로 변환하는 로직 필요
-	playerID, err := strconv.ParseUint(userID.(string), 10, 32)
+	weapons, err := h.weaponService.GetWeaponsByPlayerID(playerID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid user ID",
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get weapons",
 		})
 		return
 	}
 
-	weapons, err := h.weaponService.GetWeaponsByPlayerID(uint(playerID))
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get weapons",
@@ -60,19 +66,8 @@ func (h *WeaponHandler) GetWeapons(c *gin.Context) {
 
 	// DTO로 변환
 	responses := make([]dto.WeaponResponse, len(weapons))
-	for i, weapon := range weapons {
-		responses[i] = dto.WeaponResponse{
-			ID:          weapon.ID,
-			PlayerID:    weapon.PlayerID,
-			Name:        weapon.Name,
-			Type:        weapon.Type,
-			AttackPower: weapon.AttackPower,
-			AttackSpeed: weapon.AttackSpeed,
-			Rarity:      weapon.Rarity,
-			Level:       weapon.Level,
-			CreatedAt:   weapon.CreatedAt,
-			UpdatedAt:   weapon.UpdatedAt,
-		}
+	for i := range weapons {
+		responses[i] = newOwnedWeaponResponse(&weapons[i], bonuses)
 	}
 
 	c.JSON(http.StatusOK, gin.H{
@@ -80,26 +75,9 @@ func (h *WeaponHandler) GetWeapons(c *gin.Context) {
 	})
 }
 
-// CreateWeapon 무기 생성
-// @Summary      무기 생성
-// @Description  새로운 무기를 생성합니다
-// @Tags         weapons
-// @Accept       json
-// @Produce      json
-// @Security     BearerAuth
-// @Success      201      {object}  map[string]interface{}  "무기 생성 성공"
-// @Failure      401      {object}  map[string]interface{}  "인증 실패"
-// @Router       /weapons [post]
-func (h *WeaponHandler) CreateWeapon(c *gin.Context) {
-	// TODO: 구현 필요
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error": "Not implemented yet",
-	})
-}
-
 // UpgradeWeapon 무기 강화
 // @Summary      무기 강화
-// @Description  무기를 강화하여 공격력을 증가시킵니다
+// @Description  본인 무기를 한 단계 강화하여 공격력을 증가시킵니다. 비용(현재 레벨 × 100 골드) 차감과 강화는 함께 처리됩니다
 // @Tags         weapons
 // @Accept       json
 // @Produce      json
@@ -108,42 +86,412 @@ func (h *WeaponHandler) CreateWeapon(c *gin.Context) {
 // @Success      200  {object}  dto.WeaponResponse  "강화된 무기 정보"
 // @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
 // @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      402  {object}  map[string]interface{}  "골드 부족"
+// @Failure      403  {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404  {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      409  {object}  map[string]interface{}  "동시 강화 요청과 충돌"
 // @Failure      500  {object}  map[string]interface{}  "서버 오류"
 // @Router       /weapons/{id}/upgrade [put]
 func (h *WeaponHandler) UpgradeWeapon(c *gin.Context) {
-	weaponIDStr := c.Param("id")
-	weaponID, err := strconv.ParseUint(weaponIDStr, 10, 32)
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	weaponID, ok := parseIDParam(c, "Invalid weapon ID")
+	if !ok {
+		return
+	}
+
+	weapon, err := h.weaponService.UpgradeWeapon(playerID, weaponID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to upgrade weapon")
+		return
+	}
+
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
 	if err != nil {
+		respondWeaponError(c, err, "Failed to upgrade weapon")
+		return
+	}
+
+	c.JSON(http.StatusOK, newOwnedWeaponResponse(weapon, bonuses))
+}
+
+// PromoteWeaponRequest는 무기 등급 승급 요청 구조체입니다
+type PromoteWeaponRequest struct {
+	MaterialIDs []uint `json:"material_ids"` // 소모할 같은 종류·등급 무기 (개수는 등급 표의 duplicates)
+}
+
+// GetRarityTiers 무기 등급 표 조회
+// @Summary      무기 등급 표 조회
+// @Description  콘텐츠의 등급별 공격력 배율, 판매·분해 기본 보상, 특수 효과 수와 각 등급으로 승급하는 조건(성공 확률, 천장, 소모 무기 수, 골드)을 낮은 등급부터 조회합니다
+// @Tags         weapons
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}  "등급 표"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Router       /weapons/promotions [get]
+func (h *WeaponHandler) GetRarityTiers(c *gin.Context) {
+	tiers := h.weaponService.GetRarityTiers()
+	responses := make([]dto.RarityTierResponse, len(tiers))
+	for i, tier := range tiers {
+		responses[i] = dto.RarityTierResponse{
+			Rarity:            tier.ID,
+			StatMultiplier:    tier.StatMultiplier,
+			SellPrice:         tier.SellPrice,
+			DismantleScrap:    tier.DismantleScrap,
+			EffectSlots:       tier.EffectSlots,
+			ForgeEffectChance: tier.ForgeEffectChance,
+		}
+		if promotion := tier.Promotion; promotion != nil {
+			responses[i].SuccessRate = promotion.SuccessRate
+			responses[i].Pity = promotion.Pity
+			responses[i].Duplicates = promotion.Duplicates
+			responses[i].GoldCost = promotion.GoldCost
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"tiers": responses,
+	})
+}
+
+// PromoteWeapon 무기 등급 승급
+// @Summary      무기 등급 승급
+// @Description  같은 종류·등급의 무기를 재료로 소모하고 골드를 내서 본인 무기의 등급을 한 단계 올립니다. 성공 확률에 따라 실패할 수 있으며 실패해도 재료와 골드는 소모됩니다. 연속 실패가 천장에 이르면 다음 시도는 반드시 성공합니다
+// @Tags         weapons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                   true  "무기 ID"
+// @Param        request  body      PromoteWeaponRequest  true  "재료 무기"
+// @Success      200      {object}  dto.WeaponPromotionResponse  "승급 결과 (실패 포함)"
+// @Failure      400      {object}  map[string]interface{}  "재료 무기가 조건에 맞지 않음"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      402      {object}  map[string]interface{}  "골드 부족"
+// @Failure      403      {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404      {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "최고 등급이거나 동시 요청과 충돌"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/{id}/promote [post]
+func (h *WeaponHandler) PromoteWeapon(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	weaponID, ok := parseIDParam(c, "Invalid weapon ID")
+	if !ok {
+		return
+	}
+
+	var req PromoteWeaponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid weapon ID",
+			"error":   "Invalid request",
+			"details": err.Error(),
 		})
 		return
 	}
 
-	weapon, err := h.weaponService.UpgradeWeapon(uint(weaponID))
+	result, err := h.weaponService.PromoteWeapon(playerID, weaponID, req.MaterialIDs)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to upgrade weapon",
+		respondWeaponError(c, err, "Failed to promote weapon")
+		return
+	}
+
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to promote weapon")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WeaponPromotionResponse{
+		Success:     result.Success,
+		Guaranteed:  result.Guaranteed,
+		FromRarity:  result.FromRarity,
+		SuccessRate: result.SuccessRate,
+		Pity:        result.Pity,
+		ConsumedIDs: result.ConsumedIDs,
+		GoldSpent:   result.GoldSpent,
+		Weapon:      newOwnedWeaponResponse(result.Weapon, bonuses),
+	})
+}
+
+// EnchantWeaponRequest는 무기 특수 효과 부여 요청 구조체입니다
+type EnchantWeaponRequest struct {
+	Type string `json:"type" binding:"required"` // 콘텐츠 특수 효과 ID (예: fire)
+}
+
+// EnchantWeapon 무기 특수 효과 부여
+// @Summary      무기 특수 효과 부여
+// @Description  활력의 불씨를 내서 본인 무기에 특수 효과를 붙입니다. 효과 종류와 비용, 효과별 수치 범위는 게임 콘텐츠의 enchant 섹션을 따릅니다. 같은 종류의 효과가 이미 있으면 효과량과 발동 확률을 다시 굴리고, 없으면 등급별 슬롯(콘텐츠 등급 표의 effect_slots)에 여유가 있을 때만 새로 붙입니다
+// @Tags         weapons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                   true  "무기 ID"
+// @Param        request  body      EnchantWeaponRequest  true  "특수 효과 종류"
+// @Success      200      {object}  dto.WeaponResponse      "효과를 부여한 무기"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 효과 종류"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      402      {object}  map[string]interface{}  "활력의 불씨 부족"
+// @Failure      403      {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404      {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "특수 효과 슬롯이 가득 참"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/{id}/enchant [post]
+func (h *WeaponHandler) EnchantWeapon(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	weaponID, ok := parseIDParam(c, "Invalid weapon ID")
+	if !ok {
+		return
+	}
+
+	var req EnchantWeaponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
 			"details": err.Error(),
 		})
 		return
 	}
 
-	// DTO로 변환
-	response := dto.WeaponResponse{
-		ID:          weapon.ID,
-		PlayerID:    weapon.PlayerID,
-		Name:        weapon.Name,
-		Type:        weapon.Type,
-		AttackPower: weapon.AttackPower,
-		AttackSpeed: weapon.AttackSpeed,
-		Rarity:      weapon.Rarity,
-		Level:       weapon.Level,
-		CreatedAt:   weapon.CreatedAt,
-		UpdatedAt:   weapon.UpdatedAt,
+	weapon, err := h.weaponService.EnchantWeapon(playerID, weaponID, req.Type)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to enchant weapon")
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to enchant weapon")
+		return
+	}
+
+	c.JSON(http.StatusOK, newOwnedWeaponResponse(weapon, bonuses))
+}
+
+// LockWeaponRequest는 무기 잠금 요청 구조체입니다
+type LockWeaponRequest struct {
+	Locked *bool `json:"locked" binding:"required"`
+}
+
+// LockWeapon 무기 잠금
+// @Summary      무기 잠금
+// @Description  본인 무기를 잠그거나 잠금을 풉니다. 잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없습니다
+// @Tags         weapons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      int                true  "무기 ID"
+// @Param        request  body      LockWeaponRequest  true  "잠금 여부"
+// @Success      200      {object}  dto.WeaponResponse      "잠금 여부를 바꾼 무기"
+// @Failure      400      {object}  map[string]interface{}  "잘못된 요청"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404      {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/{id}/lock [put]
+func (h *WeaponHandler) LockWeapon(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+	weaponID, ok := parseIDParam(c, "Invalid weapon ID")
+	if !ok {
+		return
+	}
+
+	var req LockWeaponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	weapon, err := h.weaponService.SetWeaponLocked(playerID, weaponID, *req.Locked)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to lock weapon")
+		return
+	}
+
+	bonuses, err := h.upgradeService.GetStatBonuses(playerID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to lock weapon")
+		return
+	}
+
+	c.JSON(http.StatusOK, newOwnedWeaponResponse(weapon, bonuses))
+}
+
+// DisposeWeaponsRequest는 무기 판매·분해 요청 구조체입니다 (weapon_ids와 rarities 중 하나만 지정)
+type DisposeWeaponsRequest struct {
+	WeaponIDs []uint   `json:"weapon_ids"` // 처분할 무기 (하나라도 잠겼거나 장착 중이면 전체 거부)
+	Rarities  []string `json:"rarities"`   // 이 등급의 무기를 모두 처분 (잠긴 무기와 장착 중인 무기는 건너뜀)
+}
+
+// SellWeapons 무기 판매
+// @Summary      무기 판매
+// @Description  본인 무기를 판매하고 골드를 받습니다. 가격은 등급별 기본 가격에 강화에 쓴 골드의 일부(콘텐츠의 weapon_upgrade.sell_refund, 기본 25%)를 더한 값입니다. weapon_ids로 무기를 지정하거나 rarities로 등급을 골라 한 번에 판매합니다. 잠긴 무기와 장착 중인 무기는 판매할 수 없습니다
+// @Tags         weapons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      DisposeWeaponsRequest  true  "판매할 무기"
+// @Success      200      {object}  dto.WeaponDisposalResponse  "판매 결과"
+// @Failure      400      {object}  map[string]interface{}  "무기 ID와 등급 중 하나만 지정하지 않았거나 잘못된 등급"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404      {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "잠겼거나 장착 중인 무기, 또는 동시 요청과 충돌"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/sell [post]
+func (h *WeaponHandler) SellWeapons(c *gin.Context) {
+	h.disposeWeapons(c, h.weaponService.SellWeapons, "Failed to sell weapons")
+}
+
+// DismantleWeapons 무기 분해
+// @Summary      무기 분해
+// @Description  본인 무기를 분해하고 무기 파편(scrap)을 받습니다. 파편 수는 등급별 기본 수에 강화 단계마다 1개를 더한 값입니다. weapon_ids로 무기를 지정하거나 rarities로 등급을 골라 한 번에 분해합니다. 잠긴 무기와 장착 중인 무기는 분해할 수 없습니다
+// @Tags         weapons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      DisposeWeaponsRequest  true  "분해할 무기"
+// @Success      200      {object}  dto.WeaponDisposalResponse  "분해 결과"
+// @Failure      400      {object}  map[string]interface{}  "무기 ID와 등급 중 하나만 지정하지 않았거나 잘못된 등급"
+// @Failure      401      {object}  map[string]interface{}  "인증 실패"
+// @Failure      403      {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404      {object}  map[string]interface{}  "무기를 찾을 수 없음"
+// @Failure      409      {object}  map[string]interface{}  "잠겼거나 장착 중인 무기, 또는 동시 요청과 충돌"
+// @Failure      500      {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/dismantle [post]
+func (h *WeaponHandler) DismantleWeapons(c *gin.Context) {
+	h.disposeWeapons(c, h.weaponService.DismantleWeapons, "Failed to dismantle weapons")
+}
+
+// disposeWeapons는 판매·분해 요청을 처리하고 결과와 처분 후 보관함 상태를 응답합니다
+func (h *WeaponHandler) disposeWeapons(
+	c *gin.Context,
+	dispose func(uint, services.WeaponDisposal) (*services.WeaponDisposalResult, error),
+	message string,
+) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	var req DisposeWeaponsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	result, err := dispose(playerID, services.WeaponDisposal{
+		WeaponIDs: req.WeaponIDs,
+		Rarities:  req.Rarities,
+	})
+	if err != nil {
+		respondWeaponError(c, err, message)
+		return
+	}
+
+	inventory, err := h.weaponService.GetInventory(playerID)
+	if err != nil {
+		respondWeaponError(c, err, message)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WeaponDisposalResponse{
+		WeaponIDs: result.WeaponIDs,
+		Skipped:   result.Skipped,
+		Currency:  result.Currency,
+		Amount:    result.Amount,
+		Inventory: newWeaponInventoryResponse(inventory),
+	})
+}
+
+// GetInventory 무기 보관함 조회
+// @Summary      무기 보관함 조회
+// @Description  보유한 무기 수, 보관 가능 수, 최대 보관 수와 다음 확장 비용을 조회합니다. 보관함이 가득 차면 대장간에서 새 무기를 제작하거나 수령할 수 없습니다
+// @Tags         weapons
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.WeaponInventoryResponse  "무기 보관함 상태"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/inventory [get]
+func (h *WeaponHandler) GetInventory(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	inventory, err := h.weaponService.GetInventory(playerID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to get weapon inventory")
+		return
+	}
+
+	c.JSON(http.StatusOK, newWeaponInventoryResponse(inventory))
+}
+
+// ExpandInventory 무기 보관함 확장
+// @Summary      무기 보관함 확장
+// @Description  보석을 내서 무기 보관함을 한 단계 확장합니다. 보석 차감과 확장은 함께 처리됩니다
+// @Tags         weapons
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.WeaponInventoryResponse  "확장 후 무기 보관함 상태"
+// @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      402  {object}  map[string]interface{}  "보석 부족"
+// @Failure      404  {object}  map[string]interface{}  "플레이어를 찾을 수 없음"
+// @Failure      409  {object}  map[string]interface{}  "이미 최대 크기이거나 동시 요청과 충돌"
+// @Failure      500  {object}  map[string]interface{}  "서버 오류"
+// @Router       /weapons/inventory/expand [post]
+func (h *WeaponHandler) ExpandInventory(c *gin.Context) {
+	playerID, exists := middleware.GetPlayerID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "User not authenticated",
+		})
+		return
+	}
+
+	inventory, err := h.weaponService.ExpandInventory(playerID)
+	if err != nil {
+		respondWeaponError(c, err, "Failed to expand weapon inventory")
+		return
+	}
+
+	c.JSON(http.StatusOK, newWeaponInventoryResponse(inventory))
 }
 
 // EquipWeapon 무기 장착
@@ -157,6 +505,8 @@ func (h *WeaponHandler) UpgradeWeapon(c *gin.Context) {
 // @Success      200  {object}  map[string]interface{}  "장착 성공"
 // @Failure      400  {object}  map[string]interface{}  "잘못된 요청"
 // @Failure      401  {object}  map[string]interface{}  "인증 실패"
+// @Failure      403  {object}  map[string]interface{}  "다른 플레이어의 무기"
+// @Failure      404  {object}  map[string]interface{}  "무기를 찾을 수 없음"
 // @Failure      500  {object}  map[string]interface{}  "서버 오류"
 // @Router       /weapons/{id}/equip [put]
 func (h *WeaponHandler) EquipWeapon(c *gin.Context) {
@@ -169,7 +519,7 @@ func (h *WeaponHandler) EquipWeapon(c *gin.Context) {
 		return
 	}
 
-	userID, exists := c.Get("userID")
+	playerID, exists := middleware.GetPlayerID(c)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "User not authenticated",
@@ -177,24 +527,151 @@ func (h *WeaponHandler) EquipWeapon(c *gin.Context) {
 		return
 	}
 
-	playerID, err := strconv.ParseUint(userID.(string), 10, 32)
+	err = h.weaponService.EquipWeapon(playerID, uint(weaponID))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid user ID",
-		})
+		respondWeaponError(c, err, "Failed to equip weapon")
 		return
 	}
 
-	err = h.weaponService.EquipWeapon(uint(playerID), uint(weaponID))
-	if err != nil {
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Weapon equipped successfully",
+	})
+}
+
+// respondWeaponError는 무기 에러를 HTTP 상태 코드로 변환합니다
+func respondWeaponError(c *gin.Context, err error, message string) {
+	var fundsErr *services.InsufficientFundsError
+	var materialErr *services.PromotionMaterialError
+	var disposableErr *services.WeaponNotDisposableError
+	switch {
+	case errors.As(err, &fundsErr):
+		c.JSON(http.StatusPaymentRequired, gin.H{
+			"error": "Insufficient " + fundsErr.Currency,
+			"code":  "INSUFFICIENT_" + strings.ToUpper(fundsErr.Currency),
+		})
+	case errors.As(err, &materialErr):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":     "Invalid promotion materials",
+			"code":      "INVALID_PROMOTION_MATERIALS",
+			"weapon_id": materialErr.WeaponID,
+			"details":   materialErr.Reason,
+		})
+	case errors.As(err, &disposableErr):
+		c.JSON(http.StatusConflict, gin.H{
+			"error":     "Weapon is " + disposableErr.Reason,
+			"code":      "WEAPON_" + strings.ToUpper(disposableErr.Reason),
+			"weapon_id": disposableErr.WeaponID,
+		})
+	case errors.Is(err, services.ErrInvalidDisposal):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Specify either weapon_ids or valid rarities",
+			"code":  "INVALID_DISPOSAL",
+		})
+	case errors.Is(err, services.ErrInvalidWeaponEffect):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid weapon effect type",
+			"code":  "INVALID_WEAPON_EFFECT",
+		})
+	case errors.Is(err, services.ErrWeaponNotOwned):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Weapon does not belong to player",
+			"code":  middleware.ErrCodeForbidden,
+		})
+	case errors.Is(err, services.ErrWeaponNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Weapon not found",
+		})
+	case errors.Is(err, services.ErrPlayerNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+	case errors.Is(err, services.ErrWeaponUpgradeConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon was upgraded by another request, please retry",
+			"code":  "WEAPON_UPGRADE_CONFLICT",
+		})
+	case errors.Is(err, services.ErrWeaponMaxRarity):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon is already at the highest rarity",
+			"code":  "WEAPON_MAX_RARITY",
+		})
+	case errors.Is(err, services.ErrWeaponPromotionConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon or materials changed by another request, please retry",
+			"code":  "WEAPON_PROMOTION_CONFLICT",
+		})
+	case errors.Is(err, services.ErrWeaponEffectSlotsFull):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon effect slots are full",
+			"code":  "WEAPON_EFFECT_SLOTS_FULL",
+		})
+	case errors.Is(err, services.ErrWeaponDisposalConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapons changed by another request, please retry",
+			"code":  "WEAPON_DISPOSAL_CONFLICT",
+		})
+	case errors.Is(err, services.ErrInventoryMaxCapacity):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon inventory is already at max capacity",
+			"code":  "INVENTORY_MAX_CAPACITY",
+		})
+	case errors.Is(err, services.ErrInventoryExpandConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Weapon inventory was expanded by another request, please retry",
+			"code":  "INVENTORY_EXPAND_CONFLICT",
+		})
+	default:
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to equip weapon",
-			"details": err.Error(),
+			"error": message,
 		})
-		return
 	}
+}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Weapon equipped successfully",
-	})
+// newWeaponInventoryResponse는 무기 보관함 상태를 응답 DTO로 변환합니다
+func newWeaponInventoryResponse(inventory *services.WeaponInventory) dto.WeaponInventoryResponse {
+	return dto.WeaponInventoryResponse{
+		Used:        inventory.Used,
+		Capacity:    inventory.Capacity,
+		MaxCapacity: inventory.MaxCapacity,
+		ExpandStep:  inventory.ExpandStep,
+		ExpandCost:  inventory.ExpandCost,
+	}
+}
+
+// newWeaponResponse는 무기 모델을 응답 DTO로 변환합니다
+func newWeaponResponse(weapon *models.Weapon) dto.WeaponResponse {
+	effects := make([]dto.WeaponEffectResponse, len(weapon.Effects))
+	for i, effect := range weapon.Effects {
+		effects[i] = dto.WeaponEffectResponse{
+			Type:       effect.Type,
+			Magnitude:  effect.Magnitude,
+			ProcChance: effect.ProcChance,
+		}
+	}
+
+	return dto.WeaponResponse{
+		ID:          weapon.ID,
+		PlayerID:    weapon.PlayerID,
+		Name:        weapon.Name,
+		Type:        weapon.Type,
+		AttackPower: weapon.AttackPower,
+		AttackSpeed: weapon.AttackSpeed,
+		Rarity:      weapon.Rarity,
+		Level:       weapon.Level,
+		CreatedAt:   weapon.CreatedAt,
+		UpdatedAt:   weapon.UpdatedAt,
+
+		PromotionFailures: weapon.PromotionFailures,
+		Locked:            weapon.Locked,
+		Effects:           effects,
+	}
+}
+
+// newOwnedWeaponResponse는 본인 무기를 영구 강화 보너스와 특수 효과를 적용한 능력치와 함께 응답 DTO로 변환합니다
+func newOwnedWeaponResponse(weapon *models.Weapon, bonuses models.StatBonuses) dto.WeaponResponse {
+	response := newWeaponResponse(weapon)
+	response.EffectiveAttackPower = weapon.EffectiveAttackPower(bonuses)
+	response.EffectiveAttackSpeed = weapon.EffectiveAttackSpeed(bonuses)
+	response.DamagePerSecond = math.Round(weapon.DamagePerSecond(bonuses)*100) / 100
+	return response
 }
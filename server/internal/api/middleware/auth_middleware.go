@@ -1,46 +1,130 @@
 package middleware
 
 import (
+	"errors"
 	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/services"
+	"game_eating_pizza/pkg/token"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
-// AuthMiddleware는 JWT 토큰을 검증하는 미들웨어입니다
-// TODO: JWT 검증 로직 구현 필요
-func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
+// ContextKeyPlayerID는 인증된 플레이어 ID(uint)를 gin.Context에 저장할 때 사용하는 키입니다
+const ContextKeyPlayerID = "playerID"
+
+// ContextKeySessionID는 액세스 토큰을 발급한 세션 ID(uint)를 저장할 때 사용하는 키입니다
+const ContextKeySessionID = "sessionID"
+
+// 인증 실패 시 클라이언트가 분기할 수 있도록 내려주는 에러 코드입니다
+const (
+	ErrCodeAuthHeaderMissing = "AUTH_HEADER_MISSING"
+	ErrCodeAuthHeaderInvalid = "AUTH_HEADER_INVALID"
+	ErrCodeTokenExpired      = "TOKEN_EXPIRED"
+	ErrCodeTokenInvalid      = "TOKEN_INVALID"
+	ErrCodeSessionRevoked    = "SESSION_REVOKED"
+	ErrCodeAccountSuspended  = "ACCOUNT_SUSPENDED"
+)
+
+// AuthMiddleware는 JWT 액세스 토큰과 토큰을 발급한 세션, 플레이어의 이용 정지 여부를 검증하는 미들웨어입니다
+// 검증에 성공하면 플레이어 ID와 세션 ID를 uint 타입으로 컨텍스트에 저장합니다
+func AuthMiddleware(cfg *config.Config, sessionService *services.SessionService, suspensionService *services.SuspensionService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Authorization header required",
-			})
-			c.Abort()
+			abortUnauthorized(c, ErrCodeAuthHeaderMissing, "Authorization header required")
 			return
 		}
 
 		// "Bearer <token>" 형식에서 토큰 추출
 		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid authorization header format",
+		if len(parts) != 2 || parts[0] != "Bearer" || parts[1] == "" {
+			abortUnauthorized(c, ErrCodeAuthHeaderInvalid, "Invalid authorization header format")
+			return
+		}
+
+		claims, err := token.ParseAccessToken(parts[1], cfg.JWTSecret)
+		if err != nil {
+			if errors.Is(err, token.ErrTokenExpired) {
+				abortUnauthorized(c, ErrCodeTokenExpired, "Token expired")
+				return
+			}
+			abortUnauthorized(c, ErrCodeTokenInvalid, "Invalid token")
+			return
+		}
+
+		// 종료된 세션의 토큰은 만료 전이라도 즉시 거부합니다
+		if err := sessionService.ValidateSession(claims.PlayerID, claims.SessionID, c.ClientIP()); err != nil {
+			if errors.Is(err, services.ErrSessionNotFound) || errors.Is(err, services.ErrSessionRevoked) {
+				abortUnauthorized(c, ErrCodeSessionRevoked, "Session has been revoked")
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to validate session",
 			})
 			c.Abort()
 			return
 		}
 
-		token := parts[1]
+		// 이용 정지된 계정은 정지 이전에 발급된 토큰이라도 거부합니다
+		if err := suspensionService.Check(claims.PlayerID); err != nil {
+			var suspendedErr *services.AccountSuspendedError
+			if errors.As(err, &suspendedErr) {
+				c.JSON(http.StatusForbidden, gin.H{
+					"error":      "Account is suspended",
+					"code":       ErrCodeAccountSuspended,
+					"reason":     suspendedErr.Suspension.Reason,
+					"expires_at": suspendedErr.Suspension.ExpiresAt,
+				})
+				c.Abort()
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to check account suspension",
+			})
+			c.Abort()
+			return
+		}
 
-		// TODO: JWT 토큰 검증 및 사용자 ID 추출
-		// 임시로 토큰을 사용자 ID로 사용 (실제로는 JWT 파싱 필요)
-		// 예: userID, err := jwt.ValidateToken(token, cfg.JWTSecret)
-		
-		// 임시 구현: 토큰을 사용자 ID로 사용 (개발용)
-		// 프로덕션에서는 반드시 JWT 검증 로직 구현 필요
-		c.Set("userID", token) // 임시
+		c.Set(ContextKeyPlayerID, claims.PlayerID)
+		c.Set(ContextKeySessionID, claims.SessionID)
 
 		c.Next()
 	}
 }
+
+// GetPlayerID는 AuthMiddleware가 저장한 플레이어 ID를 꺼냅니다
+func GetPlayerID(c *gin.Context) (uint, bool) {
+	value, exists := c.Get(ContextKeyPlayerID)
+	if !exists {
+		return 0, false
+	}
+	playerID, ok := value.(uint)
+	if !ok || playerID == 0 {
+		return 0, false
+	}
+	return playerID, true
+}
+
+// GetSessionID는 AuthMiddleware가 저장한 세션 ID를 꺼냅니다
+func GetSessionID(c *gin.Context) (uint, bool) {
+	value, exists := c.Get(ContextKeySessionID)
+	if !exists {
+		return 0, false
+	}
+	sessionID, ok := value.(uint)
+	if !ok || sessionID == 0 {
+		return 0, false
+	}
+	return sessionID, true
+}
+
+// abortUnauthorized는 401 응답을 내려주고 요청 처리를 중단합니다
+func abortUnauthorized(c *gin.Context, code, message string) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": message,
+		"code":  code,
+	})
+	c.Abort()
+}
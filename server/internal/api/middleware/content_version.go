@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"game_eating_pizza/internal/content"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderContentVersion은 응답에 현재 게임 콘텐츠 버전을 담는 헤더입니다
+const HeaderContentVersion = "X-Content-Version"
+
+// ContentVersion은 모든 응답에 현재 적용 중인 게임 콘텐츠 버전 헤더를 붙이는 미들웨어입니다
+// 클라이언트는 이 값이 바뀌면 레시피 등 캐시한 콘텐츠를 다시 받아야 합니다
+func ContentVersion(store *content.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(HeaderContentVersion, store.Current().Version)
+		c.Next()
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContextKeyPlayerRole은 RequireRole이 조회한 플레이어 역할(string)을 저장할 때 사용하는 키입니다
+const ContextKeyPlayerRole = "playerRole"
+
+// ErrCodeForbidden은 권한이 부족할 때 내려주는 에러 코드입니다
+const ErrCodeForbidden = "FORBIDDEN"
+
+// RequireRole은 인증된 플레이어가 minRole 이상의 역할을 가졌는지 확인하는 미들웨어입니다
+// AuthMiddleware 뒤에 사용해야 하며, 역할은 토큰이 아닌 DB에서 조회하므로 권한 회수가 즉시 반영됩니다
+// 같은 요청에서 여러 번 사용되면 처음 조회한 역할을 재사용합니다
+func RequireRole(playerService *services.PlayerService, minRole string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := GetPlayerRole(c)
+		if !ok {
+			playerID, exists := GetPlayerID(c)
+			if !exists {
+				abortUnauthorized(c, ErrCodeAuthHeaderMissing, "User not authenticated")
+				return
+			}
+
+			player, err := playerService.GetPlayerByID(playerID)
+			if err != nil {
+				abortUnauthorized(c, ErrCodeTokenInvalid, "Player not found")
+				return
+			}
+			role = player.Role
+			c.Set(ContextKeyPlayerRole, role)
+		}
+
+		if !models.RoleAtLeast(role, minRole) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": "Insufficient permissions",
+				"code":  ErrCodeForbidden,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetPlayerRole은 RequireRole이 저장한 플레이어 역할을 꺼냅니다
+func GetPlayerRole(c *gin.Context) (string, bool) {
+	value, exists := c.Get(ContextKeyPlayerRole)
+	if !exists {
+		return "", false
+	}
+	role, ok := value.(string)
+	return role, ok
+}
@@ -6,6 +6,9 @@ import (
 	"game_eating_pizza/internal/api/handlers"
 	"game_eating_pizza/internal/api/middleware"
 	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/notifier"
 	"game_eating_pizza/internal/repository"
 	"game_eating_pizza/internal/services"
 	"gorm.io/gorm"
@@ -16,7 +19,8 @@ import (
 )
 
 // SetupRouter는 Gin 라우터를 설정하고 반환합니다
-func SetupRouter(db *gorm.DB, cfg *config.Config) *gin.Engine {
+// contentStore는 서비스들이 요청마다 현재 게임 콘텐츠를 읽는 저장소입니다 (다시 불러오면 다음 요청부터 적용)
+func SetupRouter(db *gorm.DB, cfg *config.Config, contentStore *content.Store) *gin.Engine {
 	// 환경에 따라 Gin 모드 설정
 	if cfg.Env == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -28,21 +32,50 @@ func SetupRouter(db *gorm.DB, cfg *config.Config) *gin.Engine {
 	router.Use(middleware.CORS(cfg))
 	router.Use(middleware.ErrorHandler())
 	router.Use(gin.Recovery())
+	router.Use(middleware.ContentVersion(contentStore))
 
 	// Repository 초기화
 	repos := repository.NewRepositories(db, cfg)
 
 	// Service 초기화
-	authService := services.NewAuthService(repos.Player)
-	playerService := services.NewPlayerService(repos.Player, repos.Weapon)
-	weaponService := services.NewWeaponService(repos.Weapon, repos.Player)
+	loginGuard := services.NewLoginGuard(repos.LoginAttempt, cfg)
+	suspensionService := services.NewSuspensionService(repos.Suspension)
+	authService := services.NewAuthService(repos.Player, repos.RefreshToken, repos.Session, loginGuard, suspensionService, cfg)
+	playerService := services.NewPlayerService(repos.Player, repos.Weapon, contentStore)
+	weaponService := services.NewWeaponService(repos.Weapon, repos.Player, contentStore, cfg)
+	activityService := services.NewActivityService(repos.Player, repos.UserActivity, repos.RejectedStep, repos.StepGoalClaim, cfg)
+	forgeService := services.NewForgeService(repos.ForgeJob, repos.Weapon, repos.Player, repos.UserActivity, contentStore, cfg)
+	walletService := services.NewWalletService(repos.Player, repos.Wallet)
+	inventoryService := services.NewInventoryService(repos.Player, repos.Inventory, contentStore)
+	upgradeService := services.NewUpgradeService(repos.Player, repos.PlayerUpgrade)
+	levelService := services.NewLevelService(repos.Player, repos.Progression, contentStore)
+	idleService := services.NewIdleService(repos.Player, repos.Weapon, repos.Progression, upgradeService, contentStore)
 	dungeonService := services.NewDungeonService(repos.Dungeon)
+	sessionService := services.NewSessionService(repos.Session, repos.RefreshToken)
+	adminService := services.NewAdminService(repos.Player, repos.Weapon, repos.Dungeon, repos.Suspension, repos.RejectedStep, repos.Wallet, repos.Inventory, levelService, contentStore, repos.AuditLog, cfg)
+	accountService := services.NewAccountService(repos.Player, repos.Session, repos.UserActivity, repos.RaidParticipant, repos.Suspension, repos.RejectedStep, repos.StepGoalClaim, repos.Wallet, repos.PlayerUpgrade, repos.Inventory, repos.Progression, repos.ForgeJob, contentStore, authService, loginGuard, cfg)
+	passwordService := services.NewPasswordService(repos.Player, repos.PasswordReset, authService, sessionService, loginGuard, notifier.New(cfg), cfg)
 
 	// Handler 초기화
 	authHandler := handlers.NewAuthHandler(authService)
 	playerHandler := handlers.NewPlayerHandler(playerService)
-	weaponHandler := handlers.NewWeaponHandler(weaponService)
+	weaponHandler := handlers.NewWeaponHandler(weaponService, upgradeService)
+	forgeHandler := handlers.NewForgeHandler(forgeService, upgradeService)
+	walletHandler := handlers.NewWalletHandler(walletService)
+	inventoryHandler := handlers.NewInventoryHandler(inventoryService)
+	upgradeHandler := handlers.NewUpgradeHandler(upgradeService)
+	levelHandler := handlers.NewLevelHandler(levelService)
+	idleHandler := handlers.NewIdleHandler(idleService)
+	activityHandler := handlers.NewActivityHandler(activityService)
 	dungeonHandler := handlers.NewDungeonHandler(dungeonService)
+	sessionHandler := handlers.NewSessionHandler(sessionService)
+	passwordHandler := handlers.NewPasswordHandler(passwordService)
+	accountHandler := handlers.NewAccountHandler(accountService)
+	adminHandler := handlers.NewAdminHandler(adminService)
+	contentHandler := handlers.NewContentHandler(contentStore)
+
+	// 인증 미들웨어 (JWT + 세션 + 이용 정지 검증)
+	authMiddleware := middleware.AuthMiddleware(cfg, sessionService, suspensionService)
 
 	// Swagger 문서
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -63,18 +96,33 @@ func SetupRouter(db *gorm.DB, cfg *config.Config) *gin.Engine {
 		{
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
+			auth.POST("/guest", authHandler.GuestLogin)
+			auth.POST("/link", authMiddleware, authHandler.LinkAccount)
 			auth.POST("/refresh", authHandler.RefreshToken)
+			auth.POST("/logout", authHandler.Logout)
+			auth.POST("/logout-all", authMiddleware, authHandler.LogoutAll)
+			auth.POST("/password/forgot", passwordHandler.ForgotPassword)
+			auth.POST("/password/reset", passwordHandler.ResetPassword)
 		}
 
+		// 게임 콘텐츠 버전 (인증 불필요)
+		v1.GET("/content/version", contentHandler.GetVersion)
+
 		// 인증이 필요한 라우트
 		authenticated := v1.Group("")
-		authenticated.Use(middleware.AuthMiddleware(cfg))
+		authenticated.Use(authMiddleware)
 		{
 			// 플레이어 관련
 			players := authenticated.Group("/players")
 			{
 				players.GET("/me", playerHandler.GetMe)
 				players.PUT("/me", playerHandler.UpdateMe)
+				players.DELETE("/me", accountHandler.DeleteMe)
+				players.GET("/me/export", accountHandler.ExportMe)
+				players.GET("/me/level", levelHandler.GetMyLevel)
+				players.PUT("/me/password", passwordHandler.ChangePassword)
+				players.GET("/me/sessions", sessionHandler.GetMySessions)
+				players.DELETE("/me/sessions/:id", sessionHandler.RevokeMySession)
 				players.GET("/leaderboard", playerHandler.GetLeaderboard)
 			}
 
@@ -82,9 +130,62 @@ func SetupRouter(db *gorm.DB, cfg *config.Config) *gin.Engine {
 			weapons := authenticated.Group("/weapons")
 			{
 				weapons.GET("", weaponHandler.GetWeapons)
-				weapons.POST("", weaponHandler.CreateWeapon)
+				weapons.POST("", forgeHandler.StartJob) // 무기는 대장간 제작으로만 생성됩니다
+				weapons.GET("/promotions", weaponHandler.GetRarityTiers)
+				weapons.GET("/inventory", weaponHandler.GetInventory)
+				weapons.POST("/inventory/expand", weaponHandler.ExpandInventory)
+				weapons.POST("/sell", weaponHandler.SellWeapons)
+				weapons.POST("/dismantle", weaponHandler.DismantleWeapons)
 				weapons.PUT("/:id/upgrade", weaponHandler.UpgradeWeapon)
+				weapons.POST("/:id/promote", weaponHandler.PromoteWeapon)
+				weapons.POST("/:id/enchant", weaponHandler.EnchantWeapon)
 				weapons.PUT("/:id/equip", weaponHandler.EquipWeapon)
+				weapons.PUT("/:id/lock", weaponHandler.LockWeapon)
+			}
+
+			// 대장간(무기 제작) 관련
+			forge := authenticated.Group("/forge")
+			{
+				forge.GET("/recipes", forgeHandler.GetRecipes)
+				forge.GET("/boost", forgeHandler.GetBoost)
+				forge.GET("/jobs", forgeHandler.GetJobs)
+				forge.POST("/jobs", forgeHandler.StartJob)
+				forge.GET("/jobs/:id", forgeHandler.GetJob)
+				forge.POST("/jobs/:id/claim", forgeHandler.ClaimJob)
+			}
+
+			// 지갑(재화 잔액/원장) 관련
+			wallet := authenticated.Group("/wallet")
+			{
+				wallet.GET("", walletHandler.GetWallet)
+				wallet.GET("/ledger", walletHandler.GetLedger)
+			}
+
+			// 재료·소모품 아이템과 인벤토리 관련
+			authenticated.GET("/items", inventoryHandler.GetItems)
+			authenticated.GET("/inventory", inventoryHandler.GetInventory)
+
+			// 레벨 표와 레벨업 보상
+			authenticated.GET("/levels", levelHandler.GetLevels)
+
+			// 방치(오프라인) 사냥 정산 (앱을 다시 열 때 호출)
+			authenticated.POST("/idle/sync", idleHandler.SyncIdle)
+
+			// 영구 강화(활력의 불씨 소비) 관련
+			upgrades := authenticated.Group("/upgrades")
+			{
+				upgrades.GET("", upgradeHandler.GetUpgrades)
+				upgrades.POST("/:id/purchase", upgradeHandler.PurchaseUpgrade)
+			}
+
+			// 걸음 수 활동 관련
+			activity := authenticated.Group("/activity")
+			{
+				activity.POST("/steps", activityHandler.SyncSteps)
+				activity.GET("/today", activityHandler.GetToday)
+				activity.POST("/today/claim", activityHandler.ClaimToday)
+				activity.GET("/history", activityHandler.GetHistory)
+				activity.GET("/stats", activityHandler.GetStats)
 			}
 
 			// 던전 관련
@@ -97,6 +198,46 @@ func SetupRouter(db *gorm.DB, cfg *config.Config) *gin.Engine {
 				dungeons.POST("/:id/clear", dungeonHandler.ClearDungeon)
 			}
 		}
+
+		// 운영 관련 (operator 이상)
+		admin := v1.Group("/admin")
+		admin.Use(authMiddleware, middleware.RequireRole(playerService, models.RoleOperator))
+		{
+			adminDungeons := admin.Group("/dungeons")
+			{
+				adminDungeons.GET("", adminHandler.GetDungeons)
+				adminDungeons.POST("", adminHandler.CreateDungeon)
+				adminDungeons.PUT("/:id", adminHandler.UpdateDungeon)
+				adminDungeons.DELETE("/:id", adminHandler.DeleteDungeon)
+			}
+
+			adminPlayers := admin.Group("/players")
+			{
+				adminPlayers.GET("", adminHandler.FindPlayer)
+				adminPlayers.GET("/:id", adminHandler.GetPlayer)
+				adminPlayers.GET("/:id/suspensions", adminHandler.GetPlayerSuspensions)
+				adminPlayers.POST("/:id/suspensions", adminHandler.SuspendPlayer)
+
+				// 관리자 전용
+				requireAdmin := middleware.RequireRole(playerService, models.RoleAdmin)
+				adminPlayers.PUT("/:id/role", requireAdmin, adminHandler.ChangeRole)
+				adminPlayers.POST("/:id/gold", requireAdmin, adminHandler.GrantGold)
+				adminPlayers.POST("/:id/wallet", requireAdmin, adminHandler.GrantCurrency)
+				adminPlayers.POST("/:id/weapons", requireAdmin, adminHandler.GrantWeapon)
+				adminPlayers.POST("/:id/items", requireAdmin, adminHandler.GrantItem)
+				adminPlayers.POST("/:id/experience", requireAdmin, adminHandler.GrantExperience)
+			}
+
+			adminSuspensions := admin.Group("/suspensions")
+			{
+				adminSuspensions.GET("", adminHandler.GetSuspensions)
+				adminSuspensions.POST("/:id/lift", adminHandler.LiftSuspension)
+			}
+
+			admin.GET("/step-rejections", adminHandler.GetRejectedStepSamples)
+
+			admin.GET("/audit-logs", middleware.RequireRole(playerService, models.RoleAdmin), adminHandler.GetAuditLogs)
+		}
 	}
 
 	return router
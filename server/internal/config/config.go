@@ -1,8 +1,10 @@
 package config
 
 import (
+	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -24,12 +26,49 @@ type Config struct {
 	DBSSLMode  string
 
 	// JWT 설정
-	JWTSecret     string
-	JWTExpiration int // 시간 (시간 단위)
+	JWTSecret            string
+	JWTExpiration        int // 시간 (시간 단위)
+	JWTRefreshExpiration int // 리프레시 토큰 유효기간 (시간 단위)
 
 	// CORS 설정
 	CORSAllowedOrigins []string
 
+	// 로그인 시도 제한 설정 (무차별 대입 방지)
+	LoginMaxFailures      int // 계정별로 잠금 없이 허용하는 연속 실패 횟수
+	LoginMaxFailuresPerIP int // IP별로 잠금 없이 허용하는 연속 실패 횟수
+	LoginLockoutBase      int // 첫 잠금 시간 (초 단위), 이후 실패할 때마다 2배씩 증가
+	LoginLockoutMax       int // 최대 잠금 시간 (초 단위)
+	LoginFailureWindow    int // 마지막 실패 후 실패 기록을 유지하는 시간 (분 단위)
+
+	// 비밀번호 재설정 설정
+	PasswordResetExpiration int    // 재설정 코드 유효기간 (분 단위)
+	NotifierDriver          string // 재설정 코드 전달 방식: "log" or "file"
+	NotifierFilePath        string // NotifierDriver가 "file"일 때 기록할 파일 경로
+
+	// 계정 삭제 설정
+	AccountDeletionGraceDays int // 삭제 요청 후 완전 삭제까지의 유예 기간 (일 단위)
+
+	// 대장간 설정
+	ForgeMaxActiveJobs int // 동시에 진행할 수 있는(수령하지 않은) 제작 작업 수
+
+	// 걸음 수 동기화 설정
+	ActivityMaxStepsPerSecond int // 샘플 구간의 평균 초당 걸음 수 상한 (넘으면 거부)
+	ActivityMaxDailySteps     int // 하루 최대 걸음 수 (넘는 샘플은 거부)
+	ActivitySyncWindowDays    int // 동기화를 허용하는 과거 기간 (일 단위)
+	ActivityMaxSamplesPerSync int // 요청 한 번에 보낼 수 있는 최대 샘플 수
+
+	// 일일 걸음 수 목표 보상 설정 (걸음 수 오름차순)
+	StepGoalTiers []StepGoalTier
+
+	// 무기 보관함 설정
+	WeaponInventoryBaseCapacity int   // 기본 무기 보관 수
+	WeaponInventoryMaxCapacity  int   // 확장으로 늘릴 수 있는 최대 무기 보관 수
+	WeaponInventoryExpandStep   int   // 한 번 확장할 때 늘어나는 보관 수
+	WeaponInventoryExpandCost   int64 // 한 번 확장하는 데 드는 보석
+
+	// 게임 콘텐츠 설정
+	ContentDir string // 콘텐츠 파일(.yaml, .yml, .json)을 읽을 디렉터리
+
 	// Redis 설정 (캐싱, 세션, 실시간 데이터용)
 	RedisHost     string
 	RedisPort     string
@@ -37,6 +76,20 @@ type Config struct {
 	RedisDB       int // Redis 데이터베이스 번호 (0-15)
 }
 
+// StepGoalTier는 일일 걸음 수 목표 단계와 달성 보상입니다
+type StepGoalTier struct {
+	Steps  int   // 목표 걸음 수
+	Gold   int64 // 보상 골드
+	Embers int64 // 보상 활력의 불씨
+}
+
+// defaultStepGoalTiers는 STEP_GOAL_TIERS가 없을 때 사용하는 기본 목표입니다
+var defaultStepGoalTiers = []StepGoalTier{
+	{Steps: 3000, Gold: 100, Embers: 1},
+	{Steps: 5000, Gold: 200, Embers: 2},
+	{Steps: 10000, Gold: 500, Embers: 5},
+}
+
 var AppConfig *Config
 
 // LoadConfig는 환경 변수에서 설정을 로드합니다
@@ -57,11 +110,40 @@ func LoadConfig() (*Config, error) {
 		DBDriver:   getEnv("DB_DRIVER", "postgres"),
 		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
 
-		JWTSecret:     getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-		JWTExpiration: getEnvAsInt("JWT_EXPIRATION", 24),
+		JWTSecret:            getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+		JWTExpiration:        getEnvAsInt("JWT_EXPIRATION", 24),
+		JWTRefreshExpiration: getEnvAsInt("JWT_REFRESH_EXPIRATION", 24*30),
 
 		CORSAllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"*"}),
 
+		LoginMaxFailures:      getEnvAsInt("LOGIN_MAX_FAILURES", 5),
+		LoginMaxFailuresPerIP: getEnvAsInt("LOGIN_MAX_FAILURES_PER_IP", 20),
+		LoginLockoutBase:      getEnvAsInt("LOGIN_LOCKOUT_BASE", 30),
+		LoginLockoutMax:       getEnvAsInt("LOGIN_LOCKOUT_MAX", 900),
+		LoginFailureWindow:    getEnvAsInt("LOGIN_FAILURE_WINDOW", 15),
+
+		PasswordResetExpiration: getEnvAsInt("PASSWORD_RESET_EXPIRATION", 15),
+		NotifierDriver:          getEnv("NOTIFIER_DRIVER", "log"),
+		NotifierFilePath:        getEnv("NOTIFIER_FILE_PATH", "notifications.log"),
+
+		AccountDeletionGraceDays: getEnvAsInt("ACCOUNT_DELETION_GRACE_DAYS", 14),
+
+		ForgeMaxActiveJobs: getEnvAsInt("FORGE_MAX_ACTIVE_JOBS", 2),
+
+		ActivityMaxStepsPerSecond: getEnvAsInt("ACTIVITY_MAX_STEPS_PER_SECOND", 5),
+		ActivityMaxDailySteps:     getEnvAsInt("ACTIVITY_MAX_DAILY_STEPS", 100000),
+		ActivitySyncWindowDays:    getEnvAsInt("ACTIVITY_SYNC_WINDOW_DAYS", 7),
+		ActivityMaxSamplesPerSync: getEnvAsInt("ACTIVITY_MAX_SAMPLES_PER_SYNC", 500),
+
+		StepGoalTiers: getEnvAsStepGoalTiers("STEP_GOAL_TIERS", defaultStepGoalTiers),
+
+		WeaponInventoryBaseCapacity: getEnvAsInt("WEAPON_INVENTORY_BASE_CAPACITY", 50),
+		WeaponInventoryMaxCapacity:  getEnvAsInt("WEAPON_INVENTORY_MAX_CAPACITY", 200),
+		WeaponInventoryExpandStep:   getEnvAsInt("WEAPON_INVENTORY_EXPAND_STEP", 10),
+		WeaponInventoryExpandCost:   int64(getEnvAsInt("WEAPON_INVENTORY_EXPAND_COST", 50)),
+
+		ContentDir: getEnv("CONTENT_DIR", "content"),
+
 		RedisHost:     getEnv("REDIS_HOST", "localhost"),
 		RedisPort:     getEnv("REDIS_PORT", "6379"),
 		RedisPassword: getEnv("REDIS_PASSWORD", ""), // 비밀번호가 설정되어 있어야 합니다
@@ -127,3 +209,34 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	}
 	return valueStr == "true" || valueStr == "1" || valueStr == "yes"
 }
+
+// getEnvAsStepGoalTiers는 "걸음수:골드:불씨" 항목을 쉼표로 구분한 환경 변수를 목표 단계로 변환합니다
+// 예: "3000:100:1,5000:200:2,10000:500:5" (형식이 잘못되었거나 걸음 수가 오름차순이 아니면 기본값 사용)
+func getEnvAsStepGoalTiers(key string, defaultValue []StepGoalTier) []StepGoalTier {
+	items := getEnvAsSlice(key, nil)
+	if len(items) == 0 {
+		return defaultValue
+	}
+
+	tiers := make([]StepGoalTier, 0, len(items))
+	for _, item := range items {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 3 {
+			log.Printf("Invalid %s entry %q, using defaults", key, item)
+			return defaultValue
+		}
+		steps, stepsErr := strconv.Atoi(parts[0])
+		gold, goldErr := strconv.ParseInt(parts[1], 10, 64)
+		embers, embersErr := strconv.ParseInt(parts[2], 10, 64)
+		if stepsErr != nil || goldErr != nil || embersErr != nil || steps <= 0 || gold < 0 || embers < 0 {
+			log.Printf("Invalid %s entry %q, using defaults", key, item)
+			return defaultValue
+		}
+		if len(tiers) > 0 && steps <= tiers[len(tiers)-1].Steps {
+			log.Printf("%s must be in ascending order of steps, using defaults", key)
+			return defaultValue
+		}
+		tiers = append(tiers, StepGoalTier{Steps: steps, Gold: gold, Embers: embers})
+	}
+	return tiers
+}
@@ -0,0 +1,370 @@
+package content
+
+import (
+	"math"
+	"time"
+)
+
+// Catalog는 한 버전의 게임 콘텐츠(레벨 표, 아이템 목록, 방치 사냥 규칙, 강화 비용, 특수 효과 부여 규칙, 무기 등급, 무기 템플릿, 제작 레시피, 드롭 테이블) 전체입니다
+// 버전이 붙은 YAML/JSON 파일에서 읽어 검증합니다. 불러온 뒤에는 바뀌지 않고, 다시 불러오면 새 Catalog로 통째로 교체됩니다
+type Catalog struct {
+	Version       string           `json:"version" yaml:"version"`
+	Levels        LevelTable       `json:"levels" yaml:"levels"`
+	Items         []ItemDefinition `json:"items" yaml:"items"` // 목록 조회 시 이 순서를 유지
+	Idle          IdleRules        `json:"idle" yaml:"idle"`
+	WeaponUpgrade WeaponUpgrade    `json:"weapon_upgrade" yaml:"weapon_upgrade"`
+	Enchant       Enchant          `json:"enchant" yaml:"enchant"`
+	ForgeBoosts   []ForgeBoostTier `json:"forge_boosts" yaml:"forge_boosts"` // 걸음 수 오름차순
+	Rarities      []RarityTier     `json:"rarities" yaml:"rarities"`         // 낮은 등급부터
+	StarterWeapon string           `json:"starter_weapon" yaml:"starter_weapon"`
+	Weapons       []WeaponTemplate `json:"weapons" yaml:"weapons"`
+	ForgeRecipes  []ForgeRecipe    `json:"forge_recipes" yaml:"forge_recipes"` // 목록 조회 시 이 순서를 유지
+	DropTables    []DropTable      `json:"drop_tables" yaml:"drop_tables"`
+
+	LoadedAt time.Time `json:"-" yaml:"-"`
+	Files    []string  `json:"-" yaml:"-"` // 불러온 파일 이름 (이름순)
+}
+
+// LevelTable은 플레이어 레벨 곡선, 최고 레벨과 레벨업 보상입니다
+// 레벨 곡선은 ExpToNext(표)와 Formula(공식) 중 하나로만 정의합니다
+type LevelTable struct {
+	MaxLevel int `json:"max_level" yaml:"max_level"` // 최고 레벨 (도달하면 더 이상 경험치를 쌓지 않음)
+
+	// ExpToNext[i]는 레벨 i+1에서 다음 레벨로 오르는 데 필요한 경험치입니다
+	// 표보다 높은 레벨은 마지막 두 값의 차이만큼 계속 늘어납니다
+	ExpToNext []int64       `json:"exp_to_next,omitempty" yaml:"exp_to_next,omitempty"`
+	Formula   *LevelFormula `json:"formula,omitempty" yaml:"formula,omitempty"`
+
+	Rewards []LevelReward `json:"rewards" yaml:"rewards"` // 레벨에 도달할 때 지급하는 보상 (보상이 없는 레벨은 생략)
+}
+
+// LevelFormula는 공식으로 정의한 레벨 곡선입니다
+// 레벨 L에서 다음 레벨로 오르는 데 필요한 경험치는 round(Base × L^Exponent)입니다 (Base 100, Exponent 1이면 레벨 × 100)
+type LevelFormula struct {
+	Base     float64 `json:"base" yaml:"base"`
+	Exponent float64 `json:"exponent" yaml:"exponent"`
+}
+
+// LevelReward는 한 레벨에 도달할 때 지급하는 보상 묶음입니다
+type LevelReward struct {
+	Level    int          `json:"level" yaml:"level"`
+	Gold     int64        `json:"gold,omitempty" yaml:"gold,omitempty"`
+	Items    []ItemReward `json:"items,omitempty" yaml:"items,omitempty"`
+	Dungeons []uint       `json:"dungeons,omitempty" yaml:"dungeons,omitempty"` // 해금하는 던전 ID
+}
+
+// ItemDefinition은 인벤토리에 쌓아 보관하는 재료·소모품 아이템의 정의입니다
+type ItemDefinition struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Type        string `json:"type" yaml:"type"` // models.ItemTypeOre 등
+	Description string `json:"description" yaml:"description"`
+	MaxStack    int64  `json:"max_stack" yaml:"max_stack"` // 한 플레이어가 보유할 수 있는 최대 수량
+}
+
+// ItemReward는 보상으로 지급하는 아이템과 수량입니다
+type ItemReward struct {
+	ID       string `json:"id" yaml:"id"`
+	Quantity int64  `json:"quantity" yaml:"quantity"`
+}
+
+// RequiredExp는 level에서 다음 레벨로 오르는 데 필요한 경험치를 반환합니다 (최고 레벨 여부와 관계없이 곡선 값)
+func (t LevelTable) RequiredExp(level int) int64 {
+	if t.Formula != nil {
+		required := int64(math.Round(t.Formula.Base * math.Pow(float64(max(level, 1)), t.Formula.Exponent)))
+		return max(required, 1)
+	}
+	return extrapolate(t.ExpToNext, level-1)
+}
+
+// Reward는 level에 도달할 때 지급하는 보상을 찾습니다
+func (t LevelTable) Reward(level int) (*LevelReward, bool) {
+	for i := range t.Rewards {
+		if t.Rewards[i].Level == level {
+			return &t.Rewards[i], true
+		}
+	}
+	return nil, false
+}
+
+// IdleRules는 방치(오프라인) 사냥 규칙입니다
+// 마지막 정산 이후 흐른 시간 동안 장착한 무기로 현재 스테이지 몬스터를 계속 처치했다고 보고 골드·경험치를 계산합니다
+type IdleRules struct {
+	MaxOfflineHours      float64 `json:"max_offline_hours" yaml:"max_offline_hours"`           // 한 번에 정산하는 최대 시간 (넘는 시간은 버림)
+	OfflineRate          float64 `json:"offline_rate" yaml:"offline_rate"`                     // 방치 사냥 효율 (0 초과 1 이하, 1이면 쉬지 않고 사냥)
+	SpawnIntervalSeconds float64 `json:"spawn_interval_seconds" yaml:"spawn_interval_seconds"` // 몬스터 등장 간격 (무기가 아무리 강해도 처치 한 번에 걸리는 최소 시간)
+	MaxStage             int     `json:"max_stage" yaml:"max_stage"`
+	ClearKills           int     `json:"clear_kills" yaml:"clear_kills"` // 다음 스테이지로 올라가려면 현재 스테이지에서 처치해야 하는 몬스터 수
+
+	// 스테이지별 몬스터 체력과 처치 보상
+	MonsterHP   StageCurve `json:"monster_hp" yaml:"monster_hp"`
+	GoldPerKill StageCurve `json:"gold_per_kill" yaml:"gold_per_kill"`
+	ExpPerKill  StageCurve `json:"exp_per_kill" yaml:"exp_per_kill"`
+}
+
+// MaxOffline은 한 번에 정산하는 최대 시간입니다
+func (r IdleRules) MaxOffline() time.Duration {
+	return time.Duration(r.MaxOfflineHours * float64(time.Hour))
+}
+
+// SpawnInterval은 몬스터 등장 간격입니다
+func (r IdleRules) SpawnInterval() time.Duration {
+	return time.Duration(r.SpawnIntervalSeconds * float64(time.Second))
+}
+
+// maxStageValue는 스테이지 값의 상한입니다 (높은 스테이지에서 골드·경험치 합계가 넘치지 않도록)
+const maxStageValue = math.MaxInt32
+
+// StageCurve는 스테이지가 오를 때마다 일정 비율로 커지는 값입니다
+// 스테이지 s의 값은 round(Base × Growth^(s-1))입니다 (Base 50, Growth 1.1이면 50, 55, 61, ...)
+type StageCurve struct {
+	Base   float64 `json:"base" yaml:"base"`
+	Growth float64 `json:"growth" yaml:"growth"`
+}
+
+// At은 stage의 값을 반환합니다 (1 이상, maxStageValue 이하)
+func (c StageCurve) At(stage int) int64 {
+	value := math.Round(c.Base * math.Pow(c.Growth, float64(max(stage, 1)-1)))
+	if value >= maxStageValue {
+		return maxStageValue
+	}
+	return max(int64(value), 1)
+}
+
+// WeaponUpgrade는 무기 강화 규칙입니다
+type WeaponUpgrade struct {
+	AttackPowerGain int `json:"attack_power_gain" yaml:"attack_power_gain"` // 한 단계 강화할 때 오르는 공격력
+
+	// GoldCosts[i]는 i+1단계 무기를 한 단계 강화하는 골드 비용입니다
+	// 표보다 높은 단계는 마지막 두 값의 차이만큼 계속 늘어납니다
+	GoldCosts []int64 `json:"gold_costs" yaml:"gold_costs"`
+
+	SellRefund float64 `json:"sell_refund" yaml:"sell_refund"` // 무기를 판매할 때 돌려주는 강화 비용의 비율 (0~1)
+}
+
+// TableMaxLevel은 강화 비용 표가 다루는 가장 높은 무기 단계입니다 (표의 마지막 단계를 강화한 단계)
+func (u WeaponUpgrade) TableMaxLevel() int {
+	return len(u.GoldCosts) + 1
+}
+
+// Cost는 level 단계 무기를 한 단계 강화하는 골드 비용을 반환합니다
+func (u WeaponUpgrade) Cost(level int) int64 {
+	return extrapolate(u.GoldCosts, level-1)
+}
+
+// Enchant는 무기 특수 효과 부여 규칙입니다
+type Enchant struct {
+	EmberCost int64              `json:"ember_cost" yaml:"ember_cost"` // 특수 효과를 한 번 부여(또는 다시 굴림)하는 데 드는 활력의 불씨
+	Effects   []WeaponEffectRule `json:"effects" yaml:"effects"`
+}
+
+// WeaponEffectRule은 특수 효과 종류 하나와 효과량·발동 확률의 범위입니다
+// 효과 종류는 여기서만 정의하며, 효과를 붙일 때 범위 안에서 값을 굴립니다
+type WeaponEffectRule struct {
+	ID         string     `json:"id" yaml:"id"`
+	Name       string     `json:"name" yaml:"name"`               // 표시 이름 (예: 화상)
+	Magnitude  FloatRange `json:"magnitude" yaml:"magnitude"`     // 발동 시 공격력 대비 추가 피해 비율
+	ProcChance FloatRange `json:"proc_chance" yaml:"proc_chance"` // 공격마다 발동할 확률 (0~1)
+}
+
+// ForgeBoostTier는 하루 걸음 수에 따른 대장간 부스트 단계입니다
+// 스토리: 걸을수록 화로가 뜨거워져 무기 제작 속도가 증가합니다
+type ForgeBoostTier struct {
+	MinSteps   int     `json:"min_steps" yaml:"min_steps"`
+	Multiplier float64 `json:"multiplier" yaml:"multiplier"` // 제작 시간 단축 및 등급 확률 보정 배율
+}
+
+// RarityTier는 무기 등급 하나의 능력치 배율, 처분 보상, 특수 효과 규칙과 이 등급으로 승급하는 조건입니다
+type RarityTier struct {
+	ID                string           `json:"id" yaml:"id"`
+	StatMultiplier    float64          `json:"stat_multiplier" yaml:"stat_multiplier"`                 // 가장 낮은 등급 대비 공격력 배율
+	SellPrice         int64            `json:"sell_price" yaml:"sell_price"`                           // 판매 기본 가격 (골드)
+	DismantleScrap    int64            `json:"dismantle_scrap" yaml:"dismantle_scrap"`                 // 분해 기본 파편 수
+	EffectSlots       int              `json:"effect_slots" yaml:"effect_slots"`                       // 붙일 수 있는 특수 효과 수
+	ForgeEffectChance float64          `json:"forge_effect_chance" yaml:"forge_effect_chance"`         // 대장간에서 제작할 때 무작위 특수 효과가 하나 붙을 확률 (0~1)
+	ForgeEffects      []string         `json:"forge_effects,omitempty" yaml:"forge_effects,omitempty"` // 제작할 때 붙을 수 있는 특수 효과 ID (비우면 모든 효과)
+	Promotion         *RarityPromotion `json:"promotion,omitempty" yaml:"promotion,omitempty"`         // 이 등급으로 승급하는 조건 (가장 낮은 등급은 생략)
+}
+
+// RarityPromotion은 바로 아래 등급에서 이 등급으로 승급하는 조건입니다
+type RarityPromotion struct {
+	SuccessRate float64 `json:"success_rate" yaml:"success_rate"` // 승급 성공 확률 (0 초과 1 이하)
+	Pity        int     `json:"pity" yaml:"pity"`                 // 연속 실패가 이 횟수에 이르면 다음 시도는 반드시 성공 (0이면 천장 없음)
+	Duplicates  int     `json:"duplicates" yaml:"duplicates"`     // 소모하는 같은 종류·등급 무기 수
+	GoldCost    int64   `json:"gold_cost" yaml:"gold_cost"`       // 승급 비용 골드
+}
+
+// IntRange는 정수 값의 범위입니다 (양 끝 포함)
+type IntRange struct {
+	Min int `json:"min" yaml:"min"`
+	Max int `json:"max" yaml:"max"`
+}
+
+// FloatRange는 실수 값의 범위입니다 (양 끝 포함)
+type FloatRange struct {
+	Min float64 `json:"min" yaml:"min"`
+	Max float64 `json:"max" yaml:"max"`
+}
+
+// WeaponTemplate은 무기를 만들 때 쓰는 기본 능력치입니다
+// 능력치는 범위로만 정의하고 실제 값은 무기를 만들 때 서버에서 굴립니다
+type WeaponTemplate struct {
+	ID          string     `json:"id" yaml:"id"`
+	Name        string     `json:"name" yaml:"name"` // 만들어질 무기 이름
+	Type        string     `json:"type" yaml:"type"` // sword, bow, staff
+	AttackPower IntRange   `json:"attack_power" yaml:"attack_power"`
+	AttackSpeed FloatRange `json:"attack_speed" yaml:"attack_speed"`
+}
+
+// ForgeRecipe는 대장간에서 제작할 수 있는 무기 레시피입니다
+type ForgeRecipe struct {
+	ID              string `json:"id" yaml:"id"`
+	Weapon          string `json:"weapon" yaml:"weapon"` // 제작될 무기 템플릿 ID
+	GoldCost        int64  `json:"gold_cost" yaml:"gold_cost"`
+	DurationSeconds int    `json:"duration_seconds" yaml:"duration_seconds"` // 기본 제작 시간
+	MinLevel        int    `json:"min_level" yaml:"min_level"`               // 제작 가능한 최소 플레이어 레벨
+	DropTable       string `json:"drop_table" yaml:"drop_table"`             // 결과 등급을 정하는 드롭 테이블 ID
+}
+
+// Duration은 기본 제작 시간입니다
+func (r ForgeRecipe) Duration() time.Duration {
+	return time.Duration(r.DurationSeconds) * time.Second
+}
+
+// DropTable은 결과 등급과 그 가중치 목록입니다
+type DropTable struct {
+	ID       string         `json:"id" yaml:"id"`
+	Rarities []RarityWeight `json:"rarities" yaml:"rarities"`
+}
+
+// RarityWeight는 드롭 테이블의 등급 하나와 그 가중치입니다
+type RarityWeight struct {
+	Rarity string `json:"rarity" yaml:"rarity"`
+	Weight int    `json:"weight" yaml:"weight"`
+}
+
+// Item은 ID로 아이템 정의를 찾습니다
+func (c *Catalog) Item(id string) (*ItemDefinition, bool) {
+	for i := range c.Items {
+		if c.Items[i].ID == id {
+			return &c.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// WeaponEffect는 ID로 특수 효과 규칙을 찾습니다
+func (c *Catalog) WeaponEffect(id string) (*WeaponEffectRule, bool) {
+	for i := range c.Enchant.Effects {
+		if c.Enchant.Effects[i].ID == id {
+			return &c.Enchant.Effects[i], true
+		}
+	}
+	return nil, false
+}
+
+// ForgeEffects는 등급의 무기를 제작할 때 붙을 수 있는 특수 효과 규칙입니다 (등급에 목록이 없으면 모든 효과)
+func (c *Catalog) ForgeEffects(tier *RarityTier) []WeaponEffectRule {
+	if len(tier.ForgeEffects) == 0 {
+		return c.Enchant.Effects
+	}
+	effects := make([]WeaponEffectRule, 0, len(tier.ForgeEffects))
+	for _, id := range tier.ForgeEffects {
+		if effect, ok := c.WeaponEffect(id); ok {
+			effects = append(effects, *effect)
+		}
+	}
+	return effects
+}
+
+// Rarity는 ID로 무기 등급을 찾습니다
+func (c *Catalog) Rarity(id string) (*RarityTier, bool) {
+	if index := c.RarityIndex(id); index >= 0 {
+		return &c.Rarities[index], true
+	}
+	return nil, false
+}
+
+// RarityIndex는 등급 표에서 등급의 위치를 찾습니다 (없으면 -1)
+func (c *Catalog) RarityIndex(id string) int {
+	for i := range c.Rarities {
+		if c.Rarities[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// LowestRarity는 가장 낮은 등급입니다 (기본 무기의 등급이며, 등급 표에서 사라진 등급의 처분 보상 기준)
+// 검증을 통과한 카탈로그는 등급이 하나 이상 있습니다
+func (c *Catalog) LowestRarity() *RarityTier {
+	return &c.Rarities[0]
+}
+
+// Weapon은 ID로 무기 템플릿을 찾습니다
+func (c *Catalog) Weapon(id string) (*WeaponTemplate, bool) {
+	for i := range c.Weapons {
+		if c.Weapons[i].ID == id {
+			return &c.Weapons[i], true
+		}
+	}
+	return nil, false
+}
+
+// HasWeaponType은 무기 템플릿 중 weaponType 종류가 있는지 확인합니다
+func (c *Catalog) HasWeaponType(weaponType string) bool {
+	for _, weapon := range c.Weapons {
+		if weapon.Type == weaponType {
+			return true
+		}
+	}
+	return false
+}
+
+// Recipe는 ID로 제작 레시피를 찾습니다
+func (c *Catalog) Recipe(id string) (*ForgeRecipe, bool) {
+	for i := range c.ForgeRecipes {
+		if c.ForgeRecipes[i].ID == id {
+			return &c.ForgeRecipes[i], true
+		}
+	}
+	return nil, false
+}
+
+// DropTable은 ID로 드롭 테이블을 찾습니다
+func (c *Catalog) DropTable(id string) (*DropTable, bool) {
+	for i := range c.DropTables {
+		if c.DropTables[i].ID == id {
+			return &c.DropTables[i], true
+		}
+	}
+	return nil, false
+}
+
+// ForgeBoost는 하루 걸음 수에 해당하는 대장간 부스트 배율을 반환합니다 (어느 단계에도 못 미치면 1.0)
+func (c *Catalog) ForgeBoost(steps int) float64 {
+	multiplier := 1.0
+	for _, tier := range c.ForgeBoosts {
+		if steps >= tier.MinSteps {
+			multiplier = tier.Multiplier
+		}
+	}
+	return multiplier
+}
+
+// extrapolate는 values[index]를 반환하고, 표를 벗어나면 마지막 두 값의 차이만큼 늘려 계산합니다
+// 검증을 통과한 표는 비어 있지 않고 줄어들지 않으므로 결과는 항상 마지막 값 이상입니다
+func extrapolate(values []int64, index int) int64 {
+	if index < 0 {
+		index = 0
+	}
+	last := len(values) - 1
+	if index <= last {
+		return values[index]
+	}
+	var step int64
+	if last > 0 {
+		step = values[last] - values[last-1]
+	}
+	return values[last] + step*int64(index-last)
+}
@@ -0,0 +1,139 @@
+package content
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// contentFile은 콘텐츠 파일 하나의 내용입니다
+// 섹션마다 정의한 파일을 추적할 수 있도록 모든 섹션을 포인터로 받습니다 (파일에 없으면 nil)
+type contentFile struct {
+	Version       *string           `json:"version" yaml:"version"`
+	Levels        *LevelTable       `json:"levels" yaml:"levels"`
+	Items         *[]ItemDefinition `json:"items" yaml:"items"`
+	Idle          *IdleRules        `json:"idle" yaml:"idle"`
+	WeaponUpgrade *WeaponUpgrade    `json:"weapon_upgrade" yaml:"weapon_upgrade"`
+	Enchant       *Enchant          `json:"enchant" yaml:"enchant"`
+	ForgeBoosts   *[]ForgeBoostTier `json:"forge_boosts" yaml:"forge_boosts"`
+	Rarities      *[]RarityTier     `json:"rarities" yaml:"rarities"`
+	StarterWeapon *string           `json:"starter_weapon" yaml:"starter_weapon"`
+	Weapons       *[]WeaponTemplate `json:"weapons" yaml:"weapons"`
+	ForgeRecipes  *[]ForgeRecipe    `json:"forge_recipes" yaml:"forge_recipes"`
+	DropTables    *[]DropTable      `json:"drop_tables" yaml:"drop_tables"`
+}
+
+// Load는 dir 안의 모든 콘텐츠 파일(.yaml, .yml, .json)을 이름순으로 읽어 하나의 Catalog로 합치고 검증합니다
+// 섹션(version, levels, weapons 등)은 여러 파일에 나눠 둘 수 있지만, 같은 섹션을 두 파일에서 정의할 수는 없습니다
+// 검증에 실패하면 문제를 모두 모은 *ValidationError를 반환합니다
+func Load(dir string) (*Catalog, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("content: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isContentFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("content: no .yaml, .yml or .json files in %s", dir)
+	}
+	sort.Strings(names)
+
+	catalog := &Catalog{Files: names}
+	owners := make(map[string]string) // 섹션 이름 → 정의한 파일
+	for _, name := range names {
+		file, err := readContentFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("content: %s: %w", name, err)
+		}
+		if err := catalog.merge(file, name, owners); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Validate(catalog); err != nil {
+		return nil, err
+	}
+	catalog.LoadedAt = time.Now()
+	return catalog, nil
+}
+
+// isContentFile은 콘텐츠 파일로 읽을 확장자인지 확인합니다
+func isContentFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// readContentFile은 확장자에 맞는 형식으로 파일을 읽습니다
+// 정의되지 않은 필드가 있으면 오타로 보고 에러를 반환합니다
+func readContentFile(path string) (*contentFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file contentFile
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	}
+	if errors.Is(err, io.EOF) {
+		return &file, nil // 빈 파일
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// merge는 파일 하나의 섹션들을 catalog에 합칩니다
+func (c *Catalog) merge(file *contentFile, name string, owners map[string]string) error {
+	return errors.Join(
+		mergeSection(&c.Version, file.Version, "version", name, owners),
+		mergeSection(&c.Levels, file.Levels, "levels", name, owners),
+		mergeSection(&c.Items, file.Items, "items", name, owners),
+		mergeSection(&c.Idle, file.Idle, "idle", name, owners),
+		mergeSection(&c.WeaponUpgrade, file.WeaponUpgrade, "weapon_upgrade", name, owners),
+		mergeSection(&c.Enchant, file.Enchant, "enchant", name, owners),
+		mergeSection(&c.ForgeBoosts, file.ForgeBoosts, "forge_boosts", name, owners),
+		mergeSection(&c.Rarities, file.Rarities, "rarities", name, owners),
+		mergeSection(&c.StarterWeapon, file.StarterWeapon, "starter_weapon", name, owners),
+		mergeSection(&c.Weapons, file.Weapons, "weapons", name, owners),
+		mergeSection(&c.ForgeRecipes, file.ForgeRecipes, "forge_recipes", name, owners),
+		mergeSection(&c.DropTables, file.DropTables, "drop_tables", name, owners),
+	)
+}
+
+// mergeSection은 파일에 섹션이 있으면 dst에 복사합니다 (이미 다른 파일에서 정의한 섹션이면 에러)
+func mergeSection[T any](dst *T, src *T, section, name string, owners map[string]string) error {
+	if src == nil {
+		return nil
+	}
+	if owner, ok := owners[section]; ok {
+		return fmt.Errorf("content: %s: section %q is already defined in %s", name, section, owner)
+	}
+	owners[section] = name
+	*dst = *src
+	return nil
+}
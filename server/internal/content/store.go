@@ -0,0 +1,44 @@
+package content
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Store는 현재 적용 중인 Catalog를 보관하고 콘텐츠 디렉터리에서 다시 불러옵니다
+// 요청 처리 중에는 Current()로 얻은 Catalog 하나만 사용해야 다시 불러오는 도중에도 값이 섞이지 않습니다
+type Store struct {
+	dir     string
+	current atomic.Pointer[Catalog]
+	mu      sync.Mutex // 다시 불러오기를 한 번에 하나씩 처리
+}
+
+// NewStore는 dir에서 콘텐츠를 불러와 새로운 Store를 생성합니다
+func NewStore(dir string) (*Store, error) {
+	catalog, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	store := &Store{dir: dir}
+	store.current.Store(catalog)
+	return store, nil
+}
+
+// Current는 현재 적용 중인 Catalog를 반환합니다
+func (s *Store) Current() *Catalog {
+	return s.current.Load()
+}
+
+// Reload는 콘텐츠 디렉터리를 다시 읽어 검증을 통과하면 새 Catalog로 교체합니다
+// 실패하면 기존 Catalog를 그대로 유지하고 에러를 반환합니다
+func (s *Store) Reload() (*Catalog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	catalog, err := Load(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	s.current.Store(catalog)
+	return catalog, nil
+}
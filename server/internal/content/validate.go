@@ -0,0 +1,410 @@
+package content
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/models"
+	"strings"
+)
+
+// ValidationError는 콘텐츠 검증에서 발견한 모든 문제입니다
+// 각 문제는 "weapons[2].attack_power: ..."처럼 문제가 있는 위치로 시작합니다
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("content: %d problem(s): %s", len(e.Problems), strings.Join(e.Problems, "; "))
+}
+
+// validator는 검증 중 발견한 문제를 모읍니다
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+// Validate는 Catalog의 값과 섹션 간 참조(레벨업 보상 → 아이템, 레시피 → 무기 템플릿, 드롭 테이블, 드롭 테이블 → 등급, 등급 → 특수 효과)를 검증합니다
+func Validate(c *Catalog) error {
+	v := &validator{}
+
+	if strings.TrimSpace(c.Version) == "" {
+		v.addf("version", "is required")
+	}
+	items := v.validateItems(c.Items)
+	v.validateLevels(c.Levels, items)
+	v.validateIdle(c.Idle)
+	if c.WeaponUpgrade.AttackPowerGain <= 0 {
+		v.addf("weapon_upgrade.attack_power_gain", "must be positive, got %d", c.WeaponUpgrade.AttackPowerGain)
+	}
+	v.validateSteps("weapon_upgrade.gold_costs", c.WeaponUpgrade.GoldCosts, 0)
+	if c.WeaponUpgrade.SellRefund < 0 || c.WeaponUpgrade.SellRefund > 1 {
+		v.addf("weapon_upgrade.sell_refund", "must be between 0 and 1, got %g", c.WeaponUpgrade.SellRefund)
+	}
+	effects := v.validateEnchant(c.Enchant)
+	v.validateForgeBoosts(c.ForgeBoosts)
+
+	weapons := v.validateWeapons(c.Weapons)
+	if c.StarterWeapon == "" {
+		v.addf("starter_weapon", "is required")
+	} else if !weapons[c.StarterWeapon] {
+		v.addf("starter_weapon", "unknown weapon %q", c.StarterWeapon)
+	}
+	rarities := v.validateRarities(c.Rarities, effects)
+	dropTables := v.validateDropTables(c.DropTables, rarities)
+	v.validateForgeRecipes(c.ForgeRecipes, weapons, dropTables)
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+// validateItems는 아이템 목록을 검증하고 유효한 아이템 ID 집합을 반환합니다
+func (v *validator) validateItems(items []ItemDefinition) map[string]bool {
+	ids := make(map[string]bool, len(items))
+	for i, item := range items {
+		path := fmt.Sprintf("items[%d]", i)
+		v.validateID(path, item.ID, ids)
+		if len(item.ID) > 32 {
+			v.addf(path+".id", "must be at most 32 characters")
+		}
+		if item.Name == "" {
+			v.addf(path+".name", "is required")
+		}
+		if !models.IsValidItemType(item.Type) {
+			v.addf(path+".type", "unknown item type %q (expected one of %s)", item.Type, strings.Join(models.ItemTypes, ", "))
+		}
+		if item.MaxStack < 1 {
+			v.addf(path+".max_stack", "must be at least 1, got %d", item.MaxStack)
+		}
+	}
+	return ids
+}
+
+// validateLevels는 최고 레벨, 레벨 곡선(표 또는 공식 중 하나)과 레벨업 보상(아이템은 아이템 목록에 있어야 함)을 검증합니다
+func (v *validator) validateLevels(levels LevelTable, itemIDs map[string]bool) {
+	if levels.MaxLevel < 1 {
+		v.addf("levels.max_level", "must be at least 1, got %d", levels.MaxLevel)
+	}
+
+	switch {
+	case len(levels.ExpToNext) > 0 && levels.Formula != nil:
+		v.addf("levels", "exp_to_next and formula are mutually exclusive")
+	case levels.Formula != nil:
+		if levels.Formula.Base < 1 {
+			v.addf("levels.formula.base", "must be at least 1, got %g", levels.Formula.Base)
+		}
+		if levels.Formula.Exponent < 0 {
+			v.addf("levels.formula.exponent", "must not be negative, got %g", levels.Formula.Exponent)
+		}
+	default:
+		v.validateSteps("levels.exp_to_next", levels.ExpToNext, 1)
+	}
+
+	seen := make(map[int]bool, len(levels.Rewards))
+	for i, reward := range levels.Rewards {
+		path := fmt.Sprintf("levels.rewards[%d]", i)
+		switch {
+		case reward.Level < 2 || (levels.MaxLevel >= 1 && reward.Level > levels.MaxLevel):
+			v.addf(path+".level", "must be between 2 and max_level (%d), got %d", levels.MaxLevel, reward.Level)
+		case seen[reward.Level]:
+			v.addf(path+".level", "duplicate level %d", reward.Level)
+		}
+		seen[reward.Level] = true
+
+		if reward.Gold < 0 {
+			v.addf(path+".gold", "must not be negative, got %d", reward.Gold)
+		}
+		if reward.Gold == 0 && len(reward.Items) == 0 && len(reward.Dungeons) == 0 {
+			v.addf(path, "must grant gold, items or dungeons")
+		}
+		items := make(map[string]bool, len(reward.Items))
+		for j, item := range reward.Items {
+			itemPath := fmt.Sprintf("%s.items[%d]", path, j)
+			v.validateID(itemPath, item.ID, items)
+			if item.ID != "" && !itemIDs[item.ID] {
+				v.addf(itemPath+".id", "unknown item %q", item.ID)
+			}
+			if item.Quantity <= 0 {
+				v.addf(itemPath+".quantity", "must be positive, got %d", item.Quantity)
+			}
+		}
+		dungeons := make(map[uint]bool, len(reward.Dungeons))
+		for j, dungeonID := range reward.Dungeons {
+			dungeonPath := fmt.Sprintf("%s.dungeons[%d]", path, j)
+			switch {
+			case dungeonID == 0:
+				v.addf(dungeonPath, "must be a dungeon id")
+			case dungeons[dungeonID]:
+				v.addf(dungeonPath, "duplicate dungeon %d", dungeonID)
+			}
+			dungeons[dungeonID] = true
+		}
+	}
+}
+
+// validateIdle은 방치 사냥 규칙과 스테이지 곡선을 검증합니다
+func (v *validator) validateIdle(idle IdleRules) {
+	if idle.MaxOfflineHours <= 0 {
+		v.addf("idle.max_offline_hours", "must be positive, got %g", idle.MaxOfflineHours)
+	}
+	if idle.OfflineRate <= 0 || idle.OfflineRate > 1 {
+		v.addf("idle.offline_rate", "must be greater than 0 and at most 1, got %g", idle.OfflineRate)
+	}
+	if idle.SpawnIntervalSeconds <= 0 {
+		v.addf("idle.spawn_interval_seconds", "must be positive, got %g", idle.SpawnIntervalSeconds)
+	}
+	if idle.MaxStage < 1 {
+		v.addf("idle.max_stage", "must be at least 1, got %d", idle.MaxStage)
+	}
+	if idle.ClearKills < 1 {
+		v.addf("idle.clear_kills", "must be at least 1, got %d", idle.ClearKills)
+	}
+	v.validateStageCurve("idle.monster_hp", idle.MonsterHP)
+	v.validateStageCurve("idle.gold_per_kill", idle.GoldPerKill)
+	v.validateStageCurve("idle.exp_per_kill", idle.ExpPerKill)
+}
+
+// validateStageCurve는 스테이지 곡선이 1 이상에서 시작해 줄어들지 않는지 확인합니다
+func (v *validator) validateStageCurve(path string, curve StageCurve) {
+	if curve.Base < 1 {
+		v.addf(path+".base", "must be at least 1, got %g", curve.Base)
+	}
+	if curve.Growth < 1 {
+		v.addf(path+".growth", "must be at least 1, got %g", curve.Growth)
+	}
+}
+
+// validateSteps는 레벨·단계별 값 표가 비어 있지 않고, 모든 값이 minValue 이상이며, 줄어들지 않는지 확인합니다
+// 표를 벗어난 단계는 마지막 두 값으로 늘려 계산하므로 줄어드는 표는 허용하지 않습니다
+func (v *validator) validateSteps(path string, values []int64, minValue int64) {
+	if len(values) == 0 {
+		v.addf(path, "must have at least one entry")
+		return
+	}
+	for i, value := range values {
+		if value < minValue {
+			v.addf(fmt.Sprintf("%s[%d]", path, i), "must be at least %d, got %d", minValue, value)
+		}
+		if i > 0 && value < values[i-1] {
+			v.addf(fmt.Sprintf("%s[%d]", path, i), "must not be less than the previous entry (%d), got %d", values[i-1], value)
+		}
+	}
+}
+
+// validateEnchant는 특수 효과 부여 비용과 효과 종류별 수치 범위를 검증하고 유효한 효과 ID 집합을 반환합니다
+func (v *validator) validateEnchant(enchant Enchant) map[string]bool {
+	if enchant.EmberCost <= 0 {
+		v.addf("enchant.ember_cost", "must be positive, got %d", enchant.EmberCost)
+	}
+
+	ids := make(map[string]bool, len(enchant.Effects))
+	if len(enchant.Effects) == 0 {
+		v.addf("enchant.effects", "must have at least one entry")
+	}
+	for i, effect := range enchant.Effects {
+		path := fmt.Sprintf("enchant.effects[%d]", i)
+		v.validateID(path, effect.ID, ids)
+		if len(effect.ID) > 20 {
+			v.addf(path+".id", "must be at most 20 characters")
+		}
+		if effect.Name == "" || len(effect.Name) > 50 {
+			v.addf(path+".name", "must be 1-50 characters")
+		}
+		if effect.Magnitude.Min <= 0 {
+			v.addf(path+".magnitude.min", "must be positive, got %g", effect.Magnitude.Min)
+		}
+		if effect.Magnitude.Max < effect.Magnitude.Min {
+			v.addf(path+".magnitude", "max %g is less than min %g", effect.Magnitude.Max, effect.Magnitude.Min)
+		}
+		v.validateChanceRange(path+".proc_chance", effect.ProcChance)
+	}
+	return ids
+}
+
+// validateChanceRange는 확률 범위가 0~1 안에 있고 min이 max 이하인지 확인합니다
+func (v *validator) validateChanceRange(path string, r FloatRange) {
+	if r.Min < 0 || r.Max > 1 {
+		v.addf(path, "must be between 0 and 1, got %g-%g", r.Min, r.Max)
+	}
+	if r.Max < r.Min {
+		v.addf(path, "max %g is less than min %g", r.Max, r.Min)
+	}
+}
+
+// validateForgeBoosts는 부스트 단계가 걸음 수와 배율 모두 오름차순인지 확인합니다
+func (v *validator) validateForgeBoosts(tiers []ForgeBoostTier) {
+	for i, tier := range tiers {
+		path := fmt.Sprintf("forge_boosts[%d]", i)
+		if tier.MinSteps <= 0 {
+			v.addf(path+".min_steps", "must be positive, got %d", tier.MinSteps)
+		}
+		if tier.Multiplier <= 1 {
+			v.addf(path+".multiplier", "must be greater than 1, got %g", tier.Multiplier)
+		}
+		if i > 0 && tier.MinSteps <= tiers[i-1].MinSteps {
+			v.addf(path+".min_steps", "must be greater than the previous tier (%d), got %d", tiers[i-1].MinSteps, tier.MinSteps)
+		}
+		if i > 0 && tier.Multiplier < tiers[i-1].Multiplier {
+			v.addf(path+".multiplier", "must not be less than the previous tier (%g), got %g", tiers[i-1].Multiplier, tier.Multiplier)
+		}
+	}
+}
+
+// validateWeapons는 무기 템플릿을 검증하고 유효한 ID 집합을 반환합니다
+func (v *validator) validateWeapons(weapons []WeaponTemplate) map[string]bool {
+	ids := make(map[string]bool, len(weapons))
+	if len(weapons) == 0 {
+		v.addf("weapons", "must have at least one entry")
+	}
+	for i, weapon := range weapons {
+		path := fmt.Sprintf("weapons[%d]", i)
+		v.validateID(path, weapon.ID, ids)
+		if weapon.Name == "" || len(weapon.Name) > 100 {
+			v.addf(path+".name", "must be 1-100 characters")
+		}
+		if !models.IsValidWeaponType(weapon.Type) {
+			v.addf(path+".type", "unknown weapon type %q (expected one of %s)", weapon.Type, strings.Join(models.WeaponTypes, ", "))
+		}
+		if weapon.AttackPower.Min < 1 {
+			v.addf(path+".attack_power.min", "must be at least 1, got %d", weapon.AttackPower.Min)
+		}
+		if weapon.AttackPower.Max < weapon.AttackPower.Min {
+			v.addf(path+".attack_power", "max %d is less than min %d", weapon.AttackPower.Max, weapon.AttackPower.Min)
+		}
+		if weapon.AttackSpeed.Min <= 0 {
+			v.addf(path+".attack_speed.min", "must be positive, got %g", weapon.AttackSpeed.Min)
+		}
+		if weapon.AttackSpeed.Max < weapon.AttackSpeed.Min {
+			v.addf(path+".attack_speed", "max %g is less than min %g", weapon.AttackSpeed.Max, weapon.AttackSpeed.Min)
+		}
+	}
+	return ids
+}
+
+// validateRarities는 무기 등급 표와 등급이 참조하는 특수 효과를 검증하고 유효한 등급 ID 집합을 반환합니다
+// 가장 낮은 등급은 승급 조건이 없고, 나머지 등급은 모두 승급 조건이 있어야 합니다
+func (v *validator) validateRarities(tiers []RarityTier, effects map[string]bool) map[string]bool {
+	ids := make(map[string]bool, len(tiers))
+	if len(tiers) == 0 {
+		v.addf("rarities", "must have at least one entry")
+	}
+	for i, tier := range tiers {
+		path := fmt.Sprintf("rarities[%d]", i)
+		v.validateID(path, tier.ID, ids)
+		if len(tier.ID) > 20 {
+			v.addf(path+".id", "must be at most 20 characters")
+		}
+		if tier.StatMultiplier <= 0 {
+			v.addf(path+".stat_multiplier", "must be positive, got %g", tier.StatMultiplier)
+		}
+		if tier.SellPrice < 0 {
+			v.addf(path+".sell_price", "must not be negative, got %d", tier.SellPrice)
+		}
+		if tier.DismantleScrap < 0 {
+			v.addf(path+".dismantle_scrap", "must not be negative, got %d", tier.DismantleScrap)
+		}
+		if tier.EffectSlots < 0 {
+			v.addf(path+".effect_slots", "must not be negative, got %d", tier.EffectSlots)
+		}
+		if tier.ForgeEffectChance < 0 || tier.ForgeEffectChance > 1 {
+			v.addf(path+".forge_effect_chance", "must be between 0 and 1, got %g", tier.ForgeEffectChance)
+		}
+		seen := make(map[string]bool, len(tier.ForgeEffects))
+		for j, effectID := range tier.ForgeEffects {
+			effectPath := fmt.Sprintf("%s.forge_effects[%d]", path, j)
+			switch {
+			case !effects[effectID]:
+				v.addf(effectPath, "unknown effect %q", effectID)
+			case seen[effectID]:
+				v.addf(effectPath, "duplicate effect %q", effectID)
+			}
+			seen[effectID] = true
+		}
+
+		promotion := tier.Promotion
+		switch {
+		case i == 0 && promotion != nil:
+			v.addf(path+".promotion", "must not be set on the lowest rarity")
+		case i > 0 && promotion == nil:
+			v.addf(path+".promotion", "is required")
+		case promotion != nil:
+			if promotion.SuccessRate <= 0 || promotion.SuccessRate > 1 {
+				v.addf(path+".promotion.success_rate", "must be greater than 0 and at most 1, got %g", promotion.SuccessRate)
+			}
+			if promotion.Pity < 0 {
+				v.addf(path+".promotion.pity", "must not be negative, got %d", promotion.Pity)
+			}
+			if promotion.Duplicates < 0 {
+				v.addf(path+".promotion.duplicates", "must not be negative, got %d", promotion.Duplicates)
+			}
+			if promotion.GoldCost < 0 {
+				v.addf(path+".promotion.gold_cost", "must not be negative, got %d", promotion.GoldCost)
+			}
+		}
+	}
+	return ids
+}
+
+// validateDropTables는 드롭 테이블과 테이블이 참조하는 등급을 검증하고 유효한 ID 집합을 반환합니다
+func (v *validator) validateDropTables(tables []DropTable, rarities map[string]bool) map[string]bool {
+	ids := make(map[string]bool, len(tables))
+	for i, table := range tables {
+		path := fmt.Sprintf("drop_tables[%d]", i)
+		v.validateID(path, table.ID, ids)
+		if len(table.Rarities) == 0 {
+			v.addf(path+".rarities", "must have at least one entry")
+		}
+		seen := make(map[string]bool, len(table.Rarities))
+		for j, entry := range table.Rarities {
+			entryPath := fmt.Sprintf("%s.rarities[%d]", path, j)
+			if !rarities[entry.Rarity] {
+				v.addf(entryPath+".rarity", "unknown rarity %q", entry.Rarity)
+			} else if seen[entry.Rarity] {
+				v.addf(entryPath+".rarity", "duplicate rarity %q", entry.Rarity)
+			}
+			seen[entry.Rarity] = true
+			if entry.Weight <= 0 {
+				v.addf(entryPath+".weight", "must be positive, got %d", entry.Weight)
+			}
+		}
+	}
+	return ids
+}
+
+// validateForgeRecipes는 제작 레시피와 레시피가 참조하는 무기 템플릿, 드롭 테이블을 검증합니다
+func (v *validator) validateForgeRecipes(recipes []ForgeRecipe, weapons, dropTables map[string]bool) {
+	ids := make(map[string]bool, len(recipes))
+	for i, recipe := range recipes {
+		path := fmt.Sprintf("forge_recipes[%d]", i)
+		v.validateID(path, recipe.ID, ids)
+		if !weapons[recipe.Weapon] {
+			v.addf(path+".weapon", "unknown weapon %q", recipe.Weapon)
+		}
+		if !dropTables[recipe.DropTable] {
+			v.addf(path+".drop_table", "unknown drop table %q", recipe.DropTable)
+		}
+		if recipe.GoldCost < 0 {
+			v.addf(path+".gold_cost", "must not be negative, got %d", recipe.GoldCost)
+		}
+		if recipe.DurationSeconds <= 0 {
+			v.addf(path+".duration_seconds", "must be positive, got %d", recipe.DurationSeconds)
+		}
+		if recipe.MinLevel < 1 {
+			v.addf(path+".min_level", "must be at least 1, got %d", recipe.MinLevel)
+		}
+	}
+}
+
+// validateID는 항목 ID가 비어 있지 않고 같은 섹션 안에서 겹치지 않는지 확인한 뒤 ids에 추가합니다
+func (v *validator) validateID(path, id string, ids map[string]bool) {
+	switch {
+	case id == "":
+		v.addf(path+".id", "is required")
+	case ids[id]:
+		v.addf(path+".id", "duplicate id %q", id)
+	default:
+		ids[id] = true
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// 감사 로그 대상 종류입니다
+const (
+	AuditTargetPlayer  = "player"
+	AuditTargetDungeon = "dungeon"
+)
+
+// 감사 로그 동작 종류입니다
+const (
+	AuditActionDungeonCreate   = "dungeon.create"
+	AuditActionDungeonUpdate   = "dungeon.update"
+	AuditActionDungeonDelete   = "dungeon.delete"
+	AuditActionPlayerSuspend   = "player.suspend"
+	AuditActionPlayerLift      = "player.suspension_lift"
+	AuditActionPlayerRole      = "player.role"
+	AuditActionGrantGold       = "grant.gold"
+	AuditActionGrantEmbers     = "grant.embers"
+	AuditActionGrantGems       = "grant.gems"
+	AuditActionGrantScrap      = "grant.scrap"
+	AuditActionGrantWeapon     = "grant.weapon"
+	AuditActionGrantItem       = "grant.item"
+	AuditActionGrantExperience = "grant.experience"
+)
+
+// AuditLog는 운영자/관리자가 수행한 작업 기록입니다
+// 누가(ActorID), 무엇을(Action), 어디에(TargetType/TargetID) 했는지 변경 내용(Details)과 함께 남깁니다
+type AuditLog struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	ActorID    uint      `gorm:"not null;index" json:"actor_id"`
+	ActorRole  string    `gorm:"size:20" json:"actor_role"`
+	Action     string    `gorm:"not null;size:50;index" json:"action"`
+	TargetType string    `gorm:"not null;size:20;index:idx_audit_logs_target" json:"target_type"`
+	TargetID   uint      `gorm:"not null;index:idx_audit_logs_target" json:"target_id"`
+	Details    string    `gorm:"type:text" json:"details"` // 변경 내용 (JSON)
+	IP         string    `gorm:"size:45" json:"ip"`
+	CreatedAt  time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
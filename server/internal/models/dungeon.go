@@ -21,3 +21,17 @@ type Dungeon struct {
 func (Dungeon) TableName() string {
 	return "dungeons"
 }
+
+// DungeonUnlock은 레벨업 보상으로 해금한 던전입니다 (플레이어·던전별 1건)
+type DungeonUnlock struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	PlayerID   uint      `gorm:"not null;uniqueIndex:idx_dungeon_unlock_player_dungeon" json:"player_id"`
+	DungeonID  uint      `gorm:"not null;uniqueIndex:idx_dungeon_unlock_player_dungeon" json:"dungeon_id"`
+	Level      int       `gorm:"not null" json:"level"` // 해금 보상을 받은 레벨
+	UnlockedAt time.Time `gorm:"not null" json:"unlocked_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (DungeonUnlock) TableName() string {
+	return "dungeon_unlocks"
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+)
+
+// 대장간 제작 작업 상태입니다
+// DB에는 crafting/claimed만 저장하고, 완료 시각이 지난 crafting 작업은 조회 시 ready로 보여줍니다
+const (
+	ForgeJobStatusCrafting = "crafting" // 제작 중
+	ForgeJobStatusReady    = "ready"    // 제작 완료, 수령 대기
+	ForgeJobStatusClaimed  = "claimed"  // 무기 수령 완료
+	ForgeJobStatusRefunded = "refunded" // 레시피가 사라져 비용을 돌려줌 (레시피를 고정하기 전에 시작한 작업만)
+)
+
+// ForgeJob은 대장간에서 진행 중이거나 완료된 무기 제작 작업입니다
+// 비용은 작업 시작 시 차감되고, 무기 능력치는 수령 시 서버에서 결정됩니다
+// 시작할 때 레시피 내용(Recipe)을 고정하므로 그 뒤 콘텐츠가 바뀌어도 비용을 낸 시점의 레시피대로 수령합니다
+type ForgeJob struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	PlayerID    uint           `gorm:"not null;index" json:"player_id"`
+	RecipeID    string         `gorm:"not null;size:50" json:"recipe_id"`
+	Status      string         `gorm:"not null;size:20;default:crafting;index" json:"status"`
+	GoldCost    int64          `gorm:"not null" json:"gold_cost"` // 시작 시 차감한 골드 (레시피 변경과 무관하게 기록)
+	StartedAt   time.Time      `gorm:"not null" json:"started_at"`
+	CompletesAt time.Time      `gorm:"not null;index" json:"completes_at"`
+	ForgeBoost  float64        `gorm:"not null;default:1" json:"forge_boost"` // 시작 시점의 걸음 수 부스트 배율 (제작 시간 단축, 등급 확률 보정)
+	BoostSteps  int            `gorm:"not null;default:0" json:"boost_steps"` // 부스트 산정에 사용한 당일 걸음 수
+	Recipe      ForgeJobRecipe `gorm:"embedded;embeddedPrefix:recipe_" json:"recipe"`
+	ClaimedAt   *time.Time     `json:"claimed_at,omitempty"`
+	WeaponID    *uint          `json:"weapon_id,omitempty"` // 수령 시 생성된 무기
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+
+	// 관계
+	Player Player  `gorm:"foreignKey:PlayerID" json:"-"`
+	Weapon *Weapon `gorm:"foreignKey:WeaponID" json:"weapon,omitempty"`
+}
+
+// ForgeJobRecipe는 작업을 시작할 때 고정한 레시피 내용(무기 템플릿과 부스트를 적용한 등급 가중치)입니다
+// 이 기능 이전에 시작한 작업은 비어 있습니다 (WeaponType == "")
+type ForgeJobRecipe struct {
+	WeaponName     string              `gorm:"size:100" json:"weapon_name"`
+	WeaponType     string              `gorm:"size:20" json:"weapon_type"`
+	AttackPowerMin int                 `json:"attack_power_min"`
+	AttackPowerMax int                 `json:"attack_power_max"`
+	AttackSpeedMin float64             `json:"attack_speed_min"`
+	AttackSpeedMax float64             `json:"attack_speed_max"`
+	RarityWeights  []ForgeRarityWeight `gorm:"type:text;serializer:json" json:"rarity_weights"`
+}
+
+// ForgeRarityWeight는 고정한 레시피의 결과 등급 하나와 그 가중치입니다
+type ForgeRarityWeight struct {
+	Rarity string `json:"rarity"`
+	Weight int    `json:"weight"`
+}
+
+// IsEmpty는 레시피 내용을 고정하지 않은 (이 기능 이전에 시작한) 작업인지 확인합니다
+func (r ForgeJobRecipe) IsEmpty() bool {
+	return r.WeaponType == ""
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (ForgeJob) TableName() string {
+	return "forge_jobs"
+}
+
+// StatusAt은 now 기준의 작업 상태를 반환합니다 (완료 시각이 지난 제작 중 작업은 ready)
+func (j *ForgeJob) StatusAt(now time.Time) string {
+	if j.Status == ForgeJobStatusCrafting && !now.Before(j.CompletesAt) {
+		return ForgeJobStatusReady
+	}
+	return j.Status
+}
+
+// RemainingAt은 now 기준으로 제작 완료까지 남은 시간을 반환합니다 (완료되었으면 0)
+func (j *ForgeJob) RemainingAt(now time.Time) time.Duration {
+	if j.Status != ForgeJobStatusCrafting || !now.Before(j.CompletesAt) {
+		return 0
+	}
+	return j.CompletesAt.Sub(now)
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// 아이템 종류입니다
+const (
+	ItemTypeOre      = "ore"       // 광석 (제작 재료)
+	ItemTypeGemShard = "gem_shard" // 보석 파편 (제작 재료)
+	ItemTypeScroll   = "scroll"    // 주문서 (소모품)
+)
+
+// ItemTypes는 모든 아이템 종류입니다
+var ItemTypes = []string{ItemTypeOre, ItemTypeGemShard, ItemTypeScroll}
+
+// IsValidItemType은 정의된 아이템 종류인지 확인합니다
+func IsValidItemType(itemType string) bool {
+	for _, t := range ItemTypes {
+		if t == itemType {
+			return true
+		}
+	}
+	return false
+}
+
+// InventoryItem은 플레이어가 보유한 아이템 한 종류의 수량입니다 (플레이어·아이템별 1건)
+// 아이템의 이름, 종류, 최대 수량 등은 아이템 목록(카탈로그)에서 ItemID로 찾습니다
+type InventoryItem struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlayerID  uint      `gorm:"not null;uniqueIndex:idx_inventory_player_item" json:"player_id"`
+	ItemID    string    `gorm:"not null;size:32;uniqueIndex:idx_inventory_player_item" json:"item_id"`
+	Quantity  int64     `gorm:"not null;default:0" json:"quantity"` // 0 이상 (모두 쓰면 0으로 남음)
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (InventoryItem) TableName() string {
+	return "inventory_items"
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// PasswordReset은 비밀번호 재설정 코드입니다
+// 코드 원문은 알림 채널로만 전달하고 서버에는 SHA-256 해시만 저장합니다
+type PasswordReset struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	PlayerID  uint       `gorm:"not null;index" json:"player_id"`
+	CodeHash  string     `gorm:"not null;size:64" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null;index" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"` // 사용(또는 새 코드 발급으로 무효화)된 시간
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (PasswordReset) TableName() string {
+	return "password_resets"
+}
+
+// IsUsable은 코드가 아직 사용되지 않았고 만료되지 않았는지 확인합니다
+func (pr *PasswordReset) IsUsable(now time.Time) bool {
+	return pr.UsedAt == nil && now.Before(pr.ExpiresAt)
+}
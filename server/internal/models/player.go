@@ -12,17 +12,109 @@ type Player struct {
 	Password    string    `gorm:"not null" json:"-"` // JSON 응답에서 제외
 	Level       int       `gorm:"default:1;index" json:"level"`
 	Experience  int64     `gorm:"default:0" json:"experience"`
-	Gold        int64     `gorm:"default:0;index" json:"gold"`
 	MaxDistance float64   `gorm:"default:0" json:"max_distance"`
 	TotalKills  int       `gorm:"default:0" json:"total_kills"`
+	IsGuest     bool      `gorm:"default:false;index" json:"is_guest"` // 게스트 계정 여부 (아이디/비밀번호 연결 전)
+	DeviceID    *string   `gorm:"uniqueIndex;size:128" json:"-"`       // 게스트 계정이 묶인 기기 식별자 (계정 연결 시 해제)
+
+	// 권한
+	Role string `gorm:"size:20;default:player;index" json:"role"` // player, operator, admin
+
+	// 프로필 (플레이어가 직접 수정할 수 있는 필드)
+	DisplayName   string                  `gorm:"size:20" json:"display_name"`               // 화면에 표시할 이름 (비어 있으면 Username 사용)
+	AvatarID      string                  `gorm:"size:32" json:"avatar_id"`                  // 선택한 아바타 식별자
+	Locale        string                  `gorm:"size:10;default:ko" json:"locale"`          // 알림/콘텐츠 언어
+	Timezone      string                  `gorm:"size:64;default:Asia/Seoul" json:"timezone"` // 하루 걸음 수 집계 기준 시간대 (IANA 이름)
+	Notifications NotificationPreferences `gorm:"embedded;embeddedPrefix:notify_" json:"notification_preferences"`
+
+	DeletionScheduledAt *time.Time `gorm:"index" json:"deletion_scheduled_at,omitempty"` // 계정 삭제 예정 시각 (유예 기간 중 로그인하면 취소)
+
+	WeaponCapacityBonus int `gorm:"not null;default:0" json:"weapon_capacity_bonus"` // 보관함 확장으로 늘어난 무기 보관 수
+
+	// 방치 사냥
+	Stage        int        `gorm:"not null;default:1" json:"stage"`       // 현재 사냥 중인 스테이지
+	StageKills   int        `gorm:"not null;default:0" json:"stage_kills"` // 현재 스테이지에서 처치한 몬스터 수 (스테이지를 옮기면 0부터 다시 셈)
+	IdleSyncedAt *time.Time `json:"idle_synced_at,omitempty"`              // 방치 사냥을 마지막으로 정산한 시각 (처음 정산하기 전이면 nil)
+
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// 관계
-	Weapons         []Weapon    `gorm:"foreignKey:PlayerID" json:"weapons,omitempty"`
-	CurrentWeaponID *uint       `json:"current_weapon_id,omitempty"`
-	CurrentWeapon   *Weapon     `gorm:"foreignKey:CurrentWeaponID" json:"current_weapon,omitempty"`
+	Weapons         []Weapon        `gorm:"foreignKey:PlayerID" json:"weapons,omitempty"`
+	CurrentWeaponID *uint           `json:"current_weapon_id,omitempty"`
+	CurrentWeapon   *Weapon         `gorm:"foreignKey:CurrentWeaponID" json:"current_weapon,omitempty"`
+	Suspensions     []Suspension    `gorm:"foreignKey:PlayerID" json:"suspensions,omitempty"` // 이용 정지 이력 (필요할 때만 로드)
+	Wallet          []WalletBalance `gorm:"foreignKey:PlayerID" json:"-"`                     // 재화별 잔액 (골드, 활력의 불씨, 보석)
+}
+
+// 플레이어 역할입니다 (아래로 갈수록 권한이 큼)
+const (
+	RolePlayer   = "player"   // 일반 플레이어
+	RoleOperator = "operator" // 운영자: 던전 관리, 플레이어 조회/이용 정지
+	RoleAdmin    = "admin"    // 관리자: 운영자 권한 + 재화/아이템 지급, 역할 변경, 감사 로그 조회
+)
+
+// roleRanks는 역할별 권한 순위입니다
+var roleRanks = map[string]int{
+	RolePlayer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// IsValidRole은 정의된 역할인지 확인합니다
+func IsValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// RoleAtLeast는 role이 minRole 이상의 권한인지 확인합니다
+// 역할이 비어 있으면(마이그레이션 이전 데이터) 일반 플레이어로 간주합니다
+func RoleAtLeast(role, minRole string) bool {
+	if role == "" {
+		role = RolePlayer
+	}
+	return roleRanks[role] >= roleRanks[minRole]
+}
+
+// 지원하는 언어 목록입니다
+const (
+	LocaleKorean   = "ko"
+	LocaleEnglish  = "en"
+	LocaleJapanese = "ja"
+)
+
+// SupportedLocales는 프로필에 설정할 수 있는 언어 목록입니다
+var SupportedLocales = []string{LocaleKorean, LocaleEnglish, LocaleJapanese}
+
+// DefaultTimezone은 시간대를 설정하지 않은 플레이어의 기본 시간대입니다
+const DefaultTimezone = "Asia/Seoul"
+
+// IsValidTimezone은 IANA 시간대 이름인지 확인합니다 (빈 문자열과 서버 로컬 시간대 "Local"은 허용하지 않음)
+func IsValidTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// NotificationPreferences는 플레이어의 푸시 알림 수신 설정입니다
+type NotificationPreferences struct {
+	ForgeComplete bool `gorm:"default:true" json:"forge_complete"` // 대장간 제작 완료
+	StepGoal      bool `gorm:"default:true" json:"step_goal"`      // 일일 걸음 목표 달성
+	Raid          bool `gorm:"default:true" json:"raid"`           // 레이드 시작/초대
+	Events        bool `gorm:"default:true" json:"events"`         // 이벤트 던전 오픈
+}
+
+// DefaultNotificationPreferences는 신규 플레이어의 기본 알림 설정(전체 수신)을 반환합니다
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		ForgeComplete: true,
+		StepGoal:      true,
+		Raid:          true,
+		Events:        true,
+	}
 }
 
 // TableName은 GORM이 사용할 테이블 이름을 지정합니다
@@ -35,25 +127,73 @@ func (p *Player) BeforeCreate(tx *gorm.DB) error {
 	if p.Level == 0 {
 		p.Level = 1
 	}
-	if p.Gold == 0 {
-		p.Gold = 0
+	if p.Locale == "" {
+		p.Locale = LocaleKorean
+	}
+	if p.Timezone == "" {
+		p.Timezone = DefaultTimezone
+	}
+	if p.Role == "" {
+		p.Role = RolePlayer
 	}
 	return nil
 }
 
-// AddGold는 플레이어의 골드를 증가시킵니다
-func (p *Player) AddGold(amount int64) {
-	p.Gold += amount
+// Location은 플레이어의 시간대를 반환합니다 (비어 있거나 잘못된 값이면 기본 시간대)
+func (p *Player) Location() *time.Location {
+	if IsValidTimezone(p.Timezone) {
+		if loc, err := time.LoadLocation(p.Timezone); err == nil {
+			return loc
+		}
+	}
+	if loc, err := time.LoadLocation(DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// HasRole은 플레이어가 minRole 이상의 권한을 가졌는지 확인합니다
+func (p *Player) HasRole(minRole string) bool {
+	return RoleAtLeast(p.Role, minRole)
 }
 
-// AddExperience는 플레이어의 경험치를 증가시키고 레벨업을 처리합니다
-func (p *Player) AddExperience(amount int64) {
+// ActiveSuspension은 로드된 이용 정지 이력(Suspensions) 중 현재 유효한 정지를 반환합니다 (없으면 nil)
+func (p *Player) ActiveSuspension(now time.Time) *Suspension {
+	for i := range p.Suspensions {
+		if p.Suspensions[i].IsActive(now) {
+			return &p.Suspensions[i]
+		}
+	}
+	return nil
+}
+
+// IsDeletionPending은 계정 삭제가 예약되어 유예 기간 중인지 확인합니다
+func (p *Player) IsDeletionPending() bool {
+	return p.DeletionScheduledAt != nil
+}
+
+// Balance는 로드된 지갑(Wallet)에서 재화 잔액을 반환합니다 (잔액 기록이 없으면 0)
+func (p *Player) Balance(currency string) int64 {
+	for _, balance := range p.Wallet {
+		if balance.Currency == currency {
+			return balance.Balance
+		}
+	}
+	return 0
+}
+
+// AddExperience는 플레이어의 경험치를 증가시키고 레벨업을 처리한 뒤 오른 레벨 수를 반환합니다
+// requiredExp는 레벨별로 다음 레벨까지 필요한 경험치를 반환합니다 (게임 콘텐츠의 레벨 곡선)
+// maxLevel에 도달하면 남은 경험치는 버리고 더 이상 쌓지 않습니다
+func (p *Player) AddExperience(amount int64, maxLevel int, requiredExp func(level int) int64) int {
+	from := p.Level
 	p.Experience += amount
-	// 레벨업 로직 (예: 레벨당 100 경험치 필요)
-	requiredExp := int64(p.Level * 100)
-	for p.Experience >= requiredExp {
-		p.Experience -= requiredExp
+	for p.Level < maxLevel && p.Experience >= requiredExp(p.Level) {
+		p.Experience -= requiredExp(p.Level)
 		p.Level++
-		requiredExp = int64(p.Level * 100)
 	}
+	if p.Level >= maxLevel {
+		p.Experience = 0
+	}
+	return p.Level - from
 }
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// 영구 강화로 올릴 수 있는 능력치입니다
+const (
+	StatAttackSpeed = "attack_speed"
+	StatAttackPower = "attack_power"
+)
+
+// PlayerUpgrade는 플레이어가 활력의 불씨로 구매한 영구 강화 단계입니다
+// (플레이어, 강화 노드)마다 한 건이며 Level은 구매한 단계 수입니다
+type PlayerUpgrade struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlayerID  uint      `gorm:"not null;uniqueIndex:idx_player_upgrade_player_node" json:"player_id"`
+	NodeID    string    `gorm:"not null;size:32;uniqueIndex:idx_player_upgrade_player_node" json:"node_id"`
+	Level     int       `gorm:"not null;default:0" json:"level"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (PlayerUpgrade) TableName() string {
+	return "player_upgrades"
+}
+
+// StatBonuses는 영구 강화로 얻은 능력치 보너스 비율입니다 (0.1 = +10%)
+type StatBonuses struct {
+	AttackSpeed float64
+	AttackPower float64
+}
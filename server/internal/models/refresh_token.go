@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// RefreshToken은 액세스 토큰 재발급에 사용하는 리프레시 토큰입니다
+// 원문은 클라이언트만 보관하고 서버에는 SHA-256 해시만 저장합니다
+type RefreshToken struct {
+	ID           uint       `gorm:"primaryKey" json:"id"`
+	PlayerID     uint       `gorm:"not null;index" json:"player_id"`
+	FamilyID     string     `gorm:"not null;size:32;index" json:"family_id"` // 로그인 1회에서 파생된 토큰 묶음 (회전해도 유지)
+	TokenHash    string     `gorm:"not null;size:64;uniqueIndex" json:"-"`
+	ExpiresAt    time.Time  `gorm:"not null;index" json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`     // 회전/로그아웃으로 폐기된 시간
+	ReplacedByID *uint      `json:"replaced_by_id,omitempty"` // 회전 시 새로 발급된 토큰 ID
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// IsRevoked는 토큰이 폐기되었는지 확인합니다
+func (rt *RefreshToken) IsRevoked() bool {
+	return rt.RevokedAt != nil
+}
+
+// IsExpired는 토큰이 만료되었는지 확인합니다
+func (rt *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(rt.ExpiresAt)
+}
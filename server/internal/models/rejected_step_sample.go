@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// 걸음 수 샘플 거부 사유입니다
+const (
+	StepRejectInvalid      = "invalid"       // 종료 시각이 시작 시각보다 빠르거나 같음, 또는 음수 값
+	StepRejectSpansDays    = "spans_days"    // 하루 경계를 넘는 샘플
+	StepRejectFuture       = "future"        // 서버 시각보다 미래의 샘플
+	StepRejectTooOld       = "too_old"       // 동기화 허용 기간보다 오래된 샘플
+	StepRejectNonMonotonic = "non_monotonic" // 같은 요청 안에서 시간순이 아니거나 앞 샘플과 겹침
+	StepRejectOverlap      = "overlap"       // 이미 반영된 샘플 구간과 겹침
+	StepRejectRateExceeded = "rate_exceeded" // 초당 걸음 수가 사람이 낼 수 있는 속도를 넘음
+	StepRejectDailyLimit   = "daily_limit"   // 하루 최대 걸음 수를 넘음
+)
+
+// RejectedStepSample은 검증에 실패하여 반영되지 않은 걸음 수 샘플입니다
+// 부정 행위 검토를 위해 클라이언트가 보낸 값을 그대로 보관합니다
+type RejectedStepSample struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlayerID  uint      `gorm:"not null;index" json:"player_id"`
+	Source    string    `gorm:"size:50" json:"source,omitempty"` // 샘플을 보낸 기기/앱 (예: "ios_healthkit", "watch")
+	StartedAt time.Time `gorm:"not null" json:"started_at"`
+	EndedAt   time.Time `gorm:"not null" json:"ended_at"`
+	Steps     int       `gorm:"not null" json:"steps"`
+	Reason    string    `gorm:"not null;size:30;index" json:"reason"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (RejectedStepSample) TableName() string {
+	return "rejected_step_samples"
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// 세션을 생성한 기기의 플랫폼입니다
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWatchOS = "watchos"
+	PlatformWearOS  = "wearos"
+	PlatformWeb     = "web"
+	PlatformUnknown = "unknown"
+)
+
+// Session은 로그인 1회로 생성되는 기기별 세션입니다
+// 스토리 설정: 휴대폰과 워치 등 여러 기기에서 같은 대장장이로 접속할 수 있습니다
+type Session struct {
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	PlayerID        uint       `gorm:"not null;index" json:"player_id"`
+	RefreshFamilyID string     `gorm:"not null;size:32;uniqueIndex" json:"-"` // 이 세션에서 발급된 리프레시 토큰 패밀리
+	DeviceName      string     `gorm:"not null;size:100" json:"device_name"`
+	Platform        string     `gorm:"not null;size:20;default:unknown" json:"platform"` // ios, android, watchos, wearos, web, unknown
+	LastSeenAt      time.Time  `gorm:"not null" json:"last_seen_at"`
+	LastIP          string     `gorm:"size:45" json:"last_ip"` // IPv6 최대 길이
+	RevokedAt       *time.Time `gorm:"index" json:"revoked_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (Session) TableName() string {
+	return "sessions"
+}
+
+// IsRevoked는 세션이 종료되었는지 확인합니다
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// StepGoalClaim은 하루 걸음 수 목표 단계별 보상 수령 기록입니다
+// (플레이어, 날짜, 목표 걸음 수)마다 한 번만 기록되어 같은 날 같은 목표 보상이 중복 지급되지 않습니다
+type StepGoalClaim struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlayerID  uint      `gorm:"not null;uniqueIndex:idx_step_goal_claim_player_date_goal" json:"player_id"`
+	Date      string    `gorm:"not null;size:10;uniqueIndex:idx_step_goal_claim_player_date_goal" json:"date"` // 활동 기록 날짜 (플레이어 시간대 기준)
+	GoalSteps int       `gorm:"not null;uniqueIndex:idx_step_goal_claim_player_date_goal" json:"goal_steps"`   // 달성한 목표 걸음 수
+	Steps     int       `gorm:"not null" json:"steps"`                                                         // 수령 시점의 걸음 수
+	Gold      int64     `gorm:"not null" json:"gold"`                                                          // 지급한 골드
+	Embers    int64     `gorm:"not null" json:"embers"`                                                        // 지급한 활력의 불씨
+	ClaimedAt time.Time `gorm:"not null" json:"claimed_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (StepGoalClaim) TableName() string {
+	return "step_goal_claims"
+}
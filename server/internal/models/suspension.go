@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// Suspension은 운영자가 플레이어에게 내린 이용 정지 기록입니다
+// 해제되거나 만료된 기록도 삭제하지 않고 이력으로 남깁니다
+type Suspension struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	PlayerID   uint       `gorm:"not null;index" json:"player_id"`
+	Reason     string     `gorm:"not null;size:255" json:"reason"`
+	IssuedByID uint       `gorm:"not null;index" json:"issued_by_id"` // 정지를 내린 운영자 ID
+	ExpiresAt  *time.Time `gorm:"index" json:"expires_at,omitempty"`  // nil이면 영구 정지
+	LiftedAt   *time.Time `json:"lifted_at,omitempty"`                // 운영자가 만료 전에 해제한 시각
+	LiftedByID *uint      `json:"lifted_by_id,omitempty"`
+	LiftReason string     `gorm:"size:255" json:"lift_reason,omitempty"`
+	CreatedAt  time.Time  `gorm:"index" json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+
+	// 관계
+	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (Suspension) TableName() string {
+	return "suspensions"
+}
+
+// IsPermanent는 만료 시각이 없는 영구 정지인지 확인합니다
+func (s *Suspension) IsPermanent() bool {
+	return s.ExpiresAt == nil
+}
+
+// IsActive는 정지가 해제되지 않았고 아직 만료되지 않았는지 확인합니다
+func (s *Suspension) IsActive(now time.Time) bool {
+	if s.LiftedAt != nil {
+		return false
+	}
+	return s.ExpiresAt == nil || now.Before(*s.ExpiresAt)
+}
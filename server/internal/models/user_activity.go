@@ -1,23 +1,32 @@
 package models
 
 import (
-	"time"
 	"gorm.io/gorm"
+	"time"
 )
 
+// ActivityDateLayout은 UserActivity.Date에 저장하는 날짜 형식입니다
+const ActivityDateLayout = "2006-01-02"
+
 // UserActivity는 사용자의 일일 활동 데이터(걸음 수 등)를 나타냅니다
 // 스토리 설정: 주인공의 움직임(심장 박동/발걸음)이 대장간의 화로를 뜨겁게 만드는 연료입니다
+// 기록마다 실제로 담당하는 시간 구간(DayStartAt~DayEndAt)을 함께 저장하며, 플레이어가 시간대를 바꿔도
+// 구간이 겹치거나 이미 지난 날짜가 다시 열리지 않도록 유지합니다
 type UserActivity struct {
-	ID           uint      `gorm:"primaryKey" json:"id"`
-	UserID       uint      `gorm:"not null;index" json:"user_id"`
-	Date         string    `gorm:"not null;size:10;index" json:"date"` // "2024-05-21" 형식
-	Steps        int       `gorm:"default:0" json:"steps"`            // 당일 걸음 수
-	Calories     float64   `gorm:"default:0" json:"calories"`         // 소모 칼로리
-	LastSyncedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"last_synced_at"` // 마지막 동기화 시간
-	BonusApplied bool      `gorm:"default:false" json:"bonus_applied"` // 걸음 수 목표 달성 보상 수령 여부
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	UserID          uint           `gorm:"not null;uniqueIndex:idx_user_activity_user_date" json:"user_id"`
+	Date            string         `gorm:"not null;size:10;uniqueIndex:idx_user_activity_user_date" json:"date"` // "2024-05-21" 형식 (Timezone 기준 현지 날짜)
+	Timezone        string         `gorm:"size:64" json:"timezone"`                                              // 날짜를 연 시점의 플레이어 시간대
+	DayStartAt      time.Time      `gorm:"index" json:"day_start_at"`                                            // 이 기록이 담당하는 구간의 시작 (UTC 시각)
+	DayEndAt        time.Time      `gorm:"index" json:"day_end_at"`                                              // 이 기록이 담당하는 구간의 끝 (이 시각은 포함하지 않음)
+	Steps           int            `gorm:"default:0" json:"steps"`                                               // 당일 걸음 수
+	Calories        float64        `gorm:"default:0" json:"calories"`                                            // 소모 칼로리
+	LastSyncedAt    time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"last_synced_at"`                      // 마지막 동기화 시간
+	BonusApplied    bool           `gorm:"default:false" json:"bonus_applied"`                                   // 걸음 수 목표 보상을 하나 이상 수령했는지 여부
+	LastSampleEndAt *time.Time     `json:"last_sample_end_at,omitempty"`                                         // 마지막으로 반영한 걸음 수 샘플의 종료 시각 (중복/겹침 판정 기준)
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// 관계
 	User Player `gorm:"foreignKey:UserID" json:"user,omitempty"`
@@ -36,17 +45,7 @@ func (ua *UserActivity) AddSteps(steps int) {
 	ua.LastSyncedAt = time.Now()
 }
 
-// GetForgeBoost는 걸음 수에 따른 대장간 부스트 배율을 반환합니다
-// 스토리: 걸을수록 화로가 뜨거워져 무기 제작 속도가 증가합니다
-func (ua *UserActivity) GetForgeBoost() float64 {
-	// 예: 5,000보 이상이면 2배 부스트
-	if ua.Steps >= 5000 {
-		return 2.0
-	}
-	// 예: 3,000보 이상이면 1.5배 부스트
-	if ua.Steps >= 3000 {
-		return 1.5
-	}
-	// 기본: 1.0배
-	return 1.0
+// Contains는 시각 t가 이 기록이 담당하는 구간에 속하는지 확인합니다
+func (ua *UserActivity) Contains(t time.Time) bool {
+	return !t.Before(ua.DayStartAt) && t.Before(ua.DayEndAt)
 }
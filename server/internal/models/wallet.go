@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+)
+
+// 지갑 재화 종류입니다
+const (
+	CurrencyGold   = "gold"   // 골드 (무기 제작/강화)
+	CurrencyEmbers = "embers" // 활력의 불씨 (걸음 수 목표 달성으로 획득, 영구 강화에 사용)
+	CurrencyGems   = "gems"   // 보석 (유료 재화, 무기 보관함 확장에 사용)
+	CurrencyScrap  = "scrap"  // 무기 파편 (무기 분해로 획득)
+)
+
+// Currencies는 지갑에서 다루는 모든 재화 종류입니다 (응답 표시 순서)
+var Currencies = []string{CurrencyGold, CurrencyEmbers, CurrencyGems, CurrencyScrap}
+
+// IsValidCurrency는 정의된 재화 종류인지 확인합니다
+func IsValidCurrency(currency string) bool {
+	for _, c := range Currencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// 재화 변동 사유입니다
+const (
+	LedgerReasonForgeCraft       = "forge.craft"       // 대장간 제작 비용
+	LedgerReasonForgeRefund      = "forge.refund"      // 레시피가 사라진 제작 작업의 비용 환불
+	LedgerReasonWeaponUpgrade    = "weapon.upgrade"    // 무기 강화 비용
+	LedgerReasonWeaponPromotion  = "weapon.promote"    // 무기 등급 승급 비용
+	LedgerReasonWeaponEnchant    = "weapon.enchant"    // 무기 특수 효과 부여 비용
+	LedgerReasonWeaponSell       = "weapon.sell"       // 무기 판매 대금
+	LedgerReasonWeaponDismantle  = "weapon.dismantle"  // 무기 분해로 얻은 파편
+	LedgerReasonInventoryExpand  = "inventory.expand"  // 무기 보관함 확장 비용
+	LedgerReasonStepGoal         = "step_goal"         // 일일 걸음 수 목표 보상
+	LedgerReasonUpgradePurchase  = "upgrade.purchase"  // 영구 강화 구매
+	LedgerReasonLevelUp          = "level_up"          // 레벨업 보상
+	LedgerReasonIdleHunt         = "idle.hunt"         // 방치 사냥 보상
+	LedgerReasonAdminGrant       = "admin.grant"       // 운영자 지급/회수
+	LedgerReasonMigrationOpening = "migration.opening" // players 테이블에서 옮겨 온 기존 잔액 (지갑 도입 시 한 번)
+)
+
+// WalletBalance는 플레이어의 재화별 잔액입니다
+// 잔액은 WalletLedgerEntry와 같은 트랜잭션에서만 변경되며 음수가 될 수 없습니다
+type WalletBalance struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlayerID  uint      `gorm:"not null;uniqueIndex:idx_wallet_balance_player_currency" json:"player_id"`
+	Currency  string    `gorm:"not null;size:16;uniqueIndex:idx_wallet_balance_player_currency;index:idx_wallet_balance_currency_balance" json:"currency"`
+	Balance   int64     `gorm:"not null;default:0;index:idx_wallet_balance_currency_balance" json:"balance"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (WalletBalance) TableName() string {
+	return "wallet_balances"
+}
+
+// WalletLedgerEntry는 재화 지급/차감 한 건의 기록입니다
+// 모든 잔액 변경은 원장에 남으며, 원장 합계는 항상 잔액과 같습니다
+type WalletLedgerEntry struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	PlayerID     uint      `gorm:"not null;index:idx_wallet_ledger_player_currency" json:"player_id"`
+	Currency     string    `gorm:"not null;size:16;index:idx_wallet_ledger_player_currency" json:"currency"`
+	Amount       int64     `gorm:"not null" json:"amount"`        // 양수면 지급, 음수면 차감
+	BalanceAfter int64     `gorm:"not null" json:"balance_after"` // 반영 후 잔액
+	Reason       string    `gorm:"not null;size:32;index" json:"reason"`
+	RefType      string    `gorm:"size:32" json:"ref_type,omitempty"` // 변동을 일으킨 대상 종류 (예: forge_job, step_goal_claim)
+	RefID        string    `gorm:"size:64" json:"ref_id,omitempty"`   // 변동을 일으킨 대상 식별자
+	Note         string    `gorm:"size:255" json:"note,omitempty"`    // 운영자 지급 사유 등
+	CreatedAt    time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (WalletLedgerEntry) TableName() string {
+	return "wallet_ledger_entries"
+}
@@ -12,16 +12,68 @@ type Weapon struct {
 	Type        string    `gorm:"not null;size:20" json:"type"` // sword, bow, staff
 	AttackPower int       `gorm:"default:10" json:"attack_power"`
 	AttackSpeed float64   `gorm:"default:1.0" json:"attack_speed"`
-	Rarity      string    `gorm:"default:common;size:20" json:"rarity"` // common, rare, epic, legendary
+	Rarity      string    `gorm:"default:common;size:20" json:"rarity"` // 콘텐츠 등급 표(rarities)의 등급 ID
 	Level       int       `gorm:"default:1" json:"level"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 
+	// 현재 등급에서 연속으로 실패한 승급 횟수 (천장 계산용, 승급에 성공하면 0)
+	PromotionFailures int `gorm:"not null;default:0" json:"promotion_failures"`
+
+	// 잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없습니다
+	Locked bool `gorm:"not null;default:false" json:"locked"`
+
 	// 관계
-	Player Player `gorm:"foreignKey:PlayerID" json:"-"`
+	Player  Player         `gorm:"foreignKey:PlayerID" json:"-"`
+	Effects []WeaponEffect `gorm:"foreignKey:WeaponID" json:"effects"` // 특수 효과 (종류별 최대 1개)
+}
+
+// 무기 종류입니다
+const (
+	WeaponTypeSword = "sword"
+	WeaponTypeBow   = "bow"
+	WeaponTypeStaff = "staff"
+)
+
+// WeaponTypes는 모든 무기 종류입니다
+var WeaponTypes = []string{WeaponTypeSword, WeaponTypeBow, WeaponTypeStaff}
+
+// IsValidWeaponType은 정의된 무기 종류인지 확인합니다
+func IsValidWeaponType(weaponType string) bool {
+	for _, t := range WeaponTypes {
+		if t == weaponType {
+			return true
+		}
+	}
+	return false
 }
 
 // TableName은 GORM이 사용할 테이블 이름을 지정합니다
 func (Weapon) TableName() string {
 	return "weapons"
 }
+
+// EffectiveAttackSpeed는 영구 강화 보너스를 적용한 공격 속도를 반환합니다
+func (w *Weapon) EffectiveAttackSpeed(bonuses StatBonuses) float64 {
+	return w.AttackSpeed * (1 + bonuses.AttackSpeed)
+}
+
+// EffectiveAttackPower는 영구 강화 보너스를 적용한 공격력을 반환합니다 (소수점 이하 버림)
+func (w *Weapon) EffectiveAttackPower(bonuses StatBonuses) int {
+	return int(float64(w.AttackPower) * (1 + bonuses.AttackPower))
+}
+
+// DamagePerHit은 영구 강화 보너스와 특수 효과의 기대 추가 피해를 적용한 공격 한 번당 기대 피해입니다
+func (w *Weapon) DamagePerHit(bonuses StatBonuses) float64 {
+	multiplier := 1.0
+	for i := range w.Effects {
+		multiplier += w.Effects[i].ExpectedBonus()
+	}
+	return float64(w.EffectiveAttackPower(bonuses)) * multiplier
+}
+
+// DamagePerSecond는 공격 한 번당 기대 피해에 공격 속도를 곱한 초당 기대 피해입니다
+// 서버에서 피해량을 계산할 때는 이 값을 기준으로 합니다
+func (w *Weapon) DamagePerSecond(bonuses StatBonuses) float64 {
+	return w.DamagePerHit(bonuses) * w.EffectiveAttackSpeed(bonuses)
+}
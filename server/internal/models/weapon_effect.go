@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// WeaponEffect는 무기에 붙은 특수 효과입니다 (무기·효과 종류별 1건)
+// 공격할 때마다 ProcChance 확률로 발동해 공격력 × Magnitude만큼 추가 피해를 줍니다
+type WeaponEffect struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	WeaponID   uint      `gorm:"not null;uniqueIndex:idx_weapon_effect_weapon_type" json:"weapon_id"`
+	Type       string    `gorm:"not null;size:20;uniqueIndex:idx_weapon_effect_weapon_type" json:"type"` // 콘텐츠 특수 효과(enchant.effects)의 ID
+	Magnitude  float64   `gorm:"not null" json:"magnitude"`                                              // 발동 시 공격력 대비 추가 피해 비율 (0.3 = 30%)
+	ProcChance float64   `gorm:"not null" json:"proc_chance"`                                            // 공격마다 발동할 확률 (0~1)
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TableName은 GORM이 사용할 테이블 이름을 지정합니다
+func (WeaponEffect) TableName() string {
+	return "weapon_effects"
+}
+
+// ExpectedBonus는 공격 한 번당 기대 추가 피해 비율입니다 (발동 확률 × 효과량)
+func (e *WeaponEffect) ExpectedBonus() float64 {
+	return e.ProcChance * e.Magnitude
+}
@@ -0,0 +1,105 @@
+package notifier
+
+import (
+	"encoding/json"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/models"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Notifier는 플레이어에게 계정 관련 알림(비밀번호 재설정 코드 등)을 전달하는 인터페이스입니다
+// 이메일, 푸시 등 실제 전달 채널은 이 인터페이스를 구현하여 교체합니다
+type Notifier interface {
+	SendPasswordResetCode(player *models.Player, code string, expiresAt time.Time) error
+}
+
+// New는 설정(NotifierDriver)에 맞는 Notifier 구현체를 생성합니다
+// 알 수 없는 값이면 경고를 남기고 LogNotifier를 사용합니다
+func New(cfg *config.Config) Notifier {
+	switch cfg.NotifierDriver {
+	case "", "log":
+		return NewLogNotifier()
+	case "file":
+		return NewFileNotifier(cfg.NotifierFilePath)
+	default:
+		log.Printf("Unknown notifier driver %q, falling back to log notifier", cfg.NotifierDriver)
+		return NewLogNotifier()
+	}
+}
+
+// LogNotifier는 알림 내용을 서버 로그로 출력하는 로컬 개발용 구현체입니다
+type LogNotifier struct{}
+
+// LogNotifier가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ Notifier = (*LogNotifier)(nil)
+
+// NewLogNotifier는 새로운 LogNotifier 인스턴스를 생성합니다
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// SendPasswordResetCode는 재설정 코드를 서버 로그에 출력합니다
+func (n *LogNotifier) SendPasswordResetCode(player *models.Player, code string, expiresAt time.Time) error {
+	log.Printf("[notifier] password reset code for player %d (%s): %s (expires at %s)",
+		player.ID, player.Username, code, expiresAt.Format(time.RFC3339))
+	return nil
+}
+
+// FileNotifier는 알림을 JSON Lines 형식으로 파일에 추가하는 로컬 개발/테스트용 구현체입니다
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// FileNotifier가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ Notifier = (*FileNotifier)(nil)
+
+// NewFileNotifier는 새로운 FileNotifier 인스턴스를 생성합니다
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+// fileNotification은 파일에 기록되는 알림 한 줄의 형식입니다
+type fileNotification struct {
+	Type      string    `json:"type"`
+	PlayerID  uint      `json:"player_id"`
+	Username  string    `json:"username"`
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// SendPasswordResetCode는 재설정 코드를 파일에 한 줄로 기록합니다
+func (n *FileNotifier) SendPasswordResetCode(player *models.Player, code string, expiresAt time.Time) error {
+	return n.append(fileNotification{
+		Type:      "password_reset",
+		PlayerID:  player.ID,
+		Username:  player.Username,
+		Code:      code,
+		ExpiresAt: expiresAt,
+		SentAt:    time.Now(),
+	})
+}
+
+// append는 알림을 JSON으로 직렬화하여 파일 끝에 추가합니다
+func (n *FileNotifier) append(notification fileNotification) error {
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepository는 감사 로그 데이터 접근을 담당합니다
+// AuditLogRepositoryInterface를 구현합니다
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+// AuditLogRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ AuditLogRepositoryInterface = (*AuditLogRepository)(nil)
+
+// NewAuditLogRepository는 새로운 AuditLogRepository 인스턴스를 생성합니다
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Create는 새로운 감사 로그를 저장합니다
+func (r *AuditLogRepository) Create(log *models.AuditLog) error {
+	return r.db.Create(log).Error
+}
+
+// Find는 조건에 맞는 감사 로그를 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *AuditLogRepository) Find(filter AuditLogFilter, limit, offset int) ([]models.AuditLog, int64, error) {
+	query := r.db.Model(&models.AuditLog{})
+	if filter.ActorID != 0 {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != 0 {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []models.AuditLog
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&logs).Error
+	return logs, total, err
+}
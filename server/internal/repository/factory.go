@@ -7,16 +7,47 @@ import (
 
 // Repositories는 모든 Repository를 담는 구조체입니다
 type Repositories struct {
-	Player  PlayerRepositoryInterface
-	Weapon  WeaponRepositoryInterface
-	Dungeon DungeonRepositoryInterface
+	Player          PlayerRepositoryInterface
+	Weapon          WeaponRepositoryInterface
+	Dungeon         DungeonRepositoryInterface
+	ForgeJob        ForgeJobRepositoryInterface
+	RefreshToken    RefreshTokenRepositoryInterface
+	Session         SessionRepositoryInterface
+	PasswordReset   PasswordResetRepositoryInterface
+	UserActivity    UserActivityRepositoryInterface
+	RejectedStep    RejectedStepSampleRepositoryInterface
+	StepGoalClaim   StepGoalClaimRepositoryInterface
+	Wallet          WalletRepositoryInterface
+	Inventory       InventoryRepositoryInterface
+	PlayerUpgrade   PlayerUpgradeRepositoryInterface
+	Progression     ProgressionRepositoryInterface
+	RaidParticipant RaidParticipantRepositoryInterface
+	Suspension      SuspensionRepositoryInterface
+	AuditLog        AuditLogRepositoryInterface
+	LoginAttempt    LoginAttemptStoreInterface
 }
 
 // NewRepositories는 실제 데이터베이스 Repository를 생성합니다
 func NewRepositories(db *gorm.DB, cfg *config.Config) *Repositories {
 	return &Repositories{
-		Player:  NewPlayerRepository(db),
-		Weapon:  NewWeaponRepository(db),
-		Dungeon: NewDungeonRepository(db),
+		Player:          NewPlayerRepository(db),
+		Weapon:          NewWeaponRepository(db),
+		Dungeon:         NewDungeonRepository(db),
+		ForgeJob:        NewForgeJobRepository(db),
+		RefreshToken:    NewRefreshTokenRepository(db),
+		Session:         NewSessionRepository(db),
+		PasswordReset:   NewPasswordResetRepository(db),
+		UserActivity:    NewUserActivityRepository(db),
+		RejectedStep:    NewRejectedStepSampleRepository(db),
+		StepGoalClaim:   NewStepGoalClaimRepository(db),
+		Wallet:          NewWalletRepository(db),
+		Inventory:       NewInventoryRepository(db),
+		PlayerUpgrade:   NewPlayerUpgradeRepository(db),
+		Progression:     NewProgressionRepository(db),
+		RaidParticipant: NewRaidParticipantRepository(db),
+		Suspension:      NewSuspensionRepository(db),
+		AuditLog:        NewAuditLogRepository(db),
+		// TODO: 다중 서버 배포 시 cfg.RedisHost 기반 Redis 구현으로 교체
+		LoginAttempt: NewMemoryLoginAttemptStore(),
 	}
 }
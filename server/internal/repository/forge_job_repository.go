@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrForgeSlotsFull은 수령하지 않은 제작 작업이 최대 개수에 도달했을 때 반환됩니다
+	ErrForgeSlotsFull = errors.New("all forge slots are in use")
+	// ErrForgeInventoryFull은 보유 무기와 수령하지 않은 제작 작업이 무기 보관 가능 수에 도달했을 때 반환됩니다
+	ErrForgeInventoryFull = errors.New("weapon inventory is full")
+	// ErrForgeJobNotClaimable은 제작이 끝나지 않았거나 이미 수령한 작업을 수령하려 할 때 반환됩니다
+	ErrForgeJobNotClaimable = errors.New("forge job is not claimable")
+)
+
+// ForgeJobRepository는 대장간 제작 작업 데이터 접근을 담당합니다
+// ForgeJobRepositoryInterface를 구현합니다
+type ForgeJobRepository struct {
+	db *gorm.DB
+}
+
+// ForgeJobRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ ForgeJobRepositoryInterface = (*ForgeJobRepository)(nil)
+
+// NewForgeJobRepository는 새로운 ForgeJobRepository 인스턴스를 생성합니다
+func NewForgeJobRepository(db *gorm.DB) *ForgeJobRepository {
+	return &ForgeJobRepository{db: db}
+}
+
+// Start는 제작 작업을 저장하고 제작 비용(job.GoldCost)을 차감하는 작업을 하나의 트랜잭션으로 처리합니다
+// 골드가 부족하면 *InsufficientFundsError, 수령하지 않은 작업이 limits.MaxActive개 이상이면 ErrForgeSlotsFull,
+// 보유 무기와 수령하지 않은 작업을 합쳐 무기 보관 가능 수 이상이면 ErrForgeInventoryFull을 반환합니다
+func (r *ForgeJobRepository) Start(job *models.ForgeJob, limits ForgeStartLimits) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 플레이어 행을 잠가 같은 플레이어의 동시 요청이 아래 슬롯·보관함 확인부터 순서대로 처리되게 합니다
+		var player models.Player
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "weapon_capacity_bonus").
+			First(&player, job.PlayerID).Error; err != nil {
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&models.ForgeJob{}).
+			Where("player_id = ? AND status = ?", job.PlayerID, models.ForgeJobStatusCrafting).
+			Count(&active).Error; err != nil {
+			return err
+		}
+		if active >= int64(limits.MaxActive) {
+			return ErrForgeSlotsFull
+		}
+
+		// 수령하지 않은 작업도 무기 보관함의 자리를 차지합니다
+		var weapons int64
+		if err := tx.Model(&models.Weapon{}).
+			Where("player_id = ?", job.PlayerID).
+			Count(&weapons).Error; err != nil {
+			return err
+		}
+		if weapons+active >= int64(limits.WeaponCapacity(player.WeaponCapacityBonus)) {
+			return ErrForgeInventoryFull
+		}
+
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		return applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: job.PlayerID,
+			Currency: models.CurrencyGold,
+			Amount:   -job.GoldCost,
+			Reason:   models.LedgerReasonForgeCraft,
+			RefType:  "forge_job",
+			RefID:    strconv.FormatUint(uint64(job.ID), 10),
+		}})
+	})
+}
+
+// FindByID는 ID로 제작 작업을 조회합니다
+func (r *ForgeJobRepository) FindByID(id uint) (*models.ForgeJob, error) {
+	var job models.ForgeJob
+	if err := r.db.First(&job, id).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindUnclaimedByPlayerID는 플레이어가 아직 수령하지 않은 제작 작업을 완료 시각 순으로 조회합니다
+func (r *ForgeJobRepository) FindUnclaimedByPlayerID(playerID uint) ([]models.ForgeJob, error) {
+	var jobs []models.ForgeJob
+	err := r.db.
+		Where("player_id = ? AND status = ?", playerID, models.ForgeJobStatusCrafting).
+		Order("completes_at ASC, id ASC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// FindByPlayerID는 플레이어의 모든 제작 작업(수령·환불한 작업 포함)을 시작 순으로 조회합니다
+func (r *ForgeJobRepository) FindByPlayerID(playerID uint) ([]models.ForgeJob, error) {
+	var jobs []models.ForgeJob
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("id ASC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// Claim은 완료된 제작 작업을 수령 처리하고 결과 무기를 생성하는 작업을 하나의 트랜잭션으로 처리합니다
+// 조건부 갱신으로 같은 작업을 동시에 수령해도 무기는 한 번만 생성되며, 나머지는 ErrForgeJobNotClaimable을 반환합니다
+func (r *ForgeJobRepository) Claim(id uint, weapon *models.Weapon, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ForgeJob{}).
+			Where("id = ? AND status = ? AND completes_at <= ?", id, models.ForgeJobStatusCrafting, now).
+			Updates(map[string]interface{}{
+				"status":     models.ForgeJobStatusClaimed,
+				"claimed_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrForgeJobNotClaimable
+		}
+
+		if err := tx.Create(weapon).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ForgeJob{}).
+			Where("id = ?", id).
+			Update("weapon_id", weapon.ID).Error
+	})
+}
+
+// Refund는 완료된 제작 작업을 환불 처리하고 시작할 때 차감한 비용(job.GoldCost)을 돌려주는 작업을 하나의 트랜잭션으로 처리합니다
+// Claim과 같은 조건부 갱신으로 수령과 환불이 겹쳐도 한 번만 처리되며, 나머지는 ErrForgeJobNotClaimable을 반환합니다
+func (r *ForgeJobRepository) Refund(job *models.ForgeJob, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ForgeJob{}).
+			Where("id = ? AND status = ? AND completes_at <= ?", job.ID, models.ForgeJobStatusCrafting, now).
+			Updates(map[string]interface{}{
+				"status":     models.ForgeJobStatusRefunded,
+				"claimed_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrForgeJobNotClaimable
+		}
+		return applyWalletEntries(tx, []models.WalletLedgerEntry{forgeRefundEntry(job)})
+	})
+}
+
+// forgeRefundEntry는 제작 작업 비용을 돌려주는 원장 항목입니다
+func forgeRefundEntry(job *models.ForgeJob) models.WalletLedgerEntry {
+	return models.WalletLedgerEntry{
+		PlayerID: job.PlayerID,
+		Currency: models.CurrencyGold,
+		Amount:   job.GoldCost,
+		Reason:   models.LedgerReasonForgeRefund,
+		RefType:  "forge_job",
+		RefID:    strconv.FormatUint(uint64(job.ID), 10),
+	}
+}
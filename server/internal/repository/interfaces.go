@@ -2,6 +2,8 @@ package repository
 
 import (
 	"game_eating_pizza/internal/models"
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -10,11 +12,19 @@ type PlayerRepositoryInterface interface {
 	Create(player *models.Player) error
 	FindByID(id uint) (*models.Player, error)
 	FindByUsername(username string) (*models.Player, error)
+	FindGuestByDeviceID(deviceID string) (*models.Player, error)
 	Update(player *models.Player) error
-	UpdateGold(id uint, gold int64) error
+	UpdateProfile(player *models.Player) error
+	UpdateCredentials(player *models.Player) error
+	UpdatePassword(id uint, hashedPassword string) error
+	UpdateCurrentWeapon(id uint, weaponID *uint) error
+	UpdateRole(id uint, role string) error
 	FindTopPlayersByLevel(limit int) ([]models.Player, error)
 	FindTopPlayersByGold(limit int) ([]models.Player, error)
 	ExistsByUsername(username string) (bool, error)
+	SetDeletionSchedule(id uint, scheduledAt *time.Time) error
+	FindIDsDueForPurge(scheduledBefore, softDeletedBefore time.Time, limit int) ([]uint, error)
+	Purge(id uint) error
 	Delete(id uint) error
 	Transaction(fn func(*gorm.DB) error) error
 }
@@ -24,10 +34,31 @@ type WeaponRepositoryInterface interface {
 	Create(weapon *models.Weapon) error
 	FindByID(id uint) (*models.Weapon, error)
 	FindByPlayerID(playerID uint) ([]models.Weapon, error)
+	CountByPlayerID(playerID uint) (int64, error)
 	Update(weapon *models.Weapon) error
+	Upgrade(weaponID, playerID uint, fromLevel, attackPowerGain int, cost int64) (*models.Weapon, error)   // 단계가 바뀌었으면 ErrWeaponLevelChanged, 골드 부족이면 *InsufficientFundsError
+	Promote(promotion WeaponPromotion) (*models.Weapon, error)                                             // 무기나 재료가 바뀌었으면 ErrWeaponPromotionConflict, 골드 부족이면 *InsufficientFundsError
+	Enchant(playerID uint, effect models.WeaponEffect, maxEffects int, cost int64) (*models.Weapon, error) // 슬롯이 가득 찼으면 ErrWeaponEffectSlotsFull, 불씨 부족이면 *InsufficientFundsError
+	SetLocked(weaponID, playerID uint, locked bool) (*models.Weapon, error)                                // 없거나 다른 플레이어의 무기면 ErrWeaponNotOwned
+	Dispose(playerID uint, weaponIDs []uint, rewards []models.WalletLedgerEntry) error                     // 잠겼거나 장착 중이거나 다른 플레이어의 무기가 있으면 ErrWeaponDisposalConflict
+	ExpandCapacity(playerID uint, fromBonus, step int, cost int64) error                                   // 보관 수가 바뀌었으면 ErrWeaponCapacityChanged, 보석 부족이면 *InsufficientFundsError
 	Delete(id uint) error
 }
 
+// WeaponPromotion은 무기 등급 승급 시도 한 번의 내용입니다
+// 성공/실패는 서비스가 미리 정하고, Repository는 시도 시점의 상태가 그대로일 때만 반영합니다
+type WeaponPromotion struct {
+	WeaponID     uint
+	PlayerID     uint
+	FromRarity   string
+	FromFailures int    // 시도 시점의 연속 실패 횟수
+	MaterialIDs  []uint // 소모할 같은 종류·등급 무기 (성공/실패와 관계없이 소모)
+	GoldCost     int64
+	Success      bool
+	ToRarity     string  // 성공 시 바뀔 등급
+	StatRatio    float64 // 성공 시 공격력에 곱할 배율 (새 등급 배율 / 현재 등급 배율)
+}
+
 // DungeonRepositoryInterface는 던전 데이터 접근 인터페이스입니다
 type DungeonRepositoryInterface interface {
 	Create(dungeon *models.Dungeon) error
@@ -37,3 +68,223 @@ type DungeonRepositoryInterface interface {
 	Update(dungeon *models.Dungeon) error
 	Delete(id uint) error
 }
+
+// RefreshTokenRepositoryInterface는 리프레시 토큰 데이터 접근 인터페이스입니다
+type RefreshTokenRepositoryInterface interface {
+	Create(token *models.RefreshToken) error
+	FindByHash(tokenHash string) (*models.RefreshToken, error)
+	Rotate(current *models.RefreshToken, next *models.RefreshToken) error
+	RevokeFamily(familyID string) error
+	RevokeAllByPlayerID(playerID uint) error
+	DeleteExpired(before time.Time) (int64, error)
+}
+
+// SessionRepositoryInterface는 기기별 세션 데이터 접근 인터페이스입니다
+type SessionRepositoryInterface interface {
+	Create(session *models.Session) error
+	FindByID(id uint) (*models.Session, error)
+	FindByRefreshFamilyID(familyID string) (*models.Session, error)
+	FindActiveByPlayerID(playerID uint) ([]models.Session, error)
+	FindAllByPlayerID(playerID uint) ([]models.Session, error)
+	Touch(id uint, lastSeenAt time.Time, ip string) error
+	Revoke(id uint) error
+	RevokeAllByPlayerID(playerID uint) error
+}
+
+// PasswordResetRepositoryInterface는 비밀번호 재설정 코드 데이터 접근 인터페이스입니다
+type PasswordResetRepositoryInterface interface {
+	Create(reset *models.PasswordReset) error
+	FindUsable(playerID uint, codeHash string, now time.Time) (*models.PasswordReset, error)
+	MarkUsed(id uint) error
+	InvalidateAllByPlayerID(playerID uint) error
+	DeleteExpired(before time.Time) (int64, error)
+}
+
+// ForgeStartLimits는 제작 작업을 시작할 때 플레이어 잠금 안에서 확인할 한도입니다
+type ForgeStartLimits struct {
+	MaxActive    int // 동시에 진행할 수 있는 제작 작업 수
+	BaseCapacity int // 무기 기본 보관 수 (플레이어의 보관 수 확장을 더함)
+	MaxCapacity  int // 무기 최대 보관 수
+}
+
+// WeaponCapacity는 보관 수 확장이 bonus인 플레이어의 무기 보관 가능 수입니다
+func (l ForgeStartLimits) WeaponCapacity(bonus int) int {
+	return min(l.BaseCapacity+bonus, l.MaxCapacity)
+}
+
+// ForgeJobRepositoryInterface는 대장간 제작 작업 데이터 접근 인터페이스입니다
+type ForgeJobRepositoryInterface interface {
+	Start(job *models.ForgeJob, limits ForgeStartLimits) error
+	FindByID(id uint) (*models.ForgeJob, error)
+	FindUnclaimedByPlayerID(playerID uint) ([]models.ForgeJob, error)
+	FindByPlayerID(playerID uint) ([]models.ForgeJob, error)
+	Claim(id uint, weapon *models.Weapon, now time.Time) error
+	Refund(job *models.ForgeJob, now time.Time) error // 수령할 수 없는 상태면 ErrForgeJobNotClaimable
+}
+
+// UserActivityRepositoryInterface는 일일 활동(걸음 수) 데이터 접근 인터페이스입니다
+type UserActivityRepositoryInterface interface {
+	FindByUserID(userID uint) ([]models.UserActivity, error)
+	FindByUserIDAndDate(userID uint, date string) (*models.UserActivity, error) // 기록이 없으면 nil, nil
+	FindByUserIDAt(userID uint, t time.Time) (*models.UserActivity, error)      // t를 구간에 포함하는 기록, 없으면 nil, nil
+	FindNeighbors(userID uint, t time.Time) (prev, next *models.UserActivity, err error)
+	FindRecentByUserID(userID uint, limit int) ([]models.UserActivity, error)
+	FindByUserIDBetween(userID uint, from, to string) ([]models.UserActivity, error) // from~to 날짜 포함
+	SumByUserIDBetween(userID uint, from, to string) (*ActivityTotals, error)
+	FindBestByUserID(userID uint) (bestSteps, bestCalories *models.UserActivity, err error) // 기록이 없으면 nil, nil, nil
+	FindDatesWithMinSteps(userID uint, minSteps int) ([]string, error)
+	AddSteps(userID uint, date string, delta StepDelta) (*models.UserActivity, error)
+}
+
+// ActivityTotals는 기간 내 일일 활동 기록의 집계 결과입니다
+type ActivityTotals struct {
+	ActiveDays    int64   // 기록이 있는 날짜 수
+	TotalSteps    int64   // 걸음 수 합계
+	TotalCalories float64 // 소모 칼로리 합계
+	MaxSteps      int     // 하루 최대 걸음 수
+	MaxCalories   float64 // 하루 최대 소모 칼로리
+}
+
+// StepGoalClaimRepositoryInterface는 걸음 수 목표 보상 수령 기록 데이터 접근 인터페이스입니다
+type StepGoalClaimRepositoryInterface interface {
+	FindByPlayerIDAndDates(playerID uint, dates []string) ([]models.StepGoalClaim, error)
+	FindByPlayerID(playerID uint) ([]models.StepGoalClaim, error)
+	Claim(playerID uint, claims []models.StepGoalClaim) ([]models.StepGoalClaim, error)
+}
+
+// StepDelta는 하루치 활동 기록에 더할 검증된 걸음 수 샘플 묶음입니다
+type StepDelta struct {
+	Steps      int
+	Calories   float64
+	FirstStart time.Time // 묶음에서 가장 이른 샘플 시작 시각 (이미 반영된 구간과 겹치는지 판정)
+	LastEnd    time.Time // 묶음에서 가장 늦은 샘플 종료 시각 (새 기준 시각으로 저장)
+	SyncedAt   time.Time
+	Timezone   string    // 기록이 없어 새로 만들 때 저장할 시간대
+	DayStart   time.Time // 기록이 담당할 구간 (기존 구간보다 넓으면 확장)
+	DayEnd     time.Time
+}
+
+// RejectedStepSampleFilter는 거부된 걸음 수 샘플 조회 조건입니다 (0 또는 빈 값인 조건은 무시)
+type RejectedStepSampleFilter struct {
+	PlayerID uint
+	Reason   string
+}
+
+// RejectedStepSampleRepositoryInterface는 거부된 걸음 수 샘플 데이터 접근 인터페이스입니다
+type RejectedStepSampleRepositoryInterface interface {
+	CreateBatch(samples []models.RejectedStepSample) error
+	FindByPlayerID(playerID uint) ([]models.RejectedStepSample, error)
+	Find(filter RejectedStepSampleFilter, limit, offset int) ([]models.RejectedStepSample, int64, error)
+}
+
+// WalletLedgerFilter는 재화 원장 조회 조건입니다 (0 또는 빈 값인 조건은 무시)
+type WalletLedgerFilter struct {
+	PlayerID uint
+	Currency string
+}
+
+// WalletRepositoryInterface는 플레이어 지갑(재화 잔액)과 재화 원장 데이터 접근 인터페이스입니다
+type WalletRepositoryInterface interface {
+	FindBalances(playerID uint) ([]models.WalletBalance, error)
+	FindLedger(filter WalletLedgerFilter, limit, offset int) ([]models.WalletLedgerEntry, int64, error)
+	FindLedgerByPlayerID(playerID uint) ([]models.WalletLedgerEntry, error)
+	Apply(entries []models.WalletLedgerEntry) ([]models.WalletLedgerEntry, error) // 잔액 부족이면 *InsufficientFundsError
+}
+
+// InventoryFilter는 인벤토리 조회 조건입니다 (0 또는 빈 값인 조건은 무시)
+type InventoryFilter struct {
+	PlayerID uint
+	ItemIDs  []string // 이 아이템들만 조회
+}
+
+// InventoryChange는 인벤토리 아이템 수량 변동 한 건입니다
+type InventoryChange struct {
+	PlayerID uint
+	ItemID   string
+	Amount   int64 // 양수면 획득, 음수면 소모
+	MaxStack int64 // 변경 후 수량의 상한 (0이면 제한 없음)
+
+	DiscardOverflow bool // true면 상한을 넘는 만큼은 버리고 채울 수 있는 만큼만 지급 (보상 지급용, 에러 없음)
+}
+
+// InventoryRepositoryInterface는 플레이어 인벤토리(재료·소모품 아이템) 데이터 접근 인터페이스입니다
+type InventoryRepositoryInterface interface {
+	Find(filter InventoryFilter, limit, offset int) ([]models.InventoryItem, int64, error)
+	FindByPlayerID(playerID uint) ([]models.InventoryItem, error)
+	Apply(changes []InventoryChange) ([]models.InventoryItem, error) // 수량 부족이면 *InsufficientItemsError, 상한 초과면 *ItemStackFullError
+}
+
+// ExperienceGain은 경험치 획득으로 바뀔 플레이어 레벨·경험치와 레벨업 보상입니다
+// 서비스가 FromLevel/FromExperience를 기준으로 계산한 결과이며, 그 사이 값이 바뀌었으면 반영하지 않습니다
+type ExperienceGain struct {
+	PlayerID       uint
+	FromLevel      int
+	FromExperience int64
+	Level          int
+	Experience     int64
+
+	Rewards  []models.WalletLedgerEntry // 레벨업 보상 재화
+	Items    []InventoryChange          // 레벨업 보상 아이템
+	Dungeons []models.DungeonUnlock     // 레벨업 보상으로 해금하는 던전 (이미 해금한 던전은 건너뜀)
+}
+
+// IdleProgress는 방치 사냥 정산으로 바뀔 플레이어 진행 상황입니다
+// 서비스가 FromSyncedAt(마지막 정산 시각)과 FromLevel/FromExperience를 기준으로 계산한 결과이며, 그 사이 값이 바뀌었으면 반영하지 않습니다
+type IdleProgress struct {
+	ExperienceGain // 처치 경험치로 바뀔 레벨·경험치와 레벨업 보상 (처치 골드도 Rewards에 포함)
+
+	FromSyncedAt *time.Time // 계산 기준 정산 시각 (처음 정산하면 nil)
+	SyncedAt     time.Time  // 새 정산 시각
+	Stage        int        // 정산 후 사냥할 스테이지
+	StageKills   int        // 정산 후 스테이지의 처치 수 (스테이지를 옮기면 0)
+	Kills        int        // 누적 처치 수에 더할 값
+}
+
+// ProgressionRepositoryInterface는 플레이어 레벨 진행(경험치, 레벨업 보상, 던전 해금, 방치 사냥) 데이터 접근 인터페이스입니다
+type ProgressionRepositoryInterface interface {
+	GainExperience(gain ExperienceGain) error      // 레벨·경험치가 바뀌었으면 ErrPlayerProgressChanged
+	ApplyIdleProgress(progress IdleProgress) error // 정산 시각·레벨·경험치가 바뀌었으면 ErrPlayerProgressChanged
+	FindDungeonUnlocksByPlayerID(playerID uint) ([]models.DungeonUnlock, error)
+}
+
+// PlayerUpgradeRepositoryInterface는 영구 강화 데이터 접근 인터페이스입니다
+type PlayerUpgradeRepositoryInterface interface {
+	FindByPlayerID(playerID uint) ([]models.PlayerUpgrade, error)
+	Purchase(playerID uint, nodeID string, level int, cost int64) (*models.PlayerUpgrade, error)
+}
+
+// RaidParticipantRepositoryInterface는 레이드 참여 기록 데이터 접근 인터페이스입니다
+type RaidParticipantRepositoryInterface interface {
+	FindByUserID(userID uint) ([]models.RaidParticipant, error)
+}
+
+// SuspensionFilter는 이용 정지 목록 조회 조건입니다 (0 또는 빈 값인 조건은 무시)
+type SuspensionFilter struct {
+	PlayerID   uint
+	ActiveOnly bool      // true면 현재 유효한(해제되지 않고 만료되지 않은) 정지만 조회
+	Now        time.Time // ActiveOnly 판단 기준 시각
+}
+
+// SuspensionRepositoryInterface는 이용 정지 데이터 접근 인터페이스입니다
+type SuspensionRepositoryInterface interface {
+	Create(suspension *models.Suspension) error
+	FindByID(id uint) (*models.Suspension, error)
+	FindActiveByPlayerID(playerID uint, now time.Time) (*models.Suspension, error)
+	FindByPlayerID(playerID uint) ([]models.Suspension, error)
+	Find(filter SuspensionFilter, limit, offset int) ([]models.Suspension, int64, error)
+	Lift(id, liftedByID uint, reason string, now time.Time) error
+}
+
+// AuditLogFilter는 감사 로그 조회 조건입니다 (0 또는 빈 값인 조건은 무시)
+type AuditLogFilter struct {
+	ActorID    uint
+	Action     string
+	TargetType string
+	TargetID   uint
+}
+
+// AuditLogRepositoryInterface는 감사 로그 데이터 접근 인터페이스입니다
+type AuditLogRepositoryInterface interface {
+	Create(log *models.AuditLog) error
+	Find(filter AuditLogFilter, limit, offset int) ([]models.AuditLog, int64, error)
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InsufficientItemsError는 소모 후 수량이 음수가 되는 아이템이 있을 때 반환됩니다
+type InsufficientItemsError struct {
+	ItemID string
+}
+
+func (e *InsufficientItemsError) Error() string {
+	return fmt.Sprintf("insufficient item %s", e.ItemID)
+}
+
+// ItemStackFullError는 획득 후 수량이 아이템의 최대 보유 수량을 넘을 때 반환됩니다
+type ItemStackFullError struct {
+	ItemID   string
+	MaxStack int64
+}
+
+func (e *ItemStackFullError) Error() string {
+	return fmt.Sprintf("item %s stack is full (max %d)", e.ItemID, e.MaxStack)
+}
+
+// InventoryRepository는 플레이어 인벤토리 데이터 접근을 담당합니다
+// InventoryRepositoryInterface를 구현합니다
+type InventoryRepository struct {
+	db *gorm.DB
+}
+
+// InventoryRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ InventoryRepositoryInterface = (*InventoryRepository)(nil)
+
+// NewInventoryRepository는 새로운 InventoryRepository 인스턴스를 생성합니다
+func NewInventoryRepository(db *gorm.DB) *InventoryRepository {
+	return &InventoryRepository{db: db}
+}
+
+// Find는 조건에 맞는 보유 아이템(수량 1 이상)을 아이템 ID 순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *InventoryRepository) Find(filter InventoryFilter, limit, offset int) ([]models.InventoryItem, int64, error) {
+	query := r.db.Model(&models.InventoryItem{}).Where("quantity > 0")
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+	if len(filter.ItemIDs) > 0 {
+		query = query.Where("item_id IN ?", filter.ItemIDs)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []models.InventoryItem
+	err := query.
+		Order("item_id").
+		Limit(limit).
+		Offset(offset).
+		Find(&items).Error
+	return items, total, err
+}
+
+// FindByPlayerID는 플레이어의 모든 인벤토리 행을 아이템 ID 순으로 조회합니다 (수량 0인 행 포함)
+func (r *InventoryRepository) FindByPlayerID(playerID uint) ([]models.InventoryItem, error) {
+	var items []models.InventoryItem
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("item_id").
+		Find(&items).Error
+	return items, err
+}
+
+// Apply는 아이템 수량 변동을 하나의 트랜잭션으로 반영하고 변경 후 인벤토리 행을 반환합니다
+// 하나라도 수량이 부족하거나 상한을 넘으면 아무것도 반영하지 않고 *InsufficientItemsError 또는 *ItemStackFullError를 반환합니다
+func (r *InventoryRepository) Apply(changes []InventoryChange) ([]models.InventoryItem, error) {
+	var items []models.InventoryItem
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		items, err = applyInventoryChanges(tx, changes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// applyInventoryChanges는 트랜잭션 tx 안에서 아이템 수량 변동을 반영합니다
+// 다른 Repository가 자신의 트랜잭션 안에서 아이템을 지급/소모할 때도 이 함수를 사용합니다
+// 변동량이 0이 아닌 변경마다 변경 후 인벤토리 행을 순서대로 반환합니다
+func applyInventoryChanges(tx *gorm.DB, changes []InventoryChange) ([]models.InventoryItem, error) {
+	items := make([]models.InventoryItem, 0, len(changes))
+	for _, change := range changes {
+		if change.Amount == 0 {
+			continue
+		}
+
+		// 행이 없으면 수량 0으로 먼저 만들어 두고, 조건부 증감으로 행을 잠가 동시 변경을 순서대로 처리합니다
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.InventoryItem{
+			PlayerID: change.PlayerID,
+			ItemID:   change.ItemID,
+		}).Error; err != nil {
+			return nil, err
+		}
+		query := tx.Model(&models.InventoryItem{}).
+			Where("player_id = ? AND item_id = ? AND quantity + ? >= 0", change.PlayerID, change.ItemID, change.Amount)
+		quantity := gorm.Expr("quantity + ?", change.Amount)
+		discard := change.DiscardOverflow && change.MaxStack > 0 && change.Amount > 0
+		switch {
+		case discard:
+			quantity = gorm.Expr("CASE WHEN quantity + ? > ? THEN ? ELSE quantity + ? END",
+				change.Amount, change.MaxStack, change.MaxStack, change.Amount)
+		case change.MaxStack > 0 && change.Amount > 0:
+			query = query.Where("quantity + ? <= ?", change.Amount, change.MaxStack)
+		}
+		result := query.Update("quantity", quantity)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		// 이미 가득 찬 아이템을 버리는 경우 값이 바뀌지 않아 변경된 행이 0일 수 있으므로 확인하지 않습니다
+		if result.RowsAffected == 0 && !discard {
+			if change.Amount < 0 {
+				return nil, &InsufficientItemsError{ItemID: change.ItemID}
+			}
+			return nil, &ItemStackFullError{ItemID: change.ItemID, MaxStack: change.MaxStack}
+		}
+
+		var item models.InventoryItem
+		if err := tx.
+			Where("player_id = ? AND item_id = ?", change.PlayerID, change.ItemID).
+			First(&item).Error; err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
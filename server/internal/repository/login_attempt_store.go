@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"sync"
+	"time"
+)
+
+// memoryStoreSweepThreshold는 만료된 항목 정리를 시작하는 최소 항목 수입니다
+const memoryStoreSweepThreshold = 10000
+
+// LoginAttempt는 특정 키(사용자명 또는 IP)의 로그인 실패 기록입니다
+type LoginAttempt struct {
+	Failures      int       // 연속 실패 횟수
+	LastFailureAt time.Time // 마지막 실패 시간
+	LockedUntil   time.Time // 잠금 해제 시각 (zero value면 잠금 아님)
+}
+
+// LoginAttemptStoreInterface는 로그인 실패 기록 저장소 인터페이스입니다
+// 단일 노드에서는 메모리 구현을 사용하고, 다중 노드 환경에서는 Redis 구현으로 교체할 수 있습니다
+type LoginAttemptStoreInterface interface {
+	Get(key string) (LoginAttempt, error)
+	IncrementFailure(key string, now time.Time, ttl time.Duration) (LoginAttempt, error)
+	Lock(key string, until time.Time, ttl time.Duration) error
+	Reset(key string) error
+}
+
+// MemoryLoginAttemptStore는 프로세스 메모리에 로그인 실패 기록을 저장합니다
+// 서버 재시작 시 기록이 초기화되며, 여러 서버 인스턴스 간에 공유되지 않습니다
+type MemoryLoginAttemptStore struct {
+	entries map[string]*memoryLoginAttempt
+	mu      sync.Mutex
+}
+
+// memoryLoginAttempt는 만료 시각을 포함한 메모리 저장 항목입니다
+type memoryLoginAttempt struct {
+	attempt   LoginAttempt
+	expiresAt time.Time
+}
+
+// MemoryLoginAttemptStore가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ LoginAttemptStoreInterface = (*MemoryLoginAttemptStore)(nil)
+
+// NewMemoryLoginAttemptStore는 새로운 MemoryLoginAttemptStore 인스턴스를 생성합니다
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		entries: make(map[string]*memoryLoginAttempt),
+	}
+}
+
+// Get은 키의 실패 기록을 조회합니다 (기록이 없거나 만료되었으면 zero value)
+func (s *MemoryLoginAttemptStore) Get(key string) (LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.lookup(key, time.Now())
+	if entry == nil {
+		return LoginAttempt{}, nil
+	}
+	return entry.attempt, nil
+}
+
+// IncrementFailure는 실패 횟수를 1 증가시키고 갱신된 기록을 반환합니다
+func (s *MemoryLoginAttemptStore) IncrementFailure(key string, now time.Time, ttl time.Duration) (LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) >= memoryStoreSweepThreshold {
+		s.sweep(now)
+	}
+
+	entry := s.lookup(key, now)
+	if entry == nil {
+		entry = &memoryLoginAttempt{}
+		s.entries[key] = entry
+	}
+
+	entry.attempt.Failures++
+	entry.attempt.LastFailureAt = now
+	entry.expiresAt = laterOf(entry.expiresAt, now.Add(ttl))
+	return entry.attempt, nil
+}
+
+// Lock은 키를 until 시각까지 잠급니다
+func (s *MemoryLoginAttemptStore) Lock(key string, until time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	entry := s.lookup(key, now)
+	if entry == nil {
+		entry = &memoryLoginAttempt{}
+		s.entries[key] = entry
+	}
+
+	entry.attempt.LockedUntil = until
+	entry.expiresAt = laterOf(entry.expiresAt, now.Add(ttl))
+	return nil
+}
+
+// Reset은 키의 실패 기록을 삭제합니다
+func (s *MemoryLoginAttemptStore) Reset(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// lookup은 만료되지 않은 항목을 반환하고, 만료된 항목은 삭제합니다 (잠금을 잡은 상태에서 호출)
+func (s *MemoryLoginAttemptStore) lookup(key string, now time.Time) *memoryLoginAttempt {
+	entry, exists := s.entries[key]
+	if !exists {
+		return nil
+	}
+	if !now.Before(entry.expiresAt) {
+		delete(s.entries, key)
+		return nil
+	}
+	return entry
+}
+
+// sweep은 만료된 항목을 모두 정리합니다 (잠금을 잡은 상태에서 호출)
+func (s *MemoryLoginAttemptStore) sweep(now time.Time) {
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// laterOf는 두 시각 중 늦은 시각을 반환합니다
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockAuditLogRepository는 감사 로그 데이터 접근을 위한 Mock 구현체입니다
+type MockAuditLogRepository struct {
+	logs   map[uint]*models.AuditLog
+	mu     sync.RWMutex
+	nextID uint
+}
+
+// NewMockAuditLogRepository는 새로운 MockAuditLogRepository 인스턴스를 생성합니다
+func NewMockAuditLogRepository() *MockAuditLogRepository {
+	return &MockAuditLogRepository{
+		logs:   make(map[uint]*models.AuditLog),
+		nextID: 1,
+	}
+}
+
+// Create는 새로운 감사 로그를 저장합니다
+func (r *MockAuditLogRepository) Create(log *models.AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	log.ID = r.nextID
+	r.nextID++
+	if log.CreatedAt.IsZero() {
+		log.CreatedAt = time.Now()
+	}
+	r.logs[log.ID] = log
+	return nil
+}
+
+// Find는 조건에 맞는 감사 로그를 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *MockAuditLogRepository) Find(filter AuditLogFilter, limit, offset int) ([]models.AuditLog, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	logs := make([]models.AuditLog, 0)
+	for _, log := range r.logs {
+		if filter.ActorID != 0 && log.ActorID != filter.ActorID {
+			continue
+		}
+		if filter.Action != "" && log.Action != filter.Action {
+			continue
+		}
+		if filter.TargetType != "" && log.TargetType != filter.TargetType {
+			continue
+		}
+		if filter.TargetID != 0 && log.TargetID != filter.TargetID {
+			continue
+		}
+		logs = append(logs, *log)
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].ID > logs[j].ID
+	})
+
+	total := int64(len(logs))
+	if offset >= len(logs) {
+		return []models.AuditLog{}, total, nil
+	}
+	logs = logs[offset:]
+	if limit < len(logs) {
+		logs = logs[:limit]
+	}
+	return logs, total, nil
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MockForgeJobRepository는 대장간 제작 작업 데이터 접근을 위한 Mock 구현체입니다
+// 골드 차감과 무기 생성은 함께 전달받은 지갑/무기 Repository에 위임하고, 무기 보관 수 확장은 플레이어 Repository에서 읽습니다
+type MockForgeJobRepository struct {
+	jobs       map[uint]*models.ForgeJob
+	walletRepo WalletRepositoryInterface
+	weaponRepo WeaponRepositoryInterface
+	playerRepo PlayerRepositoryInterface
+	mu         sync.RWMutex
+	nextID     uint
+}
+
+// NewMockForgeJobRepository는 새로운 MockForgeJobRepository 인스턴스를 생성합니다
+func NewMockForgeJobRepository(walletRepo WalletRepositoryInterface, weaponRepo WeaponRepositoryInterface, playerRepo PlayerRepositoryInterface) *MockForgeJobRepository {
+	return &MockForgeJobRepository{
+		jobs:       make(map[uint]*models.ForgeJob),
+		walletRepo: walletRepo,
+		weaponRepo: weaponRepo,
+		playerRepo: playerRepo,
+		nextID:     1,
+	}
+}
+
+// Start는 제작 비용을 차감하고 제작 작업을 저장합니다
+func (r *MockForgeJobRepository) Start(job *models.ForgeJob, limits ForgeStartLimits) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var active int
+	for _, existing := range r.jobs {
+		if existing.PlayerID == job.PlayerID && existing.Status == models.ForgeJobStatusCrafting {
+			active++
+		}
+	}
+	if active >= limits.MaxActive {
+		return ErrForgeSlotsFull
+	}
+
+	player, err := r.playerRepo.FindByID(job.PlayerID)
+	if err != nil {
+		return err
+	}
+	weapons, err := r.weaponRepo.CountByPlayerID(job.PlayerID)
+	if err != nil {
+		return err
+	}
+	if int(weapons)+active >= limits.WeaponCapacity(player.WeaponCapacityBonus) {
+		return ErrForgeInventoryFull
+	}
+
+	job.ID = r.nextID
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: job.PlayerID,
+		Currency: models.CurrencyGold,
+		Amount:   -job.GoldCost,
+		Reason:   models.LedgerReasonForgeCraft,
+		RefType:  "forge_job",
+		RefID:    strconv.FormatUint(uint64(job.ID), 10),
+	}}); err != nil {
+		return err
+	}
+	r.nextID++
+	now := time.Now()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	stored := *job
+	r.jobs[job.ID] = &stored
+	return nil
+}
+
+// FindByID는 ID로 제작 작업을 조회합니다
+func (r *MockForgeJobRepository) FindByID(id uint) (*models.ForgeJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, exists := r.jobs[id]
+	if !exists {
+		return nil, errors.New("forge job not found")
+	}
+
+	result := *job
+	return &result, nil
+}
+
+// FindUnclaimedByPlayerID는 플레이어가 아직 수령하지 않은 제작 작업을 완료 시각 순으로 조회합니다
+func (r *MockForgeJobRepository) FindUnclaimedByPlayerID(playerID uint) ([]models.ForgeJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	jobs := make([]models.ForgeJob, 0)
+	for _, job := range r.jobs {
+		if job.PlayerID == playerID && job.Status == models.ForgeJobStatusCrafting {
+			jobs = append(jobs, *job)
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].CompletesAt.Equal(jobs[j].CompletesAt) {
+			return jobs[i].ID < jobs[j].ID
+		}
+		return jobs[i].CompletesAt.Before(jobs[j].CompletesAt)
+	})
+	return jobs, nil
+}
+
+// FindByPlayerID는 플레이어의 모든 제작 작업(수령·환불한 작업 포함)을 시작 순으로 조회합니다
+func (r *MockForgeJobRepository) FindByPlayerID(playerID uint) ([]models.ForgeJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	jobs := make([]models.ForgeJob, 0)
+	for _, job := range r.jobs {
+		if job.PlayerID == playerID {
+			jobs = append(jobs, *job)
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID < jobs[j].ID
+	})
+	return jobs, nil
+}
+
+// Claim은 완료된 제작 작업을 수령 처리하고 결과 무기를 생성합니다
+func (r *MockForgeJobRepository) Claim(id uint, weapon *models.Weapon, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, exists := r.jobs[id]
+	if !exists {
+		return errors.New("forge job not found")
+	}
+	if job.StatusAt(now) != models.ForgeJobStatusReady {
+		return ErrForgeJobNotClaimable
+	}
+
+	if err := r.weaponRepo.Create(weapon); err != nil {
+		return err
+	}
+
+	job.Status = models.ForgeJobStatusClaimed
+	job.ClaimedAt = &now
+	job.WeaponID = &weapon.ID
+	job.UpdatedAt = now
+	return nil
+}
+
+// Refund는 완료된 제작 작업을 환불 처리하고 비용을 돌려줍니다
+func (r *MockForgeJobRepository) Refund(job *models.ForgeJob, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.jobs[job.ID]
+	if !exists {
+		return errors.New("forge job not found")
+	}
+	if stored.StatusAt(now) != models.ForgeJobStatusReady {
+		return ErrForgeJobNotClaimable
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{forgeRefundEntry(stored)}); err != nil {
+		return err
+	}
+
+	stored.Status = models.ForgeJobStatusRefunded
+	stored.ClaimedAt = &now
+	stored.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockInventoryRepository는 플레이어 인벤토리 데이터 접근을 위한 Mock 구현체입니다
+type MockInventoryRepository struct {
+	items  map[uint]*models.InventoryItem
+	mu     sync.RWMutex
+	nextID uint
+}
+
+// NewMockInventoryRepository는 새로운 MockInventoryRepository 인스턴스를 생성합니다
+func NewMockInventoryRepository() *MockInventoryRepository {
+	repo := &MockInventoryRepository{
+		items:  make(map[uint]*models.InventoryItem),
+		nextID: 1,
+	}
+
+	// 테스트용 초기 데이터
+	repo.initTestData()
+
+	return repo
+}
+
+// initTestData는 테스트용 초기 데이터를 생성합니다
+func (r *MockInventoryRepository) initTestData() {
+	now := time.Now()
+	r.items[1] = &models.InventoryItem{
+		ID:        1,
+		PlayerID:  1,
+		ItemID:    "iron_ore",
+		Quantity:  20,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	r.nextID = 2
+}
+
+// findLocked는 플레이어·아이템의 인벤토리 행을 찾습니다 (호출 측이 잠금을 잡고 있어야 함)
+func (r *MockInventoryRepository) findLocked(playerID uint, itemID string) *models.InventoryItem {
+	for _, item := range r.items {
+		if item.PlayerID == playerID && item.ItemID == itemID {
+			return item
+		}
+	}
+	return nil
+}
+
+// Find는 조건에 맞는 보유 아이템(수량 1 이상)을 아이템 ID 순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *MockInventoryRepository) Find(filter InventoryFilter, limit, offset int) ([]models.InventoryItem, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var itemIDs map[string]bool
+	if len(filter.ItemIDs) > 0 {
+		itemIDs = make(map[string]bool, len(filter.ItemIDs))
+		for _, id := range filter.ItemIDs {
+			itemIDs[id] = true
+		}
+	}
+
+	items := make([]models.InventoryItem, 0)
+	for _, item := range r.items {
+		if item.Quantity <= 0 {
+			continue
+		}
+		if filter.PlayerID != 0 && item.PlayerID != filter.PlayerID {
+			continue
+		}
+		if itemIDs != nil && !itemIDs[item.ItemID] {
+			continue
+		}
+		items = append(items, *item)
+	}
+	sortInventoryItems(items)
+
+	total := int64(len(items))
+	if offset >= len(items) {
+		return []models.InventoryItem{}, total, nil
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items, total, nil
+}
+
+// FindByPlayerID는 플레이어의 모든 인벤토리 행을 아이템 ID 순으로 조회합니다 (수량 0인 행 포함)
+func (r *MockInventoryRepository) FindByPlayerID(playerID uint) ([]models.InventoryItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]models.InventoryItem, 0)
+	for _, item := range r.items {
+		if item.PlayerID == playerID {
+			items = append(items, *item)
+		}
+	}
+	sortInventoryItems(items)
+	return items, nil
+}
+
+// Apply는 아이템 수량 변동을 반영하고 변경 후 인벤토리 행을 반환합니다
+// 하나라도 수량이 부족하거나 상한을 넘으면 아무것도 반영하지 않고 *InsufficientItemsError 또는 *ItemStackFullError를 반환합니다
+func (r *MockInventoryRepository) Apply(changes []InventoryChange) ([]models.InventoryItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 변경 후 수량을 먼저 계산해 모두 유효할 때만 반영합니다
+	type inventoryKey struct {
+		playerID uint
+		itemID   string
+	}
+	quantities := make(map[inventoryKey]int64)
+	for _, change := range changes {
+		if change.Amount == 0 {
+			continue
+		}
+		key := inventoryKey{change.PlayerID, change.ItemID}
+		quantity, ok := quantities[key]
+		if !ok {
+			if item := r.findLocked(change.PlayerID, change.ItemID); item != nil {
+				quantity = item.Quantity
+			}
+		}
+		quantity = addInventoryQuantity(quantity, change)
+		if quantity < 0 {
+			return nil, &InsufficientItemsError{ItemID: change.ItemID}
+		}
+		if change.MaxStack > 0 && change.Amount > 0 && quantity > change.MaxStack {
+			return nil, &ItemStackFullError{ItemID: change.ItemID, MaxStack: change.MaxStack}
+		}
+		quantities[key] = quantity
+	}
+
+	now := time.Now()
+	items := make([]models.InventoryItem, 0, len(changes))
+	for _, change := range changes {
+		if change.Amount == 0 {
+			continue
+		}
+		item := r.findLocked(change.PlayerID, change.ItemID)
+		if item == nil {
+			item = &models.InventoryItem{
+				ID:        r.nextID,
+				PlayerID:  change.PlayerID,
+				ItemID:    change.ItemID,
+				CreatedAt: now,
+			}
+			r.items[item.ID] = item
+			r.nextID++
+		}
+		item.Quantity = addInventoryQuantity(item.Quantity, change)
+		item.UpdatedAt = now
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+// addInventoryQuantity는 수량에 변동을 더합니다 (DiscardOverflow면 상한을 넘는 만큼은 버림)
+func addInventoryQuantity(quantity int64, change InventoryChange) int64 {
+	quantity += change.Amount
+	if change.DiscardOverflow && change.MaxStack > 0 && change.Amount > 0 && quantity > change.MaxStack {
+		quantity = change.MaxStack
+	}
+	return quantity
+}
+
+// sortInventoryItems는 인벤토리 행을 아이템 ID 순으로 정렬합니다
+func sortInventoryItems(items []models.InventoryItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].ItemID != items[j].ItemID {
+			return items[i].ItemID < items[j].ItemID
+		}
+		return items[i].PlayerID < items[j].PlayerID
+	})
+}
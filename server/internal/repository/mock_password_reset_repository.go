@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"sync"
+	"time"
+)
+
+// MockPasswordResetRepository는 비밀번호 재설정 코드 데이터 접근을 위한 Mock 구현체입니다
+type MockPasswordResetRepository struct {
+	resets map[uint]*models.PasswordReset
+	mu     sync.RWMutex
+	nextID uint
+}
+
+// NewMockPasswordResetRepository는 새로운 MockPasswordResetRepository 인스턴스를 생성합니다
+func NewMockPasswordResetRepository() *MockPasswordResetRepository {
+	return &MockPasswordResetRepository{
+		resets: make(map[uint]*models.PasswordReset),
+		nextID: 1,
+	}
+}
+
+// Create는 새로운 재설정 코드를 저장합니다
+func (r *MockPasswordResetRepository) Create(reset *models.PasswordReset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reset.ID = r.nextID
+	r.nextID++
+	r.resets[reset.ID] = reset
+	return nil
+}
+
+// FindUsable은 플레이어의 사용 가능한 재설정 코드를 조회합니다
+func (r *MockPasswordResetRepository) FindUsable(playerID uint, codeHash string, now time.Time) (*models.PasswordReset, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, reset := range r.resets {
+		if reset.PlayerID == playerID && reset.CodeHash == codeHash && reset.IsUsable(now) {
+			result := *reset
+			return &result, nil
+		}
+	}
+
+	return nil, errors.New("password reset not found")
+}
+
+// MarkUsed는 재설정 코드를 사용 처리합니다
+func (r *MockPasswordResetRepository) MarkUsed(id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reset, exists := r.resets[id]
+	if !exists {
+		return errors.New("password reset not found")
+	}
+	if reset.UsedAt != nil {
+		return ErrPasswordResetAlreadyUsed
+	}
+
+	now := time.Now()
+	reset.UsedAt = &now
+	return nil
+}
+
+// InvalidateAllByPlayerID는 플레이어의 미사용 재설정 코드를 모두 무효화합니다
+func (r *MockPasswordResetRepository) InvalidateAllByPlayerID(playerID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, reset := range r.resets {
+		if reset.PlayerID == playerID && reset.UsedAt == nil {
+			reset.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+// DeleteExpired는 만료 시각이 before 이전인 코드를 삭제합니다
+func (r *MockPasswordResetRepository) DeleteExpired(before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, reset := range r.resets {
+		if reset.ExpiresAt.Before(before) {
+			delete(r.resets, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -5,6 +5,7 @@ import (
 	"game_eating_pizza/internal/models"
 	"gorm.io/gorm"
 	"sync"
+	"time"
 )
 
 // MockPlayerRepository는 플레이어 데이터 접근을 위한 Mock 구현체입니다
@@ -33,8 +34,13 @@ func (r *MockPlayerRepository) initTestData() {
 		ID:         1,
 		Username:   "testuser",
 		Level:      5,
-		Gold:       1000,
 		Experience: 500,
+		Wallet: []models.WalletBalance{
+			{PlayerID: 1, Currency: models.CurrencyGold, Balance: 1000},
+		},
+
+		Locale:        models.LocaleKorean,
+		Notifications: models.DefaultNotificationPreferences(),
 	}
 	r.players[1] = testPlayer
 	r.nextID = 2
@@ -89,6 +95,21 @@ func (r *MockPlayerRepository) FindByUsername(username string) (*models.Player,
 	return nil, errors.New("player not found")
 }
 
+// FindGuestByDeviceID는 기기 식별자에 묶인 게스트 플레이어를 조회합니다
+func (r *MockPlayerRepository) FindGuestByDeviceID(deviceID string) (*models.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, player := range r.players {
+		if player.IsGuest && player.DeviceID != nil && *player.DeviceID == deviceID {
+			result := *player
+			return &result, nil
+		}
+	}
+
+	return nil, errors.New("player not found")
+}
+
 // Update는 플레이어 정보를 업데이트합니다
 func (r *MockPlayerRepository) Update(player *models.Player) error {
 	r.mu.Lock()
@@ -102,8 +123,43 @@ func (r *MockPlayerRepository) Update(player *models.Player) error {
 	return nil
 }
 
-// UpdateGold는 플레이어의 골드를 업데이트합니다
-func (r *MockPlayerRepository) UpdateGold(id uint, gold int64) error {
+// UpdateProfile은 프로필 필드만 업데이트합니다
+func (r *MockPlayerRepository) UpdateProfile(player *models.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.players[player.ID]
+	if !exists {
+		return errors.New("player not found")
+	}
+
+	existing.DisplayName = player.DisplayName
+	existing.AvatarID = player.AvatarID
+	existing.Locale = player.Locale
+	existing.Timezone = player.Timezone
+	existing.Notifications = player.Notifications
+	return nil
+}
+
+// UpdateCredentials는 아이디, 비밀번호, 게스트 여부와 기기 바인딩만 업데이트합니다
+func (r *MockPlayerRepository) UpdateCredentials(player *models.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.players[player.ID]
+	if !exists {
+		return errors.New("player not found")
+	}
+
+	existing.Username = player.Username
+	existing.Password = player.Password
+	existing.IsGuest = player.IsGuest
+	existing.DeviceID = player.DeviceID
+	return nil
+}
+
+// UpdatePassword는 비밀번호 해시만 변경합니다
+func (r *MockPlayerRepository) UpdatePassword(id uint, hashedPassword string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -112,7 +168,35 @@ func (r *MockPlayerRepository) UpdateGold(id uint, gold int64) error {
 		return errors.New("player not found")
 	}
 
-	player.Gold = gold
+	player.Password = hashedPassword
+	return nil
+}
+
+// UpdateCurrentWeapon은 장착 무기만 변경합니다
+func (r *MockPlayerRepository) UpdateCurrentWeapon(id uint, weaponID *uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, exists := r.players[id]
+	if !exists {
+		return errors.New("player not found")
+	}
+
+	player.CurrentWeaponID = weaponID
+	return nil
+}
+
+// UpdateRole은 플레이어의 역할을 변경합니다
+func (r *MockPlayerRepository) UpdateRole(id uint, role string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, exists := r.players[id]
+	if !exists {
+		return errors.New("player not found")
+	}
+
+	player.Role = role
 	return nil
 }
 
@@ -123,6 +207,9 @@ func (r *MockPlayerRepository) FindTopPlayersByLevel(limit int) ([]models.Player
 
 	players := make([]models.Player, 0, len(r.players))
 	for _, p := range r.players {
+		if p.IsDeletionPending() {
+			continue
+		}
 		players = append(players, *p)
 	}
 
@@ -150,13 +237,16 @@ func (r *MockPlayerRepository) FindTopPlayersByGold(limit int) ([]models.Player,
 
 	players := make([]models.Player, 0, len(r.players))
 	for _, p := range r.players {
+		if p.IsDeletionPending() {
+			continue
+		}
 		players = append(players, *p)
 	}
 
 	// 골드로 정렬
 	for i := 0; i < len(players)-1; i++ {
 		for j := i + 1; j < len(players); j++ {
-			if players[i].Gold < players[j].Gold {
+			if players[i].Balance(models.CurrencyGold) < players[j].Balance(models.CurrencyGold) {
 				players[i], players[j] = players[j], players[i]
 			}
 		}
@@ -183,6 +273,48 @@ func (r *MockPlayerRepository) ExistsByUsername(username string) (bool, error) {
 	return false, nil
 }
 
+// SetDeletionSchedule은 계정 삭제 예정 시각을 설정합니다 (nil이면 삭제 취소)
+func (r *MockPlayerRepository) SetDeletionSchedule(id uint, scheduledAt *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, exists := r.players[id]
+	if !exists {
+		return errors.New("player not found")
+	}
+
+	player.DeletionScheduledAt = scheduledAt
+	return nil
+}
+
+// FindIDsDueForPurge는 삭제 예정 시각이 지난 플레이어 ID를 조회합니다
+// Mock의 Delete는 즉시 삭제하므로 소프트 삭제 기준(softDeletedBefore)은 사용하지 않습니다
+func (r *MockPlayerRepository) FindIDsDueForPurge(scheduledBefore, softDeletedBefore time.Time, limit int) ([]uint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]uint, 0)
+	for id, player := range r.players {
+		if len(ids) >= limit {
+			break
+		}
+		if player.DeletionScheduledAt != nil && !player.DeletionScheduledAt.After(scheduledBefore) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Purge는 플레이어를 완전 삭제합니다
+// Mock Repository들은 서로 독립적이므로 다른 Mock의 관련 데이터는 삭제하지 않습니다
+func (r *MockPlayerRepository) Purge(id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.players, id)
+	return nil
+}
+
 // Delete는 플레이어를 삭제합니다
 func (r *MockPlayerRepository) Delete(id uint) error {
 	r.mu.Lock()
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockPlayerUpgradeRepository는 영구 강화 데이터 접근을 위한 Mock 구현체입니다
+// 활력의 불씨 차감은 함께 전달받은 지갑 Repository에 위임합니다
+type MockPlayerUpgradeRepository struct {
+	upgrades   map[uint]*models.PlayerUpgrade
+	walletRepo WalletRepositoryInterface
+	mu         sync.RWMutex
+	nextID     uint
+}
+
+// NewMockPlayerUpgradeRepository는 새로운 MockPlayerUpgradeRepository 인스턴스를 생성합니다
+func NewMockPlayerUpgradeRepository(walletRepo WalletRepositoryInterface) *MockPlayerUpgradeRepository {
+	return &MockPlayerUpgradeRepository{
+		upgrades:   make(map[uint]*models.PlayerUpgrade),
+		walletRepo: walletRepo,
+		nextID:     1,
+	}
+}
+
+// FindByPlayerID는 플레이어가 구매한 영구 강화 목록을 조회합니다
+func (r *MockPlayerUpgradeRepository) FindByPlayerID(playerID uint) ([]models.PlayerUpgrade, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	upgrades := make([]models.PlayerUpgrade, 0)
+	for _, upgrade := range r.upgrades {
+		if upgrade.PlayerID == playerID {
+			upgrades = append(upgrades, *upgrade)
+		}
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		return upgrades[i].NodeID < upgrades[j].NodeID
+	})
+	return upgrades, nil
+}
+
+// Purchase는 강화 노드를 level 단계로 올리고 활력의 불씨 cost를 차감합니다
+func (r *MockPlayerUpgradeRepository) Purchase(playerID uint, nodeID string, level int, cost int64) (*models.PlayerUpgrade, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var existing *models.PlayerUpgrade
+	for _, upgrade := range r.upgrades {
+		if upgrade.PlayerID == playerID && upgrade.NodeID == nodeID {
+			existing = upgrade
+			break
+		}
+	}
+	current := 0
+	if existing != nil {
+		current = existing.Level
+	}
+	if current != level-1 {
+		return nil, ErrUpgradeLevelChanged
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: playerID,
+		Currency: models.CurrencyEmbers,
+		Amount:   -cost,
+		Reason:   models.LedgerReasonUpgradePurchase,
+		RefType:  "upgrade",
+		RefID:    fmt.Sprintf("%s:%d", nodeID, level),
+	}}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if existing == nil {
+		existing = &models.PlayerUpgrade{
+			ID:        r.nextID,
+			PlayerID:  playerID,
+			NodeID:    nodeID,
+			CreatedAt: now,
+		}
+		r.nextID++
+		r.upgrades[existing.ID] = existing
+	}
+	existing.Level = level
+	existing.UpdatedAt = now
+
+	result := *existing
+	return &result, nil
+}
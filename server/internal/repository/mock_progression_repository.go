@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockProgressionRepository는 플레이어 레벨 진행 데이터 접근을 위한 Mock 구현체입니다
+// 레벨·경험치 변경, 재화·아이템 지급은 함께 전달받은 Repository에 위임합니다
+type MockProgressionRepository struct {
+	unlocks       map[uint]*models.DungeonUnlock
+	playerRepo    PlayerRepositoryInterface
+	walletRepo    WalletRepositoryInterface
+	inventoryRepo InventoryRepositoryInterface
+	mu            sync.RWMutex
+	nextID        uint
+}
+
+// NewMockProgressionRepository는 새로운 MockProgressionRepository 인스턴스를 생성합니다
+func NewMockProgressionRepository(playerRepo PlayerRepositoryInterface, walletRepo WalletRepositoryInterface, inventoryRepo InventoryRepositoryInterface) *MockProgressionRepository {
+	return &MockProgressionRepository{
+		unlocks:       make(map[uint]*models.DungeonUnlock),
+		playerRepo:    playerRepo,
+		walletRepo:    walletRepo,
+		inventoryRepo: inventoryRepo,
+		nextID:        1,
+	}
+}
+
+// GainExperience는 플레이어 레벨·경험치를 바꾸고 레벨업 보상을 지급합니다
+func (r *MockProgressionRepository) GainExperience(gain ExperienceGain) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, err := r.playerRepo.FindByID(gain.PlayerID)
+	if err != nil || player.Level != gain.FromLevel || player.Experience != gain.FromExperience {
+		return ErrPlayerProgressChanged
+	}
+	return r.applyLocked(gain, func(player *models.Player) {
+		player.Level = gain.Level
+		player.Experience = gain.Experience
+	})
+}
+
+// ApplyIdleProgress는 방치 사냥 정산을 반영합니다
+func (r *MockProgressionRepository) ApplyIdleProgress(progress IdleProgress) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, err := r.playerRepo.FindByID(progress.PlayerID)
+	if err != nil || player.Level != progress.FromLevel || player.Experience != progress.FromExperience ||
+		!sameTime(player.IdleSyncedAt, progress.FromSyncedAt) {
+		return ErrPlayerProgressChanged
+	}
+	return r.applyLocked(progress.ExperienceGain, func(player *models.Player) {
+		player.Level = progress.Level
+		player.Experience = progress.Experience
+		player.Stage = progress.Stage
+		player.StageKills = progress.StageKills
+		syncedAt := progress.SyncedAt
+		player.IdleSyncedAt = &syncedAt
+		player.TotalKills += progress.Kills
+	})
+}
+
+// sameTime은 두 시각이 모두 nil이거나 같은 시각인지 확인합니다
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// applyLocked는 보상을 지급하고 update로 플레이어 진행 상황을 바꾼 뒤 던전을 해금합니다 (호출 측이 잠금을 잡고 있어야 함)
+func (r *MockProgressionRepository) applyLocked(gain ExperienceGain, update func(player *models.Player)) error {
+	if _, err := r.walletRepo.Apply(gain.Rewards); err != nil {
+		return err
+	}
+	if _, err := r.inventoryRepo.Apply(gain.Items); err != nil {
+		return err
+	}
+
+	// 지갑 Mock이 플레이어의 잔액을 바꾸므로 다시 조회한 뒤 진행 상황만 바꿉니다
+	player, err := r.playerRepo.FindByID(gain.PlayerID)
+	if err != nil {
+		return err
+	}
+	update(player)
+	if err := r.playerRepo.Update(player); err != nil {
+		return err
+	}
+
+	for _, unlock := range gain.Dungeons {
+		if r.unlockedLocked(unlock.PlayerID, unlock.DungeonID) {
+			continue
+		}
+		unlock.ID = r.nextID
+		r.nextID++
+		r.unlocks[unlock.ID] = &unlock
+	}
+	return nil
+}
+
+// unlockedLocked는 플레이어가 이미 해금한 던전인지 확인합니다 (호출 측이 잠금을 잡고 있어야 함)
+func (r *MockProgressionRepository) unlockedLocked(playerID, dungeonID uint) bool {
+	for _, unlock := range r.unlocks {
+		if unlock.PlayerID == playerID && unlock.DungeonID == dungeonID {
+			return true
+		}
+	}
+	return false
+}
+
+// FindDungeonUnlocksByPlayerID는 플레이어가 해금한 던전을 던전 ID 순으로 조회합니다
+func (r *MockProgressionRepository) FindDungeonUnlocksByPlayerID(playerID uint) ([]models.DungeonUnlock, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	unlocks := make([]models.DungeonUnlock, 0)
+	for _, unlock := range r.unlocks {
+		if unlock.PlayerID == playerID {
+			unlocks = append(unlocks, *unlock)
+		}
+	}
+	sort.Slice(unlocks, func(i, j int) bool {
+		return unlocks[i].DungeonID < unlocks[j].DungeonID
+	})
+	return unlocks, nil
+}
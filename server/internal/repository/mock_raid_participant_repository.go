@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+)
+
+// MockRaidParticipantRepository는 레이드 참여 기록 데이터 접근을 위한 Mock 구현체입니다
+type MockRaidParticipantRepository struct {
+	participants map[uint]*models.RaidParticipant
+	mu           sync.RWMutex
+	nextID       uint
+}
+
+// NewMockRaidParticipantRepository는 새로운 MockRaidParticipantRepository 인스턴스를 생성합니다
+func NewMockRaidParticipantRepository() *MockRaidParticipantRepository {
+	return &MockRaidParticipantRepository{
+		participants: make(map[uint]*models.RaidParticipant),
+		nextID:       1,
+	}
+}
+
+// FindByUserID는 유저의 레이드 참여 기록을 참여 시간순으로 조회합니다
+func (r *MockRaidParticipantRepository) FindByUserID(userID uint) ([]models.RaidParticipant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	participants := make([]models.RaidParticipant, 0)
+	for _, participant := range r.participants {
+		if participant.UserID == userID {
+			participants = append(participants, *participant)
+		}
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].JoinedAt.Before(participants[j].JoinedAt)
+	})
+	return participants, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"sync"
+	"time"
+)
+
+// MockRefreshTokenRepository는 리프레시 토큰 데이터 접근을 위한 Mock 구현체입니다
+type MockRefreshTokenRepository struct {
+	tokens map[uint]*models.RefreshToken
+	mu     sync.RWMutex
+	nextID uint
+}
+
+// NewMockRefreshTokenRepository는 새로운 MockRefreshTokenRepository 인스턴스를 생성합니다
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		tokens: make(map[uint]*models.RefreshToken),
+		nextID: 1,
+	}
+}
+
+// Create는 새로운 리프레시 토큰을 저장합니다
+func (r *MockRefreshTokenRepository) Create(token *models.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.tokens {
+		if t.TokenHash == token.TokenHash {
+			return errors.New("token hash already exists")
+		}
+	}
+
+	token.ID = r.nextID
+	r.nextID++
+	r.tokens[token.ID] = token
+	return nil
+}
+
+// FindByHash는 토큰 해시로 리프레시 토큰을 조회합니다
+func (r *MockRefreshTokenRepository) FindByHash(tokenHash string) (*models.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.tokens {
+		if t.TokenHash == tokenHash {
+			result := *t
+			return &result, nil
+		}
+	}
+
+	return nil, errors.New("refresh token not found")
+}
+
+// Rotate는 현재 토큰을 폐기하고 새 토큰을 저장합니다
+func (r *MockRefreshTokenRepository) Rotate(current *models.RefreshToken, next *models.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.tokens[current.ID]
+	if !exists {
+		return errors.New("refresh token not found")
+	}
+	if stored.RevokedAt != nil {
+		return ErrRefreshTokenAlreadyRotated
+	}
+
+	next.ID = r.nextID
+	r.nextID++
+	r.tokens[next.ID] = next
+
+	now := time.Now()
+	stored.RevokedAt = &now
+	stored.ReplacedByID = &next.ID
+	current.RevokedAt = &now
+	current.ReplacedByID = &next.ID
+	return nil
+}
+
+// RevokeFamily는 같은 패밀리에 속한 모든 토큰을 폐기합니다
+func (r *MockRefreshTokenRepository) RevokeFamily(familyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// RevokeAllByPlayerID는 플레이어의 모든 토큰을 폐기합니다
+func (r *MockRefreshTokenRepository) RevokeAllByPlayerID(playerID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.PlayerID == playerID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// DeleteExpired는 만료 시각이 before 이전인 토큰을 삭제합니다
+func (r *MockRefreshTokenRepository) DeleteExpired(before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, t := range r.tokens {
+		if t.ExpiresAt.Before(before) {
+			delete(r.tokens, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
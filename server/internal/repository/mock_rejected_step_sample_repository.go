@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockRejectedStepSampleRepository는 거부된 걸음 수 샘플 데이터 접근을 위한 Mock 구현체입니다
+type MockRejectedStepSampleRepository struct {
+	samples map[uint]*models.RejectedStepSample
+	mu      sync.RWMutex
+	nextID  uint
+}
+
+// NewMockRejectedStepSampleRepository는 새로운 MockRejectedStepSampleRepository 인스턴스를 생성합니다
+func NewMockRejectedStepSampleRepository() *MockRejectedStepSampleRepository {
+	return &MockRejectedStepSampleRepository{
+		samples: make(map[uint]*models.RejectedStepSample),
+		nextID:  1,
+	}
+}
+
+// CreateBatch는 거부된 샘플들을 한 번에 저장합니다
+func (r *MockRejectedStepSampleRepository) CreateBatch(samples []models.RejectedStepSample) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for i := range samples {
+		samples[i].ID = r.nextID
+		r.nextID++
+		if samples[i].CreatedAt.IsZero() {
+			samples[i].CreatedAt = now
+		}
+		sample := samples[i]
+		r.samples[sample.ID] = &sample
+	}
+	return nil
+}
+
+// FindByPlayerID는 플레이어의 거부된 샘플을 최신순으로 조회합니다
+func (r *MockRejectedStepSampleRepository) FindByPlayerID(playerID uint) ([]models.RejectedStepSample, error) {
+	samples, _, err := r.Find(RejectedStepSampleFilter{PlayerID: playerID}, math.MaxInt, 0)
+	return samples, err
+}
+
+// Find는 조건에 맞는 거부된 샘플을 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *MockRejectedStepSampleRepository) Find(filter RejectedStepSampleFilter, limit, offset int) ([]models.RejectedStepSample, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	samples := make([]models.RejectedStepSample, 0)
+	for _, sample := range r.samples {
+		if filter.PlayerID != 0 && sample.PlayerID != filter.PlayerID {
+			continue
+		}
+		if filter.Reason != "" && sample.Reason != filter.Reason {
+			continue
+		}
+		samples = append(samples, *sample)
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].ID > samples[j].ID
+	})
+
+	total := int64(len(samples))
+	if offset >= len(samples) {
+		return []models.RejectedStepSample{}, total, nil
+	}
+	samples = samples[offset:]
+	if limit < len(samples) {
+		samples = samples[:limit]
+	}
+	return samples, total, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"sync"
+	"time"
+)
+
+// MockSessionRepository는 세션 데이터 접근을 위한 Mock 구현체입니다
+type MockSessionRepository struct {
+	sessions map[uint]*models.Session
+	mu       sync.RWMutex
+	nextID   uint
+}
+
+// NewMockSessionRepository는 새로운 MockSessionRepository 인스턴스를 생성합니다
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		sessions: make(map[uint]*models.Session),
+		nextID:   1,
+	}
+}
+
+// Create는 새로운 세션을 생성합니다
+func (r *MockSessionRepository) Create(session *models.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session.ID = r.nextID
+	r.nextID++
+	r.sessions[session.ID] = session
+	return nil
+}
+
+// FindByID는 ID로 세션을 조회합니다
+func (r *MockSessionRepository) FindByID(id uint) (*models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, exists := r.sessions[id]
+	if !exists {
+		return nil, errors.New("session not found")
+	}
+
+	result := *session
+	return &result, nil
+}
+
+// FindByRefreshFamilyID는 리프레시 토큰 패밀리 ID로 세션을 조회합니다
+func (r *MockSessionRepository) FindByRefreshFamilyID(familyID string) (*models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, session := range r.sessions {
+		if session.RefreshFamilyID == familyID {
+			result := *session
+			return &result, nil
+		}
+	}
+
+	return nil, errors.New("session not found")
+}
+
+// FindActiveByPlayerID는 플레이어의 종료되지 않은 세션 목록을 조회합니다
+func (r *MockSessionRepository) FindActiveByPlayerID(playerID uint) ([]models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]models.Session, 0)
+	for _, session := range r.sessions {
+		if session.PlayerID == playerID && session.RevokedAt == nil {
+			sessions = append(sessions, *session)
+		}
+	}
+
+	return sessions, nil
+}
+
+// FindAllByPlayerID는 종료된 세션을 포함한 플레이어의 모든 세션을 조회합니다
+func (r *MockSessionRepository) FindAllByPlayerID(playerID uint) ([]models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]models.Session, 0)
+	for _, session := range r.sessions {
+		if session.PlayerID == playerID {
+			sessions = append(sessions, *session)
+		}
+	}
+
+	return sessions, nil
+}
+
+// Touch는 세션의 마지막 접속 시간과 IP를 갱신합니다
+func (r *MockSessionRepository) Touch(id uint, lastSeenAt time.Time, ip string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, exists := r.sessions[id]
+	if !exists {
+		return errors.New("session not found")
+	}
+
+	session.LastSeenAt = lastSeenAt
+	session.LastIP = ip
+	return nil
+}
+
+// Revoke는 세션을 종료합니다
+func (r *MockSessionRepository) Revoke(id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, exists := r.sessions[id]
+	if !exists {
+		return errors.New("session not found")
+	}
+
+	if session.RevokedAt == nil {
+		now := time.Now()
+		session.RevokedAt = &now
+	}
+	return nil
+}
+
+// RevokeAllByPlayerID는 플레이어의 모든 세션을 종료합니다
+func (r *MockSessionRepository) RevokeAllByPlayerID(playerID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, session := range r.sessions {
+		if session.PlayerID == playerID && session.RevokedAt == nil {
+			session.RevokedAt = &now
+		}
+	}
+	return nil
+}
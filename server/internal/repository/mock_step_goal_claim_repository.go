@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockStepGoalClaimRepository는 걸음 수 목표 보상 수령 기록 데이터 접근을 위한 Mock 구현체입니다
+// 보상 지급과 수령 표시는 함께 전달받은 지갑/활동 Repository에 위임합니다
+type MockStepGoalClaimRepository struct {
+	claims       map[uint]*models.StepGoalClaim
+	walletRepo   WalletRepositoryInterface
+	activityRepo *MockUserActivityRepository
+	mu           sync.RWMutex
+	nextID       uint
+}
+
+// NewMockStepGoalClaimRepository는 새로운 MockStepGoalClaimRepository 인스턴스를 생성합니다
+func NewMockStepGoalClaimRepository(walletRepo WalletRepositoryInterface, activityRepo *MockUserActivityRepository) *MockStepGoalClaimRepository {
+	return &MockStepGoalClaimRepository{
+		claims:       make(map[uint]*models.StepGoalClaim),
+		walletRepo:   walletRepo,
+		activityRepo: activityRepo,
+		nextID:       1,
+	}
+}
+
+// FindByPlayerIDAndDates는 플레이어의 지정한 날짜들의 수령 기록을 조회합니다
+func (r *MockStepGoalClaimRepository) FindByPlayerIDAndDates(playerID uint, dates []string) ([]models.StepGoalClaim, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	claims := make([]models.StepGoalClaim, 0)
+	for _, claim := range r.claims {
+		if claim.PlayerID == playerID && slices.Contains(dates, claim.Date) {
+			claims = append(claims, *claim)
+		}
+	}
+	sortStepGoalClaims(claims)
+	return claims, nil
+}
+
+// FindByPlayerID는 플레이어의 모든 수령 기록을 최신 날짜부터 조회합니다
+func (r *MockStepGoalClaimRepository) FindByPlayerID(playerID uint) ([]models.StepGoalClaim, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	claims := make([]models.StepGoalClaim, 0)
+	for _, claim := range r.claims {
+		if claim.PlayerID == playerID {
+			claims = append(claims, *claim)
+		}
+	}
+	sortStepGoalClaims(claims)
+	return claims, nil
+}
+
+// Claim은 목표 보상 수령 기록을 저장하고 새로 기록된 목표의 보상을 플레이어에게 지급합니다
+func (r *MockStepGoalClaimRepository) Claim(playerID uint, claims []models.StepGoalClaim) ([]models.StepGoalClaim, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var claimed []models.StepGoalClaim
+	var entries []models.WalletLedgerEntry
+	for i, claim := range claims {
+		if r.exists(playerID, claim.Date, claim.GoalSteps) {
+			continue
+		}
+		claim.ID = r.nextID + uint(i)
+		claim.PlayerID = playerID
+		if claim.ClaimedAt.IsZero() {
+			claim.ClaimedAt = time.Now()
+		}
+		claimed = append(claimed, claim)
+		entries = append(entries, stepGoalRewardEntries(&claim)...)
+	}
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+	if _, err := r.walletRepo.Apply(entries); err != nil {
+		return nil, err
+	}
+
+	r.nextID += uint(len(claims))
+	for _, claim := range claimed {
+		stored := claim
+		r.claims[claim.ID] = &stored
+		if r.activityRepo != nil {
+			r.activityRepo.markBonusApplied(playerID, claim.Date)
+		}
+	}
+	return claimed, nil
+}
+
+// exists는 (플레이어, 날짜, 목표) 수령 기록이 이미 있는지 확인합니다 (잠금은 호출자가 보유)
+func (r *MockStepGoalClaimRepository) exists(playerID uint, date string, goalSteps int) bool {
+	for _, claim := range r.claims {
+		if claim.PlayerID == playerID && claim.Date == date && claim.GoalSteps == goalSteps {
+			return true
+		}
+	}
+	return false
+}
+
+// sortStepGoalClaims는 수령 기록을 최신 날짜, 낮은 목표 순으로 정렬합니다
+func sortStepGoalClaims(claims []models.StepGoalClaim) {
+	sort.Slice(claims, func(i, j int) bool {
+		if claims[i].Date != claims[j].Date {
+			return claims[i].Date > claims[j].Date
+		}
+		return claims[i].GoalSteps < claims[j].GoalSteps
+	})
+}
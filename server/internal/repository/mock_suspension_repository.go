@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockSuspensionRepository는 이용 정지 데이터 접근을 위한 Mock 구현체입니다
+type MockSuspensionRepository struct {
+	suspensions map[uint]*models.Suspension
+	mu          sync.RWMutex
+	nextID      uint
+}
+
+// NewMockSuspensionRepository는 새로운 MockSuspensionRepository 인스턴스를 생성합니다
+func NewMockSuspensionRepository() *MockSuspensionRepository {
+	return &MockSuspensionRepository{
+		suspensions: make(map[uint]*models.Suspension),
+		nextID:      1,
+	}
+}
+
+// Create는 새로운 이용 정지를 저장합니다
+func (r *MockSuspensionRepository) Create(suspension *models.Suspension) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	suspension.ID = r.nextID
+	r.nextID++
+	now := time.Now()
+	if suspension.CreatedAt.IsZero() {
+		suspension.CreatedAt = now
+	}
+	suspension.UpdatedAt = now
+	r.suspensions[suspension.ID] = suspension
+	return nil
+}
+
+// FindByID는 ID로 이용 정지를 조회합니다
+func (r *MockSuspensionRepository) FindByID(id uint) (*models.Suspension, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	suspension, exists := r.suspensions[id]
+	if !exists {
+		return nil, errors.New("suspension not found")
+	}
+
+	result := *suspension
+	return &result, nil
+}
+
+// FindActiveByPlayerID는 플레이어의 현재 유효한 이용 정지를 조회합니다 (없으면 nil, nil)
+func (r *MockSuspensionRepository) FindActiveByPlayerID(playerID uint, now time.Time) (*models.Suspension, error) {
+	suspensions, _, err := r.Find(SuspensionFilter{PlayerID: playerID, ActiveOnly: true, Now: now}, 1, 0)
+	if err != nil || len(suspensions) == 0 {
+		return nil, err
+	}
+	return &suspensions[0], nil
+}
+
+// FindByPlayerID는 플레이어의 이용 정지 이력을 최신순으로 조회합니다
+func (r *MockSuspensionRepository) FindByPlayerID(playerID uint) ([]models.Suspension, error) {
+	r.mu.RLock()
+	total := len(r.suspensions)
+	r.mu.RUnlock()
+
+	suspensions, _, err := r.Find(SuspensionFilter{PlayerID: playerID}, total, 0)
+	return suspensions, err
+}
+
+// Find는 조건에 맞는 이용 정지를 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *MockSuspensionRepository) Find(filter SuspensionFilter, limit, offset int) ([]models.Suspension, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	suspensions := make([]models.Suspension, 0)
+	for _, suspension := range r.suspensions {
+		if filter.PlayerID != 0 && suspension.PlayerID != filter.PlayerID {
+			continue
+		}
+		if filter.ActiveOnly && !suspension.IsActive(filter.Now) {
+			continue
+		}
+		suspensions = append(suspensions, *suspension)
+	}
+
+	sort.Slice(suspensions, func(i, j int) bool {
+		return suspensions[i].ID > suspensions[j].ID
+	})
+
+	total := int64(len(suspensions))
+	if offset >= len(suspensions) {
+		return []models.Suspension{}, total, nil
+	}
+	suspensions = suspensions[offset:]
+	if limit < len(suspensions) {
+		suspensions = suspensions[:limit]
+	}
+	return suspensions, total, nil
+}
+
+// Lift는 유효한 이용 정지를 해제합니다
+func (r *MockSuspensionRepository) Lift(id, liftedByID uint, reason string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	suspension, exists := r.suspensions[id]
+	if !exists {
+		return errors.New("suspension not found")
+	}
+	if !suspension.IsActive(now) {
+		return ErrSuspensionNotActive
+	}
+
+	suspension.LiftedAt = &now
+	suspension.LiftedByID = &liftedByID
+	suspension.LiftReason = reason
+	suspension.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockUserActivityRepository는 일일 활동 데이터 접근을 위한 Mock 구현체입니다
+type MockUserActivityRepository struct {
+	activities map[uint]*models.UserActivity
+	mu         sync.RWMutex
+	nextID     uint
+}
+
+// NewMockUserActivityRepository는 새로운 MockUserActivityRepository 인스턴스를 생성합니다
+func NewMockUserActivityRepository() *MockUserActivityRepository {
+	return &MockUserActivityRepository{
+		activities: make(map[uint]*models.UserActivity),
+		nextID:     1,
+	}
+}
+
+// FindByUserID는 유저의 일일 활동 기록을 날짜순으로 조회합니다
+func (r *MockUserActivityRepository) FindByUserID(userID uint) ([]models.UserActivity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	activities := make([]models.UserActivity, 0)
+	for _, activity := range r.activities {
+		if activity.UserID == userID {
+			activities = append(activities, *activity)
+		}
+	}
+
+	sort.Slice(activities, func(i, j int) bool {
+		return activities[i].Date < activities[j].Date
+	})
+	return activities, nil
+}
+
+// FindRecentByUserID는 유저의 최근 활동 기록을 최신 날짜부터 limit개 조회합니다
+func (r *MockUserActivityRepository) FindRecentByUserID(userID uint, limit int) ([]models.UserActivity, error) {
+	activities, err := r.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(activities)
+	if limit < len(activities) {
+		activities = activities[:limit]
+	}
+	return activities, nil
+}
+
+// FindByUserIDAndDate는 유저의 특정 날짜 활동 기록을 조회합니다 (기록이 없으면 nil, nil)
+func (r *MockUserActivityRepository) FindByUserIDAndDate(userID uint, date string) (*models.UserActivity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, activity := range r.activities {
+		if activity.UserID == userID && activity.Date == date {
+			result := *activity
+			return &result, nil
+		}
+	}
+	return nil, nil
+}
+
+// AddSteps는 하루치 활동 기록에 걸음 수를 더하고 담당 구간을 delta 구간까지 넓힙니다 (기록이 없으면 생성)
+// 마지막 반영 샘플 종료 시각이 delta.FirstStart 이후면 ErrStepSamplesOverlap을 반환합니다
+func (r *MockUserActivityRepository) AddSteps(userID uint, date string, delta StepDelta) (*models.UserActivity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var activity *models.UserActivity
+	for _, existing := range r.activities {
+		if existing.UserID == userID && existing.Date == date {
+			activity = existing
+			break
+		}
+	}
+	if activity == nil {
+		activity = &models.UserActivity{
+			ID:         r.nextID,
+			UserID:     userID,
+			Date:       date,
+			Timezone:   delta.Timezone,
+			DayStartAt: delta.DayStart,
+			DayEndAt:   delta.DayEnd,
+			CreatedAt:  delta.SyncedAt,
+		}
+		r.activities[activity.ID] = activity
+		r.nextID++
+	}
+
+	if activity.LastSampleEndAt != nil && activity.LastSampleEndAt.After(delta.FirstStart) {
+		return nil, ErrStepSamplesOverlap
+	}
+
+	lastEnd := delta.LastEnd
+	activity.Steps += delta.Steps
+	activity.Calories += delta.Calories
+	activity.LastSampleEndAt = &lastEnd
+	activity.LastSyncedAt = delta.SyncedAt
+	activity.UpdatedAt = delta.SyncedAt
+	if delta.DayStart.Before(activity.DayStartAt) {
+		activity.DayStartAt = delta.DayStart
+	}
+	if delta.DayEnd.After(activity.DayEndAt) {
+		activity.DayEndAt = delta.DayEnd
+	}
+
+	result := *activity
+	return &result, nil
+}
+
+// FindByUserIDAt은 시각 t를 담당 구간에 포함하는 유저의 활동 기록을 조회합니다 (없으면 nil, nil)
+func (r *MockUserActivityRepository) FindByUserIDAt(userID uint, t time.Time) (*models.UserActivity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, activity := range r.activities {
+		if activity.UserID == userID && activity.Contains(t) {
+			result := *activity
+			return &result, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindNeighbors는 시각 t 직전에 끝난 기록과 t 이후에 시작하는 기록을 조회합니다 (없는 쪽은 nil)
+func (r *MockUserActivityRepository) FindNeighbors(userID uint, t time.Time) (*models.UserActivity, *models.UserActivity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var prev, next *models.UserActivity
+	for _, activity := range r.activities {
+		if activity.UserID != userID {
+			continue
+		}
+		if !activity.DayEndAt.After(t) && (prev == nil || activity.DayEndAt.After(prev.DayEndAt)) {
+			prev = activity
+		}
+		if activity.DayStartAt.After(t) && (next == nil || activity.DayStartAt.Before(next.DayStartAt)) {
+			next = activity
+		}
+	}
+
+	var prevActivity, nextActivity *models.UserActivity
+	if prev != nil {
+		result := *prev
+		prevActivity = &result
+	}
+	if next != nil {
+		result := *next
+		nextActivity = &result
+	}
+	return prevActivity, nextActivity, nil
+}
+
+// FindByUserIDBetween은 유저의 from~to(포함) 날짜 활동 기록을 날짜순으로 조회합니다
+func (r *MockUserActivityRepository) FindByUserIDBetween(userID uint, from, to string) ([]models.UserActivity, error) {
+	activities, err := r.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.UserActivity, 0, len(activities))
+	for _, activity := range activities {
+		if activity.Date >= from && activity.Date <= to {
+			result = append(result, activity)
+		}
+	}
+	return result, nil
+}
+
+// SumByUserIDBetween은 유저의 from~to(포함) 날짜 활동 기록을 집계합니다
+func (r *MockUserActivityRepository) SumByUserIDBetween(userID uint, from, to string) (*ActivityTotals, error) {
+	activities, err := r.FindByUserIDBetween(userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals ActivityTotals
+	for _, activity := range activities {
+		totals.ActiveDays++
+		totals.TotalSteps += int64(activity.Steps)
+		totals.TotalCalories += activity.Calories
+		totals.MaxSteps = max(totals.MaxSteps, activity.Steps)
+		totals.MaxCalories = max(totals.MaxCalories, activity.Calories)
+	}
+	return &totals, nil
+}
+
+// FindBestByUserID는 유저의 걸음 수가 가장 많은 날과 소모 칼로리가 가장 많은 날을 조회합니다
+func (r *MockUserActivityRepository) FindBestByUserID(userID uint) (*models.UserActivity, *models.UserActivity, error) {
+	activities, err := r.FindByUserID(userID)
+	if err != nil || len(activities) == 0 {
+		return nil, nil, err
+	}
+
+	// 날짜순으로 정렬되어 있으므로 같은 기록이면 먼저 달성한 날짜가 남습니다
+	bestSteps, bestCalories := activities[0], activities[0]
+	for _, activity := range activities[1:] {
+		if activity.Steps > bestSteps.Steps {
+			bestSteps = activity
+		}
+		if activity.Calories > bestCalories.Calories {
+			bestCalories = activity
+		}
+	}
+	return &bestSteps, &bestCalories, nil
+}
+
+// FindDatesWithMinSteps는 유저가 minSteps 이상 걸은 날짜를 날짜순으로 조회합니다
+func (r *MockUserActivityRepository) FindDatesWithMinSteps(userID uint, minSteps int) ([]string, error) {
+	activities, err := r.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]string, 0, len(activities))
+	for _, activity := range activities {
+		if activity.Steps >= minSteps {
+			dates = append(dates, activity.Date)
+		}
+	}
+	return dates, nil
+}
+
+// markBonusApplied는 유저의 특정 날짜 기록에 목표 보상 수령 여부를 표시합니다 (MockStepGoalClaimRepository용)
+func (r *MockUserActivityRepository) markBonusApplied(userID uint, date string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, activity := range r.activities {
+		if activity.UserID == userID && activity.Date == date {
+			activity.BonusApplied = true
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MockWalletRepository는 지갑과 재화 원장 데이터 접근을 위한 Mock 구현체입니다
+// 잔액은 함께 전달받은 플레이어 Repository의 Player.Wallet에 보관하고, 원장만 직접 저장합니다
+type MockWalletRepository struct {
+	ledger     map[uint]*models.WalletLedgerEntry
+	playerRepo PlayerRepositoryInterface
+	mu         sync.RWMutex
+	nextID     uint
+}
+
+// NewMockWalletRepository는 새로운 MockWalletRepository 인스턴스를 생성합니다
+func NewMockWalletRepository(playerRepo PlayerRepositoryInterface) *MockWalletRepository {
+	return &MockWalletRepository{
+		ledger:     make(map[uint]*models.WalletLedgerEntry),
+		playerRepo: playerRepo,
+		nextID:     1,
+	}
+}
+
+// FindBalances는 플레이어의 재화별 잔액을 조회합니다
+func (r *MockWalletRepository) FindBalances(playerID uint) ([]models.WalletBalance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	player, err := r.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]models.WalletBalance{}, player.Wallet...), nil
+}
+
+// FindLedger는 조건에 맞는 재화 원장을 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *MockWalletRepository) FindLedger(filter WalletLedgerFilter, limit, offset int) ([]models.WalletLedgerEntry, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]models.WalletLedgerEntry, 0)
+	for _, entry := range r.ledger {
+		if filter.PlayerID != 0 && entry.PlayerID != filter.PlayerID {
+			continue
+		}
+		if filter.Currency != "" && entry.Currency != filter.Currency {
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID > entries[j].ID
+	})
+
+	total := int64(len(entries))
+	if offset >= len(entries) {
+		return []models.WalletLedgerEntry{}, total, nil
+	}
+	entries = entries[offset:]
+	if limit < len(entries) {
+		entries = entries[:limit]
+	}
+	return entries, total, nil
+}
+
+// FindLedgerByPlayerID는 플레이어의 모든 재화 원장을 오래된 순으로 조회합니다
+func (r *MockWalletRepository) FindLedgerByPlayerID(playerID uint) ([]models.WalletLedgerEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]models.WalletLedgerEntry, 0)
+	for _, entry := range r.ledger {
+		if entry.PlayerID == playerID {
+			entries = append(entries, *entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// Apply는 재화 변동을 잔액에 반영하고 원장에 기록합니다
+// 하나라도 잔액이 부족하면 아무것도 반영하지 않고 *InsufficientFundsError를 반환합니다
+func (r *MockWalletRepository) Apply(entries []models.WalletLedgerEntry) ([]models.WalletLedgerEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 플레이어별로 변경 후 잔액을 먼저 계산해 모두 유효할 때만 반영합니다
+	players := make(map[uint]*models.Player)
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Amount == 0 {
+			continue
+		}
+		player, ok := players[entry.PlayerID]
+		if !ok {
+			found, err := r.playerRepo.FindByID(entry.PlayerID)
+			if err != nil {
+				return nil, err
+			}
+			found.Wallet = append([]models.WalletBalance{}, found.Wallet...)
+			players[entry.PlayerID] = found
+			player = found
+		}
+
+		index := -1
+		for j := range player.Wallet {
+			if player.Wallet[j].Currency == entry.Currency {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			player.Wallet = append(player.Wallet, models.WalletBalance{PlayerID: player.ID, Currency: entry.Currency})
+			index = len(player.Wallet) - 1
+		}
+		if player.Wallet[index].Balance+entry.Amount < 0 {
+			return nil, &InsufficientFundsError{Currency: entry.Currency}
+		}
+		player.Wallet[index].Balance += entry.Amount
+		player.Wallet[index].UpdatedAt = time.Now()
+		entry.BalanceAfter = player.Wallet[index].Balance
+	}
+
+	for _, player := range players {
+		if err := r.playerRepo.Update(player); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	for i := range entries {
+		if entries[i].Amount == 0 {
+			continue
+		}
+		entries[i].ID = r.nextID
+		r.nextID++
+		entries[i].CreatedAt = now
+		stored := entries[i]
+		r.ledger[stored.ID] = &stored
+	}
+	return entries, nil
+}
@@ -3,21 +3,31 @@ package repository
 import (
 	"errors"
 	"game_eating_pizza/internal/models"
+	"math"
+	"strconv"
 	"sync"
+	"time"
 )
 
 // MockWeaponRepository는 무기 데이터 접근을 위한 Mock 구현체입니다
+// 강화 비용 차감은 함께 전달받은 지갑 Repository에, 장착 무기와 보관 수 확인은 플레이어 Repository에 위임합니다
 type MockWeaponRepository struct {
-	weapons map[uint]*models.Weapon
-	mu      sync.RWMutex
-	nextID  uint
+	weapons      map[uint]*models.Weapon
+	walletRepo   WalletRepositoryInterface
+	playerRepo   PlayerRepositoryInterface
+	mu           sync.RWMutex
+	nextID       uint
+	nextEffectID uint
 }
 
 // NewMockWeaponRepository는 새로운 MockWeaponRepository 인스턴스를 생성합니다
-func NewMockWeaponRepository() *MockWeaponRepository {
+func NewMockWeaponRepository(walletRepo WalletRepositoryInterface, playerRepo PlayerRepositoryInterface) *MockWeaponRepository {
 	repo := &MockWeaponRepository{
-		weapons: make(map[uint]*models.Weapon),
-		nextID:  1,
+		weapons:      make(map[uint]*models.Weapon),
+		walletRepo:   walletRepo,
+		playerRepo:   playerRepo,
+		nextID:       1,
+		nextEffectID: 1,
 	}
 	
 	// 테스트용 초기 데이터
@@ -49,6 +59,11 @@ func (r *MockWeaponRepository) Create(weapon *models.Weapon) error {
 
 	weapon.ID = r.nextID
 	r.nextID++
+	for i := range weapon.Effects {
+		weapon.Effects[i].ID = r.nextEffectID
+		weapon.Effects[i].WeaponID = weapon.ID
+		r.nextEffectID++
+	}
 	r.weapons[weapon.ID] = weapon
 	return nil
 }
@@ -63,8 +78,7 @@ func (r *MockWeaponRepository) FindByID(id uint) (*models.Weapon, error) {
 		return nil, errors.New("weapon not found")
 	}
 
-	result := *weapon
-	return &result, nil
+	return copyWeapon(weapon), nil
 }
 
 // FindByPlayerID는 플레이어 ID로 무기 목록을 조회합니다
@@ -75,13 +89,27 @@ func (r *MockWeaponRepository) FindByPlayerID(playerID uint) ([]models.Weapon, e
 	weapons := make([]models.Weapon, 0)
 	for _, weapon := range r.weapons {
 		if weapon.PlayerID == playerID {
-			weapons = append(weapons, *weapon)
+			weapons = append(weapons, *copyWeapon(weapon))
 		}
 	}
 
 	return weapons, nil
 }
 
+// CountByPlayerID는 플레이어가 보유한 무기 수를 조회합니다
+func (r *MockWeaponRepository) CountByPlayerID(playerID uint) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, weapon := range r.weapons {
+		if weapon.PlayerID == playerID {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // Update는 무기 정보를 업데이트합니다
 func (r *MockWeaponRepository) Update(weapon *models.Weapon) error {
 	r.mu.Lock()
@@ -91,10 +119,200 @@ func (r *MockWeaponRepository) Update(weapon *models.Weapon) error {
 		return errors.New("weapon not found")
 	}
 
-	r.weapons[weapon.ID] = weapon
+	r.weapons[weapon.ID] = copyWeapon(weapon)
+	return nil
+}
+
+// Upgrade는 playerID 소유의 무기를 fromLevel에서 한 단계 올리고 골드 cost를 차감합니다
+func (r *MockWeaponRepository) Upgrade(weaponID, playerID uint, fromLevel, attackPowerGain int, cost int64) (*models.Weapon, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	weapon, exists := r.weapons[weaponID]
+	if !exists || weapon.PlayerID != playerID || weapon.Level != fromLevel {
+		return nil, ErrWeaponLevelChanged
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: playerID,
+		Currency: models.CurrencyGold,
+		Amount:   -cost,
+		Reason:   models.LedgerReasonWeaponUpgrade,
+		RefType:  "weapon",
+		RefID:    strconv.FormatUint(uint64(weaponID), 10),
+	}}); err != nil {
+		return nil, err
+	}
+
+	weapon.Level++
+	weapon.AttackPower += attackPowerGain
+	weapon.UpdatedAt = time.Now()
+	return copyWeapon(weapon), nil
+}
+
+// Promote는 재료 무기를 삭제하고 골드를 차감한 뒤 승급 결과를 반영합니다
+// 장착 여부는 확인하지 않습니다 (서비스에서 확인)
+func (r *MockWeaponRepository) Promote(promotion WeaponPromotion) (*models.Weapon, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	weapon, exists := r.weapons[promotion.WeaponID]
+	if !exists || weapon.PlayerID != promotion.PlayerID ||
+		weapon.Rarity != promotion.FromRarity || weapon.PromotionFailures != promotion.FromFailures {
+		return nil, ErrWeaponPromotionConflict
+	}
+	for _, id := range promotion.MaterialIDs {
+		material, exists := r.weapons[id]
+		if !exists || id == weapon.ID || material.PlayerID != promotion.PlayerID ||
+			material.Type != weapon.Type || material.Rarity != promotion.FromRarity || material.Locked {
+			return nil, ErrWeaponPromotionConflict
+		}
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: promotion.PlayerID,
+		Currency: models.CurrencyGold,
+		Amount:   -promotion.GoldCost,
+		Reason:   models.LedgerReasonWeaponPromotion,
+		RefType:  "weapon",
+		RefID:    strconv.FormatUint(uint64(weapon.ID), 10),
+	}}); err != nil {
+		return nil, err
+	}
+
+	for _, id := range promotion.MaterialIDs {
+		delete(r.weapons, id)
+	}
+	if promotion.Success {
+		weapon.Rarity = promotion.ToRarity
+		weapon.AttackPower = int(math.Round(float64(weapon.AttackPower) * promotion.StatRatio))
+		weapon.PromotionFailures = 0
+	} else {
+		weapon.PromotionFailures++
+	}
+	weapon.UpdatedAt = time.Now()
+	return copyWeapon(weapon), nil
+}
+
+// Enchant는 무기에 특수 효과를 붙이거나 같은 종류의 효과를 새 값으로 바꾸고 불씨를 차감합니다
+func (r *MockWeaponRepository) Enchant(playerID uint, effect models.WeaponEffect, maxEffects int, cost int64) (*models.Weapon, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	weapon, exists := r.weapons[effect.WeaponID]
+	if !exists || weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
+	}
+
+	existing := -1
+	for i := range weapon.Effects {
+		if weapon.Effects[i].Type == effect.Type {
+			existing = i
+			break
+		}
+	}
+	if existing < 0 && len(weapon.Effects) >= maxEffects {
+		return nil, ErrWeaponEffectSlotsFull
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: playerID,
+		Currency: models.CurrencyEmbers,
+		Amount:   -cost,
+		Reason:   models.LedgerReasonWeaponEnchant,
+		RefType:  "weapon",
+		RefID:    strconv.FormatUint(uint64(weapon.ID), 10),
+		Note:     effect.Type,
+	}}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if existing >= 0 {
+		weapon.Effects[existing].Magnitude = effect.Magnitude
+		weapon.Effects[existing].ProcChance = effect.ProcChance
+		weapon.Effects[existing].UpdatedAt = now
+	} else {
+		effect.ID = r.nextEffectID
+		effect.CreatedAt = now
+		effect.UpdatedAt = now
+		r.nextEffectID++
+		weapon.Effects = append(weapon.Effects, effect)
+	}
+	return copyWeapon(weapon), nil
+}
+
+// SetLocked는 playerID 소유 무기의 잠금 여부를 바꿉니다
+func (r *MockWeaponRepository) SetLocked(weaponID, playerID uint, locked bool) (*models.Weapon, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	weapon, exists := r.weapons[weaponID]
+	if !exists || weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
+	}
+
+	weapon.Locked = locked
+	weapon.UpdatedAt = time.Now()
+	return copyWeapon(weapon), nil
+}
+
+// Dispose는 playerID 소유 무기들을 삭제하고 보상을 지급합니다
+func (r *MockWeaponRepository) Dispose(playerID uint, weaponIDs []uint, rewards []models.WalletLedgerEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, err := r.playerRepo.FindByID(playerID)
+	if err != nil {
+		return ErrWeaponDisposalConflict
+	}
+	for _, id := range weaponIDs {
+		weapon, exists := r.weapons[id]
+		if !exists || weapon.PlayerID != playerID || weapon.Locked ||
+			(player.CurrentWeaponID != nil && *player.CurrentWeaponID == id) {
+			return ErrWeaponDisposalConflict
+		}
+	}
+
+	if _, err := r.walletRepo.Apply(rewards); err != nil {
+		return err
+	}
+	for _, id := range weaponIDs {
+		delete(r.weapons, id)
+	}
 	return nil
 }
 
+// ExpandCapacity는 플레이어의 무기 보관 수를 늘리고 보석을 차감합니다
+func (r *MockWeaponRepository) ExpandCapacity(playerID uint, fromBonus, step int, cost int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	player, err := r.playerRepo.FindByID(playerID)
+	if err != nil || player.WeaponCapacityBonus != fromBonus {
+		return ErrWeaponCapacityChanged
+	}
+
+	if _, err := r.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: playerID,
+		Currency: models.CurrencyGems,
+		Amount:   -cost,
+		Reason:   models.LedgerReasonInventoryExpand,
+		RefType:  "player",
+		RefID:    strconv.FormatUint(uint64(playerID), 10),
+	}}); err != nil {
+		return err
+	}
+
+	// 지갑 Repository가 잔액을 반영한 최신 플레이어에 보관 수를 더합니다
+	player, err = r.playerRepo.FindByID(playerID)
+	if err != nil {
+		return err
+	}
+	player.WeaponCapacityBonus += step
+	return r.playerRepo.Update(player)
+}
+
 // Delete는 무기를 삭제합니다
 func (r *MockWeaponRepository) Delete(id uint) error {
 	r.mu.Lock()
@@ -107,3 +325,10 @@ func (r *MockWeaponRepository) Delete(id uint) error {
 	delete(r.weapons, id)
 	return nil
 }
+
+// copyWeapon은 특수 효과 목록까지 복사한 무기 사본을 반환합니다
+func copyWeapon(weapon *models.Weapon) *models.Weapon {
+	result := *weapon
+	result.Effects = append([]models.WeaponEffect(nil), weapon.Effects...)
+	return &result
+}
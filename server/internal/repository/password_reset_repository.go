@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrPasswordResetAlreadyUsed는 이미 사용된 재설정 코드를 다시 사용하려 할 때 반환됩니다
+var ErrPasswordResetAlreadyUsed = errors.New("password reset code already used")
+
+// PasswordResetRepository는 비밀번호 재설정 코드 데이터 접근을 담당합니다
+// PasswordResetRepositoryInterface를 구현합니다
+type PasswordResetRepository struct {
+	db *gorm.DB
+}
+
+// PasswordResetRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ PasswordResetRepositoryInterface = (*PasswordResetRepository)(nil)
+
+// NewPasswordResetRepository는 새로운 PasswordResetRepository 인스턴스를 생성합니다
+func NewPasswordResetRepository(db *gorm.DB) *PasswordResetRepository {
+	return &PasswordResetRepository{db: db}
+}
+
+// Create는 새로운 재설정 코드를 저장합니다
+func (r *PasswordResetRepository) Create(reset *models.PasswordReset) error {
+	return r.db.Create(reset).Error
+}
+
+// FindUsable은 플레이어의 사용 가능한(미사용, 미만료) 재설정 코드를 조회합니다
+func (r *PasswordResetRepository) FindUsable(playerID uint, codeHash string, now time.Time) (*models.PasswordReset, error) {
+	var reset models.PasswordReset
+	err := r.db.
+		Where("player_id = ? AND code_hash = ? AND used_at IS NULL AND expires_at > ?", playerID, codeHash, now).
+		First(&reset).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reset, nil
+}
+
+// MarkUsed는 재설정 코드를 사용 처리합니다
+// used_at IS NULL 조건으로 동시에 같은 코드를 사용하는 요청 중 하나만 성공합니다
+func (r *PasswordResetRepository) MarkUsed(id uint) error {
+	result := r.db.Model(&models.PasswordReset{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPasswordResetAlreadyUsed
+	}
+	return nil
+}
+
+// InvalidateAllByPlayerID는 플레이어의 미사용 재설정 코드를 모두 무효화합니다
+func (r *PasswordResetRepository) InvalidateAllByPlayerID(playerID uint) error {
+	return r.db.Model(&models.PasswordReset{}).
+		Where("player_id = ? AND used_at IS NULL", playerID).
+		Update("used_at", time.Now()).Error
+}
+
+// DeleteExpired는 만료 시각이 before 이전인 코드를 삭제하고 삭제된 개수를 반환합니다
+func (r *PasswordResetRepository) DeleteExpired(before time.Time) (int64, error) {
+	result := r.db.Where("expires_at < ?", before).Delete(&models.PasswordReset{})
+	return result.RowsAffected, result.Error
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"game_eating_pizza/internal/models"
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -28,8 +30,9 @@ func (r *PlayerRepository) Create(player *models.Player) error {
 func (r *PlayerRepository) FindByID(id uint) (*models.Player, error) {
 	var player models.Player
 	err := r.db.
-		Preload("Weapons").
-		Preload("CurrentWeapon").
+		Preload("Weapons.Effects").
+		Preload("CurrentWeapon.Effects").
+		Preload("Wallet").
 		First(&player, id).Error
 	if err != nil {
 		return nil, err
@@ -47,33 +50,82 @@ func (r *PlayerRepository) FindByUsername(username string) (*models.Player, erro
 	return &player, nil
 }
 
+// FindGuestByDeviceID는 기기 식별자에 묶인 게스트 플레이어를 조회합니다
+func (r *PlayerRepository) FindGuestByDeviceID(deviceID string) (*models.Player, error) {
+	var player models.Player
+	err := r.db.Where("device_id = ? AND is_guest = ?", deviceID, true).First(&player).Error
+	if err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
 // Update는 플레이어 정보를 업데이트합니다
+// 모든 컬럼을 저장하므로 재화, 레벨, 보관 수 확장처럼 다른 요청이 동시에 변경하는 값을 덮어쓸 수 있습니다
+// 서비스에서는 바꿀 컬럼만 저장하는 UpdateProfile, UpdateCurrentWeapon 등을 사용하세요
 func (r *PlayerRepository) Update(player *models.Player) error {
 	return r.db.Save(player).Error
 }
 
-// UpdateGold는 플레이어의 골드를 업데이트합니다 (선택적 업데이트)
-func (r *PlayerRepository) UpdateGold(id uint, gold int64) error {
+// UpdateProfile은 프로필 필드만 업데이트합니다 (선택적 업데이트)
+// 골드, 레벨 등 다른 요청이 동시에 변경하는 값을 덮어쓰지 않도록 프로필 컬럼만 저장합니다
+func (r *PlayerRepository) UpdateProfile(player *models.Player) error {
+	return r.db.Model(player).
+		Select("display_name", "avatar_id", "locale", "timezone",
+			"notify_forge_complete", "notify_step_goal", "notify_raid", "notify_events").
+		Updates(player).Error
+}
+
+// UpdateCredentials는 계정 연결로 바뀌는 아이디, 비밀번호, 게스트 여부와 기기 바인딩만 업데이트합니다
+// 재화, 레벨 등 다른 요청이 동시에 변경하는 값을 덮어쓰지 않도록 해당 컬럼만 저장합니다
+func (r *PlayerRepository) UpdateCredentials(player *models.Player) error {
+	return r.db.Model(player).
+		Select("username", "password", "is_guest", "device_id").
+		Updates(player).Error
+}
+
+// UpdatePassword는 비밀번호 해시만 변경합니다
+func (r *PlayerRepository) UpdatePassword(id uint, hashedPassword string) error {
+	return r.db.Model(&models.Player{}).
+		Where("id = ?", id).
+		Update("password", hashedPassword).Error
+}
+
+// UpdateCurrentWeapon은 장착 무기만 변경합니다 (weaponID가 nil이면 장착 해제)
+// 재화, 레벨, 보관 수 확장 등 다른 요청이 동시에 변경하는 값을 덮어쓰지 않도록 해당 컬럼만 저장합니다
+func (r *PlayerRepository) UpdateCurrentWeapon(id uint, weaponID *uint) error {
 	return r.db.Model(&models.Player{}).
 		Where("id = ?", id).
-		Update("gold", gold).Error
+		Update("current_weapon_id", weaponID).Error
+}
+
+// UpdateRole은 플레이어의 역할을 변경합니다
+func (r *PlayerRepository) UpdateRole(id uint, role string) error {
+	return r.db.Model(&models.Player{}).
+		Where("id = ?", id).
+		Update("role", role).Error
 }
 
 // FindTopPlayersByLevel은 레벨이 높은 상위 플레이어를 조회합니다
 func (r *PlayerRepository) FindTopPlayersByLevel(limit int) ([]models.Player, error) {
 	var players []models.Player
 	err := r.db.
+		Where("deletion_scheduled_at IS NULL").
 		Order("level DESC, experience DESC").
 		Limit(limit).
 		Find(&players).Error
 	return players, err
 }
 
-// FindTopPlayersByGold은 골드가 많은 상위 플레이어를 조회합니다
+// FindTopPlayersByGold은 골드가 많은 상위 플레이어를 조회합니다 (지갑 포함)
+// 골드 잔액 행이 없는 플레이어(가입 후 골드를 받은 적 없는 플레이어 등)는 골드 0으로 봅니다
 func (r *PlayerRepository) FindTopPlayersByGold(limit int) ([]models.Player, error) {
 	var players []models.Player
 	err := r.db.
-		Order("gold DESC").
+		Preload("Wallet").
+		Joins("LEFT JOIN wallet_balances ON wallet_balances.player_id = players.id AND wallet_balances.currency = ?", models.CurrencyGold).
+		Where("players.deletion_scheduled_at IS NULL").
+		Order("COALESCE(wallet_balances.balance, 0) DESC").
 		Limit(limit).
 		Find(&players).Error
 	return players, err
@@ -88,6 +140,75 @@ func (r *PlayerRepository) ExistsByUsername(username string) (bool, error) {
 	return count > 0, err
 }
 
+// SetDeletionSchedule은 계정 삭제 예정 시각을 설정합니다 (nil이면 삭제 취소)
+func (r *PlayerRepository) SetDeletionSchedule(id uint, scheduledAt *time.Time) error {
+	return r.db.Model(&models.Player{}).
+		Where("id = ?", id).
+		Update("deletion_scheduled_at", scheduledAt).Error
+}
+
+// FindIDsDueForPurge는 완전 삭제 대상 플레이어 ID를 조회합니다
+// 삭제 예정 시각이 지난 계정과, 소프트 삭제된 뒤 유예 기간이 지난 계정이 대상입니다
+func (r *PlayerRepository) FindIDsDueForPurge(scheduledBefore, softDeletedBefore time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Unscoped().
+		Model(&models.Player{}).
+		Where("(deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= ?) OR (deleted_at IS NOT NULL AND deleted_at <= ?)",
+			scheduledBefore, softDeletedBefore).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Purge는 플레이어와 플레이어에 딸린 모든 데이터를 트랜잭션으로 완전 삭제합니다
+func (r *PlayerRepository) Purge(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 장착 무기 FK가 무기 삭제를 막지 않도록 먼저 해제합니다
+		if err := tx.Unscoped().Model(&models.Player{}).
+			Where("id = ?", id).
+			Update("current_weapon_id", nil).Error; err != nil {
+			return err
+		}
+
+		// 무기 특수 효과는 플레이어 ID가 없으므로 무기를 통해 먼저 삭제합니다
+		if err := tx.Where("weapon_id IN (?)", tx.Unscoped().Model(&models.Weapon{}).
+			Select("id").
+			Where("player_id = ?", id)).
+			Delete(&models.WeaponEffect{}).Error; err != nil {
+			return err
+		}
+
+		related := []struct {
+			model  interface{}
+			column string
+		}{
+			{&models.ForgeJob{}, "player_id"}, // 결과 무기를 참조하므로 무기보다 먼저 삭제
+			{&models.Weapon{}, "player_id"},
+			{&models.UserActivity{}, "user_id"},
+			{&models.RejectedStepSample{}, "player_id"},
+			{&models.StepGoalClaim{}, "player_id"},
+			{&models.PlayerUpgrade{}, "player_id"},
+			{&models.WalletLedgerEntry{}, "player_id"},
+			{&models.WalletBalance{}, "player_id"},
+			{&models.InventoryItem{}, "player_id"},
+			{&models.DungeonUnlock{}, "player_id"},
+			{&models.RaidParticipant{}, "user_id"},
+			{&models.RefreshToken{}, "player_id"},
+			{&models.Session{}, "player_id"},
+			{&models.PasswordReset{}, "player_id"},
+			{&models.Suspension{}, "player_id"},
+		}
+		for _, rel := range related {
+			if err := tx.Unscoped().Where(rel.column+" = ?", id).Delete(rel.model).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Delete(&models.Player{}, id).Error
+	})
+}
+
 // Delete는 플레이어를 소프트 삭제합니다 (DeletedAt 설정)
 func (r *PlayerRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Player{}, id).Error
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrUpgradeLevelChanged는 구매하려던 단계가 (동시에 처리된 다른 요청 등으로) 이미 구매되었을 때 반환됩니다
+var ErrUpgradeLevelChanged = errors.New("upgrade level changed")
+
+// PlayerUpgradeRepository는 영구 강화 데이터 접근을 담당합니다
+// PlayerUpgradeRepositoryInterface를 구현합니다
+type PlayerUpgradeRepository struct {
+	db *gorm.DB
+}
+
+// PlayerUpgradeRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ PlayerUpgradeRepositoryInterface = (*PlayerUpgradeRepository)(nil)
+
+// NewPlayerUpgradeRepository는 새로운 PlayerUpgradeRepository 인스턴스를 생성합니다
+func NewPlayerUpgradeRepository(db *gorm.DB) *PlayerUpgradeRepository {
+	return &PlayerUpgradeRepository{db: db}
+}
+
+// FindByPlayerID는 플레이어가 구매한 영구 강화 목록을 조회합니다
+func (r *PlayerUpgradeRepository) FindByPlayerID(playerID uint) ([]models.PlayerUpgrade, error) {
+	var upgrades []models.PlayerUpgrade
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("node_id").
+		Find(&upgrades).Error
+	return upgrades, err
+}
+
+// Purchase는 강화 노드를 level 단계로 올리고 활력의 불씨 cost를 차감하는 작업을 하나의 트랜잭션으로 처리합니다
+// 현재 단계가 level-1이 아니면 ErrUpgradeLevelChanged, 불씨가 부족하면 *InsufficientFundsError를 반환합니다
+func (r *PlayerUpgradeRepository) Purchase(playerID uint, nodeID string, level int, cost int64) (*models.PlayerUpgrade, error) {
+	upgrade := models.PlayerUpgrade{
+		PlayerID: playerID,
+		NodeID:   nodeID,
+		Level:    level,
+	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var result *gorm.DB
+		if level == 1 {
+			result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&upgrade)
+		} else {
+			result = tx.Model(&models.PlayerUpgrade{}).
+				Where("player_id = ? AND node_id = ? AND level = ?", playerID, nodeID, level-1).
+				Update("level", level)
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrUpgradeLevelChanged
+		}
+
+		if err := applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: playerID,
+			Currency: models.CurrencyEmbers,
+			Amount:   -cost,
+			Reason:   models.LedgerReasonUpgradePurchase,
+			RefType:  "upgrade",
+			RefID:    fmt.Sprintf("%s:%d", nodeID, level),
+		}}); err != nil {
+			return err
+		}
+
+		return tx.Where("player_id = ? AND node_id = ?", playerID, nodeID).First(&upgrade).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &upgrade, nil
+}
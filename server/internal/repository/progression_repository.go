@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrPlayerProgressChanged는 경험치를 계산한 뒤 (동시에 처리된 다른 요청 등으로) 플레이어 레벨, 경험치나 방치 사냥 정산 시각이 바뀌었을 때 반환됩니다
+var ErrPlayerProgressChanged = errors.New("player progress changed")
+
+// ProgressionRepository는 플레이어 레벨 진행 데이터 접근을 담당합니다
+// ProgressionRepositoryInterface를 구현합니다
+type ProgressionRepository struct {
+	db *gorm.DB
+}
+
+// ProgressionRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ ProgressionRepositoryInterface = (*ProgressionRepository)(nil)
+
+// NewProgressionRepository는 새로운 ProgressionRepository 인스턴스를 생성합니다
+func NewProgressionRepository(db *gorm.DB) *ProgressionRepository {
+	return &ProgressionRepository{db: db}
+}
+
+// GainExperience는 플레이어 레벨·경험치 변경과 레벨업 보상(재화, 아이템, 던전 해금)을 하나의 트랜잭션으로 처리합니다
+// 레벨·경험치가 FromLevel/FromExperience가 아니면 아무것도 반영하지 않고 ErrPlayerProgressChanged를 반환합니다
+func (r *ProgressionRepository) GainExperience(gain ExperienceGain) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Player{}).
+			Where("id = ? AND level = ? AND experience = ?", gain.PlayerID, gain.FromLevel, gain.FromExperience).
+			Updates(map[string]interface{}{
+				"level":      gain.Level,
+				"experience": gain.Experience,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPlayerProgressChanged
+		}
+		return applyExperienceRewards(tx, gain)
+	})
+}
+
+// ApplyIdleProgress는 방치 사냥 정산(정산 시각, 스테이지, 처치 수, 레벨·경험치 변경, 처치 골드와 레벨업 보상)을 하나의 트랜잭션으로 처리합니다
+// 정산 시각이 FromSyncedAt이 아니거나 레벨·경험치가 FromLevel/FromExperience가 아니면 아무것도 반영하지 않고 ErrPlayerProgressChanged를 반환합니다
+func (r *ProgressionRepository) ApplyIdleProgress(progress IdleProgress) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Player{}).
+			Where("id = ? AND level = ? AND experience = ?", progress.PlayerID, progress.FromLevel, progress.FromExperience)
+		if progress.FromSyncedAt == nil {
+			query = query.Where("idle_synced_at IS NULL")
+		} else {
+			query = query.Where("idle_synced_at = ?", *progress.FromSyncedAt)
+		}
+		result := query.Updates(map[string]interface{}{
+			"level":          progress.Level,
+			"experience":     progress.Experience,
+			"stage":          progress.Stage,
+			"stage_kills":    progress.StageKills,
+			"idle_synced_at": progress.SyncedAt,
+			"total_kills":    gorm.Expr("total_kills + ?", progress.Kills),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPlayerProgressChanged
+		}
+		return applyExperienceRewards(tx, progress.ExperienceGain)
+	})
+}
+
+// applyExperienceRewards는 경험치 획득에 딸린 재화·아이템 지급과 던전 해금을 트랜잭션 안에서 반영합니다
+func applyExperienceRewards(tx *gorm.DB, gain ExperienceGain) error {
+	if err := applyWalletEntries(tx, gain.Rewards); err != nil {
+		return err
+	}
+	if _, err := applyInventoryChanges(tx, gain.Items); err != nil {
+		return err
+	}
+	for _, unlock := range gain.Dungeons {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&unlock).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindDungeonUnlocksByPlayerID는 플레이어가 해금한 던전을 던전 ID 순으로 조회합니다
+func (r *ProgressionRepository) FindDungeonUnlocksByPlayerID(playerID uint) ([]models.DungeonUnlock, error) {
+	var unlocks []models.DungeonUnlock
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("dungeon_id").
+		Find(&unlocks).Error
+	return unlocks, err
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// RaidParticipantRepository는 레이드 참여 기록 데이터 접근을 담당합니다
+// RaidParticipantRepositoryInterface를 구현합니다
+type RaidParticipantRepository struct {
+	db *gorm.DB
+}
+
+// RaidParticipantRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ RaidParticipantRepositoryInterface = (*RaidParticipantRepository)(nil)
+
+// NewRaidParticipantRepository는 새로운 RaidParticipantRepository 인스턴스를 생성합니다
+func NewRaidParticipantRepository(db *gorm.DB) *RaidParticipantRepository {
+	return &RaidParticipantRepository{db: db}
+}
+
+// FindByUserID는 유저의 레이드 참여 기록을 참여 시간순으로 조회합니다
+func (r *RaidParticipantRepository) FindByUserID(userID uint) ([]models.RaidParticipant, error) {
+	var participants []models.RaidParticipant
+	err := r.db.
+		Where("user_id = ?", userID).
+		Order("joined_at").
+		Find(&participants).Error
+	return participants, err
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrRefreshTokenAlreadyRotated는 회전하려는 토큰이 이미 다른 요청에 의해 폐기되었을 때 반환됩니다
+// 동일한 리프레시 토큰으로 동시에 두 번 갱신을 시도한 경우에 해당합니다
+var ErrRefreshTokenAlreadyRotated = errors.New("refresh token already rotated")
+
+// RefreshTokenRepository는 리프레시 토큰 데이터 접근을 담당합니다
+// RefreshTokenRepositoryInterface를 구현합니다
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// RefreshTokenRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ RefreshTokenRepositoryInterface = (*RefreshTokenRepository)(nil)
+
+// NewRefreshTokenRepository는 새로운 RefreshTokenRepository 인스턴스를 생성합니다
+func NewRefreshTokenRepository(db *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Create는 새로운 리프레시 토큰을 저장합니다
+func (r *RefreshTokenRepository) Create(token *models.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+// FindByHash는 토큰 해시로 리프레시 토큰을 조회합니다
+func (r *RefreshTokenRepository) FindByHash(tokenHash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Rotate는 현재 토큰을 폐기하고 새 토큰을 저장합니다 (트랜잭션)
+// 현재 토큰이 이미 폐기된 상태라면 ErrRefreshTokenAlreadyRotated를 반환합니다
+func (r *RefreshTokenRepository) Rotate(current *models.RefreshToken, next *models.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+
+		// revoked_at IS NULL 조건으로 동시 요청 중 하나만 성공하도록 보장
+		now := time.Now()
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", current.ID).
+			Updates(map[string]interface{}{
+				"revoked_at":     now,
+				"replaced_by_id": next.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrRefreshTokenAlreadyRotated
+		}
+
+		current.RevokedAt = &now
+		current.ReplacedByID = &next.ID
+		return nil
+	})
+}
+
+// RevokeFamily는 같은 패밀리에 속한 모든 토큰을 폐기합니다
+func (r *RefreshTokenRepository) RevokeFamily(familyID string) error {
+	return r.db.Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// RevokeAllByPlayerID는 플레이어의 모든 토큰을 폐기합니다 (전체 기기 로그아웃)
+func (r *RefreshTokenRepository) RevokeAllByPlayerID(playerID uint) error {
+	return r.db.Model(&models.RefreshToken{}).
+		Where("player_id = ? AND revoked_at IS NULL", playerID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// DeleteExpired는 만료 시각이 before 이전인 토큰을 삭제하고 삭제된 개수를 반환합니다
+func (r *RefreshTokenRepository) DeleteExpired(before time.Time) (int64, error) {
+	result := r.db.Where("expires_at < ?", before).Delete(&models.RefreshToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// RejectedStepSampleRepository는 거부된 걸음 수 샘플 데이터 접근을 담당합니다
+// RejectedStepSampleRepositoryInterface를 구현합니다
+type RejectedStepSampleRepository struct {
+	db *gorm.DB
+}
+
+// RejectedStepSampleRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ RejectedStepSampleRepositoryInterface = (*RejectedStepSampleRepository)(nil)
+
+// NewRejectedStepSampleRepository는 새로운 RejectedStepSampleRepository 인스턴스를 생성합니다
+func NewRejectedStepSampleRepository(db *gorm.DB) *RejectedStepSampleRepository {
+	return &RejectedStepSampleRepository{db: db}
+}
+
+// CreateBatch는 거부된 샘플들을 한 번에 저장합니다
+func (r *RejectedStepSampleRepository) CreateBatch(samples []models.RejectedStepSample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	return r.db.Create(&samples).Error
+}
+
+// FindByPlayerID는 플레이어의 거부된 샘플을 최신순으로 조회합니다
+func (r *RejectedStepSampleRepository) FindByPlayerID(playerID uint) ([]models.RejectedStepSample, error) {
+	var samples []models.RejectedStepSample
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("created_at DESC, id DESC").
+		Find(&samples).Error
+	return samples, err
+}
+
+// Find는 조건에 맞는 거부된 샘플을 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *RejectedStepSampleRepository) Find(filter RejectedStepSampleFilter, limit, offset int) ([]models.RejectedStepSample, int64, error) {
+	query := r.db.Model(&models.RejectedStepSample{})
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+	if filter.Reason != "" {
+		query = query.Where("reason = ?", filter.Reason)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var samples []models.RejectedStepSample
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&samples).Error
+	return samples, total, err
+}
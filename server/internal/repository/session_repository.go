@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SessionRepository는 기기별 세션 데이터 접근을 담당합니다
+// SessionRepositoryInterface를 구현합니다
+type SessionRepository struct {
+	db *gorm.DB
+}
+
+// SessionRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ SessionRepositoryInterface = (*SessionRepository)(nil)
+
+// NewSessionRepository는 새로운 SessionRepository 인스턴스를 생성합니다
+func NewSessionRepository(db *gorm.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+// Create는 새로운 세션을 생성합니다
+func (r *SessionRepository) Create(session *models.Session) error {
+	return r.db.Create(session).Error
+}
+
+// FindByID는 ID로 세션을 조회합니다
+func (r *SessionRepository) FindByID(id uint) (*models.Session, error) {
+	var session models.Session
+	err := r.db.First(&session, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindByRefreshFamilyID는 리프레시 토큰 패밀리 ID로 세션을 조회합니다
+func (r *SessionRepository) FindByRefreshFamilyID(familyID string) (*models.Session, error) {
+	var session models.Session
+	err := r.db.Where("refresh_family_id = ?", familyID).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindActiveByPlayerID는 플레이어의 종료되지 않은 세션 목록을 최근 접속순으로 조회합니다
+func (r *SessionRepository) FindActiveByPlayerID(playerID uint) ([]models.Session, error) {
+	var sessions []models.Session
+	err := r.db.
+		Where("player_id = ? AND revoked_at IS NULL", playerID).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// FindAllByPlayerID는 종료된 세션을 포함한 플레이어의 모든 세션을 생성순으로 조회합니다
+func (r *SessionRepository) FindAllByPlayerID(playerID uint) ([]models.Session, error) {
+	var sessions []models.Session
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("created_at").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// Touch는 세션의 마지막 접속 시간과 IP를 갱신합니다
+func (r *SessionRepository) Touch(id uint, lastSeenAt time.Time, ip string) error {
+	return r.db.Model(&models.Session{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_seen_at": lastSeenAt,
+			"last_ip":      ip,
+		}).Error
+}
+
+// Revoke는 세션을 종료합니다
+func (r *SessionRepository) Revoke(id uint) error {
+	return r.db.Model(&models.Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
+
+// RevokeAllByPlayerID는 플레이어의 모든 세션을 종료합니다
+func (r *SessionRepository) RevokeAllByPlayerID(playerID uint) error {
+	return r.db.Model(&models.Session{}).
+		Where("player_id = ? AND revoked_at IS NULL", playerID).
+		Update("revoked_at", time.Now()).Error
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"game_eating_pizza/internal/models"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StepGoalClaimRepository는 걸음 수 목표 보상 수령 기록 데이터 접근을 담당합니다
+// StepGoalClaimRepositoryInterface를 구현합니다
+type StepGoalClaimRepository struct {
+	db *gorm.DB
+}
+
+// StepGoalClaimRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ StepGoalClaimRepositoryInterface = (*StepGoalClaimRepository)(nil)
+
+// NewStepGoalClaimRepository는 새로운 StepGoalClaimRepository 인스턴스를 생성합니다
+func NewStepGoalClaimRepository(db *gorm.DB) *StepGoalClaimRepository {
+	return &StepGoalClaimRepository{db: db}
+}
+
+// FindByPlayerIDAndDates는 플레이어의 지정한 날짜들의 수령 기록을 조회합니다
+func (r *StepGoalClaimRepository) FindByPlayerIDAndDates(playerID uint, dates []string) ([]models.StepGoalClaim, error) {
+	var claims []models.StepGoalClaim
+	if len(dates) == 0 {
+		return claims, nil
+	}
+	err := r.db.
+		Where("player_id = ? AND date IN ?", playerID, dates).
+		Order("date DESC, goal_steps").
+		Find(&claims).Error
+	return claims, err
+}
+
+// FindByPlayerID는 플레이어의 모든 수령 기록을 최신 날짜부터 조회합니다
+func (r *StepGoalClaimRepository) FindByPlayerID(playerID uint) ([]models.StepGoalClaim, error) {
+	var claims []models.StepGoalClaim
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("date DESC, goal_steps").
+		Find(&claims).Error
+	return claims, err
+}
+
+// Claim은 목표 보상 수령 기록을 저장하고 새로 기록된 목표의 보상을 플레이어에게 지급합니다
+// 이미 수령한 (날짜, 목표)는 건너뛰므로 같은 요청이 동시에 들어와도 보상은 한 번만 지급되며,
+// 실제로 새로 수령한 기록만 반환합니다
+func (r *StepGoalClaimRepository) Claim(playerID uint, claims []models.StepGoalClaim) ([]models.StepGoalClaim, error) {
+	var claimed []models.StepGoalClaim
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var entries []models.WalletLedgerEntry
+		dates := make(map[string]bool)
+		for _, claim := range claims {
+			claim.PlayerID = playerID
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&claim)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			claimed = append(claimed, claim)
+			entries = append(entries, stepGoalRewardEntries(&claim)...)
+			dates[claim.Date] = true
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		if err := applyWalletEntries(tx, entries); err != nil {
+			return err
+		}
+
+		for date := range dates {
+			if err := tx.Model(&models.UserActivity{}).
+				Where("user_id = ? AND date = ?", playerID, date).
+				Update("bonus_applied", true).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// stepGoalRewardEntries는 목표 보상 수령 기록 한 건의 골드/활력의 불씨 지급 원장 항목을 만듭니다
+func stepGoalRewardEntries(claim *models.StepGoalClaim) []models.WalletLedgerEntry {
+	refID := strconv.FormatUint(uint64(claim.ID), 10)
+	return []models.WalletLedgerEntry{
+		{PlayerID: claim.PlayerID, Currency: models.CurrencyGold, Amount: claim.Gold, Reason: models.LedgerReasonStepGoal, RefType: "step_goal_claim", RefID: refID},
+		{PlayerID: claim.PlayerID, Currency: models.CurrencyEmbers, Amount: claim.Embers, Reason: models.LedgerReasonStepGoal, RefType: "step_goal_claim", RefID: refID},
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrSuspensionNotActive는 이미 해제되었거나 만료된 이용 정지를 해제하려 할 때 반환됩니다
+var ErrSuspensionNotActive = errors.New("suspension is not active")
+
+// SuspensionRepository는 이용 정지 데이터 접근을 담당합니다
+// SuspensionRepositoryInterface를 구현합니다
+type SuspensionRepository struct {
+	db *gorm.DB
+}
+
+// SuspensionRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ SuspensionRepositoryInterface = (*SuspensionRepository)(nil)
+
+// NewSuspensionRepository는 새로운 SuspensionRepository 인스턴스를 생성합니다
+func NewSuspensionRepository(db *gorm.DB) *SuspensionRepository {
+	return &SuspensionRepository{db: db}
+}
+
+// Create는 새로운 이용 정지를 저장합니다
+func (r *SuspensionRepository) Create(suspension *models.Suspension) error {
+	return r.db.Create(suspension).Error
+}
+
+// FindByID는 ID로 이용 정지를 조회합니다
+func (r *SuspensionRepository) FindByID(id uint) (*models.Suspension, error) {
+	var suspension models.Suspension
+	if err := r.db.First(&suspension, id).Error; err != nil {
+		return nil, err
+	}
+	return &suspension, nil
+}
+
+// FindActiveByPlayerID는 플레이어의 현재 유효한 이용 정지를 조회합니다
+// 유효한 정지가 없으면 nil, nil을 반환합니다 (매 요청마다 호출되므로 "없음"을 에러로 취급하지 않음)
+func (r *SuspensionRepository) FindActiveByPlayerID(playerID uint, now time.Time) (*models.Suspension, error) {
+	var suspensions []models.Suspension
+	err := r.activeScope(r.db.Where("player_id = ?", playerID), now).
+		Order("created_at DESC, id DESC").
+		Limit(1).
+		Find(&suspensions).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(suspensions) == 0 {
+		return nil, nil
+	}
+	return &suspensions[0], nil
+}
+
+// FindByPlayerID는 플레이어의 이용 정지 이력을 최신순으로 조회합니다
+func (r *SuspensionRepository) FindByPlayerID(playerID uint) ([]models.Suspension, error) {
+	var suspensions []models.Suspension
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("created_at DESC, id DESC").
+		Find(&suspensions).Error
+	return suspensions, err
+}
+
+// Find는 조건에 맞는 이용 정지를 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *SuspensionRepository) Find(filter SuspensionFilter, limit, offset int) ([]models.Suspension, int64, error) {
+	query := r.db.Model(&models.Suspension{})
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+	if filter.ActiveOnly {
+		query = r.activeScope(query, filter.Now)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var suspensions []models.Suspension
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&suspensions).Error
+	return suspensions, total, err
+}
+
+// Lift는 이용 정지를 해제합니다
+// 유효한 정지에만 조건부로 반영하므로 동시에 해제를 요청해도 한 번만 기록됩니다
+func (r *SuspensionRepository) Lift(id, liftedByID uint, reason string, now time.Time) error {
+	result := r.activeScope(r.db.Model(&models.Suspension{}).Where("id = ?", id), now).
+		Updates(map[string]interface{}{
+			"lifted_at":    now,
+			"lifted_by_id": liftedByID,
+			"lift_reason":  reason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrSuspensionNotActive
+	}
+	return nil
+}
+
+// activeScope는 해제되지 않고 만료되지 않은 정지만 남기는 조건을 추가합니다
+func (r *SuspensionRepository) activeScope(query *gorm.DB, now time.Time) *gorm.DB {
+	return query.Where("lifted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", now)
+}
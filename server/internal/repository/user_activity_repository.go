@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrStepSamplesOverlap은 반영하려는 샘플이 (동시에 처리된 다른 요청 등으로) 이미 반영된 구간과 겹칠 때 반환됩니다
+var ErrStepSamplesOverlap = errors.New("step samples overlap already applied samples")
+
+// UserActivityRepository는 일일 활동(걸음 수) 데이터 접근을 담당합니다
+// UserActivityRepositoryInterface를 구현합니다
+type UserActivityRepository struct {
+	db *gorm.DB
+}
+
+// UserActivityRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ UserActivityRepositoryInterface = (*UserActivityRepository)(nil)
+
+// NewUserActivityRepository는 새로운 UserActivityRepository 인스턴스를 생성합니다
+func NewUserActivityRepository(db *gorm.DB) *UserActivityRepository {
+	return &UserActivityRepository{db: db}
+}
+
+// FindByUserID는 유저의 일일 활동 기록을 날짜순으로 조회합니다
+func (r *UserActivityRepository) FindByUserID(userID uint) ([]models.UserActivity, error) {
+	var activities []models.UserActivity
+	err := r.db.
+		Where("user_id = ?", userID).
+		Order("date").
+		Find(&activities).Error
+	return activities, err
+}
+
+// FindRecentByUserID는 유저의 최근 활동 기록을 최신 날짜부터 limit개 조회합니다
+func (r *UserActivityRepository) FindRecentByUserID(userID uint, limit int) ([]models.UserActivity, error) {
+	var activities []models.UserActivity
+	err := r.db.
+		Where("user_id = ?", userID).
+		Order("date DESC").
+		Limit(limit).
+		Find(&activities).Error
+	return activities, err
+}
+
+// FindByUserIDAndDate는 유저의 특정 날짜 활동 기록을 조회합니다 (기록이 없으면 nil, nil)
+func (r *UserActivityRepository) FindByUserIDAndDate(userID uint, date string) (*models.UserActivity, error) {
+	var activity models.UserActivity
+	err := r.db.
+		Where("user_id = ? AND date = ?", userID, date).
+		First(&activity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+// FindByUserIDAt은 시각 t를 담당 구간에 포함하는 유저의 활동 기록을 조회합니다 (없으면 nil, nil)
+func (r *UserActivityRepository) FindByUserIDAt(userID uint, t time.Time) (*models.UserActivity, error) {
+	var activity models.UserActivity
+	err := r.db.
+		Where("user_id = ? AND day_start_at <= ? AND day_end_at > ?", userID, t, t).
+		First(&activity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+// FindNeighbors는 시각 t 직전에 끝난 기록과 t 이후에 시작하는 기록을 조회합니다 (없는 쪽은 nil)
+func (r *UserActivityRepository) FindNeighbors(userID uint, t time.Time) (*models.UserActivity, *models.UserActivity, error) {
+	var prev, next []models.UserActivity
+	err := r.db.
+		Where("user_id = ? AND day_end_at <= ?", userID, t).
+		Order("day_end_at DESC").
+		Limit(1).
+		Find(&prev).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	err = r.db.
+		Where("user_id = ? AND day_start_at > ?", userID, t).
+		Order("day_start_at").
+		Limit(1).
+		Find(&next).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var prevActivity, nextActivity *models.UserActivity
+	if len(prev) > 0 {
+		prevActivity = &prev[0]
+	}
+	if len(next) > 0 {
+		nextActivity = &next[0]
+	}
+	return prevActivity, nextActivity, nil
+}
+
+// AddSteps는 하루치 활동 기록에 걸음 수를 더하고 담당 구간을 delta 구간까지 넓힙니다 (기록이 없으면 생성)
+// 마지막 반영 샘플 종료 시각이 delta.FirstStart 이전일 때만 조건부로 반영하므로
+// 같은 샘플이 동시에 두 번 동기화되어도 한 번만 더해지고, 나중 요청은 ErrStepSamplesOverlap을 받습니다
+func (r *UserActivityRepository) AddSteps(userID uint, date string, delta StepDelta) (*models.UserActivity, error) {
+	var activity models.UserActivity
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		row := models.UserActivity{
+			UserID:       userID,
+			Date:         date,
+			Timezone:     delta.Timezone,
+			DayStartAt:   delta.DayStart,
+			DayEndAt:     delta.DayEnd,
+			LastSyncedAt: delta.SyncedAt,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&row).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.UserActivity{}).
+			Where("user_id = ? AND date = ?", userID, date).
+			Where("last_sample_end_at IS NULL OR last_sample_end_at <= ?", delta.FirstStart).
+			Updates(map[string]interface{}{
+				"steps":              gorm.Expr("steps + ?", delta.Steps),
+				"calories":           gorm.Expr("calories + ?", delta.Calories),
+				"last_sample_end_at": delta.LastEnd,
+				"last_synced_at":     delta.SyncedAt,
+				"day_start_at":       gorm.Expr("LEAST(day_start_at, ?)", delta.DayStart),
+				"day_end_at":         gorm.Expr("GREATEST(day_end_at, ?)", delta.DayEnd),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrStepSamplesOverlap
+		}
+
+		return tx.Where("user_id = ? AND date = ?", userID, date).First(&activity).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+// FindByUserIDBetween은 유저의 from~to(포함) 날짜 활동 기록을 날짜순으로 조회합니다
+func (r *UserActivityRepository) FindByUserIDBetween(userID uint, from, to string) ([]models.UserActivity, error) {
+	var activities []models.UserActivity
+	err := r.db.
+		Where("user_id = ? AND date >= ? AND date <= ?", userID, from, to).
+		Order("date").
+		Find(&activities).Error
+	return activities, err
+}
+
+// SumByUserIDBetween은 유저의 from~to(포함) 날짜 활동 기록을 집계합니다
+// Postgres와 MySQL에서 모두 동작하도록 표준 집계 함수만 사용하며, 기록이 없으면 0을 반환합니다
+func (r *UserActivityRepository) SumByUserIDBetween(userID uint, from, to string) (*ActivityTotals, error) {
+	var totals ActivityTotals
+	err := r.db.Model(&models.UserActivity{}).
+		Select("COUNT(*) AS active_days, "+
+			"COALESCE(SUM(steps), 0) AS total_steps, "+
+			"COALESCE(SUM(calories), 0) AS total_calories, "+
+			"COALESCE(MAX(steps), 0) AS max_steps, "+
+			"COALESCE(MAX(calories), 0) AS max_calories").
+		Where("user_id = ? AND date >= ? AND date <= ?", userID, from, to).
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return &totals, nil
+}
+
+// FindBestByUserID는 유저의 걸음 수가 가장 많은 날과 소모 칼로리가 가장 많은 날을 조회합니다
+// 같은 기록이면 먼저 달성한 날짜를 반환하며, 기록이 없으면 nil, nil, nil을 반환합니다
+func (r *UserActivityRepository) FindBestByUserID(userID uint) (*models.UserActivity, *models.UserActivity, error) {
+	var bestSteps, bestCalories []models.UserActivity
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("steps DESC, date").
+		Limit(1).
+		Find(&bestSteps).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(bestSteps) == 0 {
+		return nil, nil, nil
+	}
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("calories DESC, date").
+		Limit(1).
+		Find(&bestCalories).Error; err != nil {
+		return nil, nil, err
+	}
+	return &bestSteps[0], &bestCalories[0], nil
+}
+
+// FindDatesWithMinSteps는 유저가 minSteps 이상 걸은 날짜를 날짜순으로 조회합니다 (연속 달성 계산용)
+func (r *UserActivityRepository) FindDatesWithMinSteps(userID uint, minSteps int) ([]string, error) {
+	var dates []string
+	err := r.db.Model(&models.UserActivity{}).
+		Where("user_id = ? AND steps >= ?", userID, minSteps).
+		Order("date").
+		Pluck("date", &dates).Error
+	return dates, err
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InsufficientFundsError는 차감 후 잔액이 음수가 되는 재화가 있을 때 반환됩니다
+type InsufficientFundsError struct {
+	Currency string
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient %s", e.Currency)
+}
+
+// WalletRepository는 플레이어 지갑(재화 잔액)과 재화 원장 데이터 접근을 담당합니다
+// WalletRepositoryInterface를 구현합니다
+type WalletRepository struct {
+	db *gorm.DB
+}
+
+// WalletRepository가 인터페이스를 구현하는지 컴파일 타임에 확인
+var _ WalletRepositoryInterface = (*WalletRepository)(nil)
+
+// NewWalletRepository는 새로운 WalletRepository 인스턴스를 생성합니다
+func NewWalletRepository(db *gorm.DB) *WalletRepository {
+	return &WalletRepository{db: db}
+}
+
+// FindBalances는 플레이어의 재화별 잔액을 조회합니다 (한 번도 받은 적 없는 재화는 포함되지 않음)
+func (r *WalletRepository) FindBalances(playerID uint) ([]models.WalletBalance, error) {
+	var balances []models.WalletBalance
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Find(&balances).Error
+	return balances, err
+}
+
+// FindLedger는 조건에 맞는 재화 원장을 최신순으로 조회하고 전체 개수를 함께 반환합니다
+func (r *WalletRepository) FindLedger(filter WalletLedgerFilter, limit, offset int) ([]models.WalletLedgerEntry, int64, error) {
+	query := r.db.Model(&models.WalletLedgerEntry{})
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+	if filter.Currency != "" {
+		query = query.Where("currency = ?", filter.Currency)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []models.WalletLedgerEntry
+	err := query.
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error
+	return entries, total, err
+}
+
+// FindLedgerByPlayerID는 플레이어의 모든 재화 원장을 오래된 순으로 조회합니다
+func (r *WalletRepository) FindLedgerByPlayerID(playerID uint) ([]models.WalletLedgerEntry, error) {
+	var entries []models.WalletLedgerEntry
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("id").
+		Find(&entries).Error
+	return entries, err
+}
+
+// Apply는 재화 변동을 하나의 트랜잭션으로 반영하고 원장에 기록합니다
+// 하나라도 잔액이 부족하면 아무것도 반영하지 않고 *InsufficientFundsError를 반환합니다
+func (r *WalletRepository) Apply(entries []models.WalletLedgerEntry) ([]models.WalletLedgerEntry, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return applyWalletEntries(tx, entries)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// applyWalletEntries는 트랜잭션 tx 안에서 재화 변동을 잔액에 반영하고 원장에 기록합니다
+// 다른 Repository가 자신의 트랜잭션 안에서 재화를 지급/차감할 때도 이 함수를 사용하므로,
+// 잔액 변경은 항상 원장 기록과 함께 커밋되거나 함께 롤백됩니다
+// entries의 ID, BalanceAfter, CreatedAt이 채워집니다
+func applyWalletEntries(tx *gorm.DB, entries []models.WalletLedgerEntry) error {
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Amount == 0 {
+			continue
+		}
+
+		// 잔액 행이 없으면 0으로 먼저 만들어 두고, 조건부 증감으로 행을 잠가 동시 변경을 순서대로 처리합니다
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.WalletBalance{
+			PlayerID: entry.PlayerID,
+			Currency: entry.Currency,
+		}).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&models.WalletBalance{}).
+			Where("player_id = ? AND currency = ? AND balance + ? >= 0", entry.PlayerID, entry.Currency, entry.Amount).
+			Update("balance", gorm.Expr("balance + ?", entry.Amount))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &InsufficientFundsError{Currency: entry.Currency}
+		}
+
+		if err := tx.Model(&models.WalletBalance{}).
+			Where("player_id = ? AND currency = ?", entry.PlayerID, entry.Currency).
+			Pluck("balance", &entry.BalanceAfter).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(entry).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
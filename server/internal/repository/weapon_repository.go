@@ -1,10 +1,34 @@
 package repository
 
 import (
+	"errors"
 	"game_eating_pizza/internal/models"
+	"strconv"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrWeaponLevelChanged는 강화하려던 무기의 단계가 (동시에 처리된 다른 강화 요청 등으로) 이미 바뀌었거나
+// 무기가 더 이상 해당 플레이어의 소유가 아닐 때 반환됩니다
+var ErrWeaponLevelChanged = errors.New("weapon level changed")
+
+// ErrWeaponPromotionConflict는 승급하려던 무기나 재료 무기가 (동시에 처리된 다른 요청 등으로) 이미 바뀌었을 때 반환됩니다
+var ErrWeaponPromotionConflict = errors.New("weapon or materials changed during promotion")
+
+// ErrWeaponEffectSlotsFull은 새 종류의 특수 효과를 붙일 빈 슬롯이 없을 때 반환됩니다
+var ErrWeaponEffectSlotsFull = errors.New("weapon effect slots full")
+
+// ErrWeaponNotOwned는 대상 무기가 없거나 해당 플레이어의 소유가 아닐 때 반환됩니다
+var ErrWeaponNotOwned = errors.New("weapon not found or not owned by player")
+
+// ErrWeaponDisposalConflict는 판매·분해하려던 무기 중 하나라도 (동시에 처리된 다른 요청 등으로) 이미 없어졌거나
+// 잠겼거나 장착 중일 때 반환됩니다
+var ErrWeaponDisposalConflict = errors.New("weapons changed during disposal")
+
+// ErrWeaponCapacityChanged는 확장하려던 무기 보관 수가 (동시에 처리된 다른 확장 요청 등으로) 이미 바뀌었을 때 반환됩니다
+var ErrWeaponCapacityChanged = errors.New("weapon capacity changed")
+
 // WeaponRepository는 무기 데이터 접근을 담당합니다
 // WeaponRepositoryInterface를 구현합니다
 type WeaponRepository struct {
@@ -24,29 +48,294 @@ func (r *WeaponRepository) Create(weapon *models.Weapon) error {
 	return r.db.Create(weapon).Error
 }
 
-// FindByID는 ID로 무기를 조회합니다
+// FindByID는 ID로 무기를 조회합니다 (특수 효과 포함)
 func (r *WeaponRepository) FindByID(id uint) (*models.Weapon, error) {
 	var weapon models.Weapon
-	err := r.db.First(&weapon, id).Error
+	err := r.db.Preload("Effects").First(&weapon, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &weapon, nil
 }
 
-// FindByPlayerID는 플레이어 ID로 무기 목록을 조회합니다
+// FindByPlayerID는 플레이어 ID로 무기 목록을 조회합니다 (특수 효과 포함)
 func (r *WeaponRepository) FindByPlayerID(playerID uint) ([]models.Weapon, error) {
 	var weapons []models.Weapon
-	err := r.db.Where("player_id = ?", playerID).Find(&weapons).Error
+	err := r.db.Preload("Effects").Where("player_id = ?", playerID).Find(&weapons).Error
 	return weapons, err
 }
 
+// CountByPlayerID는 플레이어가 보유한 무기 수를 조회합니다
+func (r *WeaponRepository) CountByPlayerID(playerID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Weapon{}).Where("player_id = ?", playerID).Count(&count).Error
+	return count, err
+}
+
 // Update는 무기 정보를 업데이트합니다
 func (r *WeaponRepository) Update(weapon *models.Weapon) error {
 	return r.db.Save(weapon).Error
 }
 
-// Delete는 무기를 삭제합니다
+// Upgrade는 playerID 소유의 무기를 fromLevel에서 한 단계 올리고 공격력을 attackPowerGain만큼 높이는 작업과
+// 골드 cost 차감을 하나의 트랜잭션으로 처리합니다
+// 조건부 UPDATE로 처리하므로 같은 무기를 동시에 강화해도 한 요청만 성공하고 비용도 한 번만 차감됩니다
+func (r *WeaponRepository) Upgrade(weaponID, playerID uint, fromLevel, attackPowerGain int, cost int64) (*models.Weapon, error) {
+	var weapon models.Weapon
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Weapon{}).
+			Where("id = ? AND player_id = ? AND level = ?", weaponID, playerID, fromLevel).
+			Updates(map[string]interface{}{
+				"level":        gorm.Expr("level + 1"),
+				"attack_power": gorm.Expr("attack_power + ?", attackPowerGain),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrWeaponLevelChanged
+		}
+
+		if err := applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: playerID,
+			Currency: models.CurrencyGold,
+			Amount:   -cost,
+			Reason:   models.LedgerReasonWeaponUpgrade,
+			RefType:  "weapon",
+			RefID:    strconv.FormatUint(uint64(weaponID), 10),
+		}}); err != nil {
+			return err
+		}
+
+		return tx.Preload("Effects").First(&weapon, weaponID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &weapon, nil
+}
+
+// Promote는 무기 등급 승급 시도를 하나의 트랜잭션으로 반영합니다
+// 재료 무기 삭제, 골드 차감, 결과(성공 시 등급·공격력 변경과 실패 횟수 초기화, 실패 시 실패 횟수 증가)를 함께 처리하며
+// 무기의 등급이나 실패 횟수가 시도 시점과 다르거나, 재료 무기 중 하나라도 조건에 맞지 않으면(다른 소유자, 다른 종류·등급, 장착 중)
+// 아무것도 반영하지 않고 ErrWeaponPromotionConflict를 반환합니다
+func (r *WeaponRepository) Promote(promotion WeaponPromotion) (*models.Weapon, error) {
+	var weapon models.Weapon
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND player_id = ?", promotion.WeaponID, promotion.PlayerID).
+			First(&weapon).Error; err != nil {
+			return ErrWeaponPromotionConflict
+		}
+		if weapon.Rarity != promotion.FromRarity || weapon.PromotionFailures != promotion.FromFailures {
+			return ErrWeaponPromotionConflict
+		}
+
+		if len(promotion.MaterialIDs) > 0 {
+			if err := detachWeapons(tx, promotion.MaterialIDs); err != nil {
+				return err
+			}
+			result := tx.
+				Where("id IN ? AND id <> ? AND player_id = ? AND type = ? AND rarity = ? AND locked = ?",
+					promotion.MaterialIDs, weapon.ID, promotion.PlayerID, weapon.Type, promotion.FromRarity, false).
+				Where("id NOT IN (?)", equippedWeaponIDs(tx, promotion.PlayerID)).
+				Delete(&models.Weapon{})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected != int64(len(promotion.MaterialIDs)) {
+				return ErrWeaponPromotionConflict
+			}
+		}
+
+		if err := applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: promotion.PlayerID,
+			Currency: models.CurrencyGold,
+			Amount:   -promotion.GoldCost,
+			Reason:   models.LedgerReasonWeaponPromotion,
+			RefType:  "weapon",
+			RefID:    strconv.FormatUint(uint64(weapon.ID), 10),
+		}}); err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"promotion_failures": gorm.Expr("promotion_failures + 1"),
+		}
+		if promotion.Success {
+			updates = map[string]interface{}{
+				"rarity":             promotion.ToRarity,
+				"attack_power":       gorm.Expr("ROUND(attack_power * ?)", promotion.StatRatio),
+				"promotion_failures": 0,
+			}
+		}
+		if err := tx.Model(&weapon).Updates(updates).Error; err != nil {
+			return err
+		}
+		return tx.Preload("Effects").First(&weapon, weapon.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &weapon, nil
+}
+
+// Enchant는 playerID 소유 무기(effect.WeaponID)에 특수 효과를 붙이는 작업과 불씨 cost 차감을 하나의 트랜잭션으로 처리합니다
+// 같은 종류의 효과가 이미 있으면 효과량과 발동 확률을 새 값으로 바꾸고(슬롯을 더 쓰지 않음),
+// 없으면 무기에 붙은 효과가 maxEffects개 미만일 때만 새로 붙입니다
+// 무기 행을 잠그고 처리하므로 같은 무기에 동시에 효과를 붙여도 슬롯 수를 넘지 않습니다
+func (r *WeaponRepository) Enchant(playerID uint, effect models.WeaponEffect, maxEffects int, cost int64) (*models.Weapon, error) {
+	var weapon models.Weapon
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND player_id = ?", effect.WeaponID, playerID).
+			First(&weapon).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrWeaponNotOwned
+			}
+			return err
+		}
+
+		var effects []models.WeaponEffect
+		if err := tx.Where("weapon_id = ?", weapon.ID).Find(&effects).Error; err != nil {
+			return err
+		}
+		existing := -1
+		for i := range effects {
+			if effects[i].Type == effect.Type {
+				existing = i
+				break
+			}
+		}
+
+		if existing >= 0 {
+			if err := tx.Model(&effects[existing]).Updates(map[string]interface{}{
+				"magnitude":   effect.Magnitude,
+				"proc_chance": effect.ProcChance,
+			}).Error; err != nil {
+				return err
+			}
+		} else {
+			if len(effects) >= maxEffects {
+				return ErrWeaponEffectSlotsFull
+			}
+			effect.ID = 0
+			if err := tx.Create(&effect).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: playerID,
+			Currency: models.CurrencyEmbers,
+			Amount:   -cost,
+			Reason:   models.LedgerReasonWeaponEnchant,
+			RefType:  "weapon",
+			RefID:    strconv.FormatUint(uint64(weapon.ID), 10),
+			Note:     effect.Type,
+		}}); err != nil {
+			return err
+		}
+
+		return tx.Preload("Effects").First(&weapon, weapon.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &weapon, nil
+}
+
+// SetLocked는 playerID 소유 무기의 잠금 여부를 바꿉니다
+func (r *WeaponRepository) SetLocked(weaponID, playerID uint, locked bool) (*models.Weapon, error) {
+	if err := r.db.Model(&models.Weapon{}).
+		Where("id = ? AND player_id = ?", weaponID, playerID).
+		Update("locked", locked).Error; err != nil {
+		return nil, err
+	}
+
+	var weapon models.Weapon
+	err := r.db.Preload("Effects").Where("id = ? AND player_id = ?", weaponID, playerID).First(&weapon).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrWeaponNotOwned
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &weapon, nil
+}
+
+// Dispose는 playerID 소유 무기들을 삭제(판매·분해)하는 작업과 보상 지급을 하나의 트랜잭션으로 처리합니다
+// 무기 중 하나라도 없거나, 다른 플레이어의 무기이거나, 잠겼거나, 장착 중이면 아무것도 반영하지 않고
+// ErrWeaponDisposalConflict를 반환합니다
+func (r *WeaponRepository) Dispose(playerID uint, weaponIDs []uint, rewards []models.WalletLedgerEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := detachWeapons(tx, weaponIDs); err != nil {
+			return err
+		}
+		result := tx.
+			Where("id IN ? AND player_id = ? AND locked = ?", weaponIDs, playerID, false).
+			Where("id NOT IN (?)", equippedWeaponIDs(tx, playerID)).
+			Delete(&models.Weapon{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(weaponIDs)) {
+			return ErrWeaponDisposalConflict
+		}
+
+		return applyWalletEntries(tx, rewards)
+	})
+}
+
+// ExpandCapacity는 플레이어의 무기 보관 수를 fromBonus에서 step만큼 늘리는 작업과 보석 cost 차감을 하나의 트랜잭션으로 처리합니다
+// 조건부 UPDATE로 처리하므로 동시에 확장해도 한 요청만 성공하고 비용도 한 번만 차감됩니다
+func (r *WeaponRepository) ExpandCapacity(playerID uint, fromBonus, step int, cost int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Player{}).
+			Where("id = ? AND weapon_capacity_bonus = ?", playerID, fromBonus).
+			Update("weapon_capacity_bonus", gorm.Expr("weapon_capacity_bonus + ?", step))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrWeaponCapacityChanged
+		}
+
+		return applyWalletEntries(tx, []models.WalletLedgerEntry{{
+			PlayerID: playerID,
+			Currency: models.CurrencyGems,
+			Amount:   -cost,
+			Reason:   models.LedgerReasonInventoryExpand,
+			RefType:  "player",
+			RefID:    strconv.FormatUint(uint64(playerID), 10),
+		}})
+	})
+}
+
+// Delete는 무기와 무기에 붙은 특수 효과를 삭제합니다
 func (r *WeaponRepository) Delete(id uint) error {
-	return r.db.Delete(&models.Weapon{}, id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := detachWeapons(tx, []uint{id}); err != nil {
+			return err
+		}
+		return tx.Delete(&models.Weapon{}, id).Error
+	})
+}
+
+// equippedWeaponIDs는 플레이어가 장착 중인 무기 ID를 고르는 서브쿼리입니다 (장착한 무기가 없으면 빈 결과)
+func equippedWeaponIDs(tx *gorm.DB, playerID uint) *gorm.DB {
+	return tx.Model(&models.Player{}).
+		Select("current_weapon_id").
+		Where("id = ? AND current_weapon_id IS NOT NULL", playerID)
+}
+
+// detachWeapons는 무기를 삭제하기 전에 무기를 참조하는 데이터를 정리합니다
+// 무기에 붙은 특수 효과는 삭제하고, 제작 작업의 결과 무기 참조는 해제합니다 (제작 이력은 남김)
+func detachWeapons(tx *gorm.DB, weaponIDs []uint) error {
+	if err := tx.Where("weapon_id IN ?", weaponIDs).Delete(&models.WeaponEffect{}).Error; err != nil {
+		return err
+	}
+	return tx.Model(&models.ForgeJob{}).
+		Where("weapon_id IN ?", weaponIDs).
+		Update("weapon_id", nil).Error
 }
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrDeletionAlreadyScheduled는 이미 삭제가 예약된 계정에 다시 삭제를 요청했을 때 반환됩니다
+var ErrDeletionAlreadyScheduled = errors.New("account deletion already scheduled")
+
+// AccountService는 계정 삭제와 개인 데이터 내보내기 비즈니스 로직을 담당합니다
+type AccountService struct {
+	playerRepo          repository.PlayerRepositoryInterface
+	sessionRepo         repository.SessionRepositoryInterface
+	userActivityRepo    repository.UserActivityRepositoryInterface
+	raidParticipantRepo repository.RaidParticipantRepositoryInterface
+	suspensionRepo      repository.SuspensionRepositoryInterface
+	rejectedStepRepo    repository.RejectedStepSampleRepositoryInterface
+	stepGoalClaimRepo   repository.StepGoalClaimRepositoryInterface
+	walletRepo          repository.WalletRepositoryInterface
+	upgradeRepo         repository.PlayerUpgradeRepositoryInterface
+	inventoryRepo       repository.InventoryRepositoryInterface
+	progressionRepo     repository.ProgressionRepositoryInterface
+	forgeJobRepo        repository.ForgeJobRepositoryInterface
+	contentStore        *content.Store
+	authService         *AuthService
+	loginGuard          *LoginGuard
+	cfg                 *config.Config
+}
+
+// NewAccountService는 새로운 AccountService 인스턴스를 생성합니다
+func NewAccountService(
+	playerRepo repository.PlayerRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
+	userActivityRepo repository.UserActivityRepositoryInterface,
+	raidParticipantRepo repository.RaidParticipantRepositoryInterface,
+	suspensionRepo repository.SuspensionRepositoryInterface,
+	rejectedStepRepo repository.RejectedStepSampleRepositoryInterface,
+	stepGoalClaimRepo repository.StepGoalClaimRepositoryInterface,
+	walletRepo repository.WalletRepositoryInterface,
+	upgradeRepo repository.PlayerUpgradeRepositoryInterface,
+	inventoryRepo repository.InventoryRepositoryInterface,
+	progressionRepo repository.ProgressionRepositoryInterface,
+	forgeJobRepo repository.ForgeJobRepositoryInterface,
+	contentStore *content.Store,
+	authService *AuthService,
+	loginGuard *LoginGuard,
+	cfg *config.Config,
+) *AccountService {
+	return &AccountService{
+		playerRepo:          playerRepo,
+		sessionRepo:         sessionRepo,
+		userActivityRepo:    userActivityRepo,
+		raidParticipantRepo: raidParticipantRepo,
+		suspensionRepo:      suspensionRepo,
+		rejectedStepRepo:    rejectedStepRepo,
+		stepGoalClaimRepo:   stepGoalClaimRepo,
+		walletRepo:          walletRepo,
+		upgradeRepo:         upgradeRepo,
+		inventoryRepo:       inventoryRepo,
+		progressionRepo:     progressionRepo,
+		forgeJobRepo:        forgeJobRepo,
+		contentStore:        contentStore,
+		authService:         authService,
+		loginGuard:          loginGuard,
+		cfg:                 cfg,
+	}
+}
+
+// PlayerDataArchive는 플레이어에 대해 저장하고 있는 모든 데이터입니다 (개인 데이터 내보내기용)
+type PlayerDataArchive struct {
+	Player             *models.Player
+	Weapons            []models.Weapon
+	Sessions           []models.Session
+	Activities         []models.UserActivity
+	RaidParticipations []models.RaidParticipant
+	Suspensions        []models.Suspension
+	RejectedSteps      []models.RejectedStepSample
+	StepGoalClaims     []models.StepGoalClaim
+	Wallet             []models.WalletBalance
+	WalletLedger       []models.WalletLedgerEntry
+	Upgrades           []models.PlayerUpgrade
+	Inventory          []InventoryEntry
+	DungeonUnlocks     []models.DungeonUnlock
+	ForgeJobs          []models.ForgeJob
+	ExportedAt         time.Time
+}
+
+// RequestDeletion은 계정 삭제를 예약하고 모든 기기에서 로그아웃시킵니다
+// 유예 기간(AccountDeletionGraceDays) 동안 다시 로그인하면 삭제가 취소되고,
+// 유예 기간이 지나면 배치 서버가 관련 데이터와 함께 완전 삭제합니다
+// 정식 계정은 비밀번호 확인이 필요하며, 게스트 계정은 password를 무시합니다
+// 비밀번호 확인은 로그인과 같은 기준(LoginGuard)으로 실패 횟수를 제한합니다
+func (s *AccountService) RequestDeletion(playerID uint, password, ip string) (time.Time, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return time.Time{}, ErrPlayerNotFound
+	}
+	if player.IsDeletionPending() {
+		return time.Time{}, ErrDeletionAlreadyScheduled
+	}
+	if !player.IsGuest {
+		// 탈취된 액세스 토큰으로 비밀번호를 대입하는 것도 로그인과 같은 기준으로 제한합니다
+		if err := s.loginGuard.Check(player.Username, ip); err != nil {
+			return time.Time{}, err
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(player.Password), []byte(password)); err != nil {
+			if err := s.loginGuard.RecordFailure(player.Username, ip); err != nil {
+				return time.Time{}, err
+			}
+			return time.Time{}, ErrIncorrectPassword
+		}
+		if err := s.loginGuard.RecordSuccess(player.Username); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	scheduledAt := time.Now().AddDate(0, 0, s.cfg.AccountDeletionGraceDays)
+	if err := s.playerRepo.SetDeletionSchedule(player.ID, &scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := s.authService.LogoutAll(player.ID); err != nil {
+		return time.Time{}, err
+	}
+	return scheduledAt, nil
+}
+
+// ExportData는 플레이어에 대해 저장하고 있는 모든 데이터를 모아 반환합니다
+func (s *AccountService) ExportData(playerID uint) (*PlayerDataArchive, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	sessions, err := s.sessionRepo.FindAllByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := s.userActivityRepo.FindByUserID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	raidParticipations, err := s.raidParticipantRepo.FindByUserID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	suspensions, err := s.suspensionRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	rejectedSteps, err := s.rejectedStepRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	stepGoalClaims, err := s.stepGoalClaimRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	walletLedger, err := s.walletRepo.FindLedgerByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	upgrades, err := s.upgradeRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	inventory, err := s.inventoryRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	dungeonUnlocks, err := s.progressionRepo.FindDungeonUnlocksByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	forgeJobs, err := s.forgeJobRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlayerDataArchive{
+		Player:             player,
+		Weapons:            player.Weapons,
+		Sessions:           sessions,
+		Activities:         activities,
+		RaidParticipations: raidParticipations,
+		Suspensions:        suspensions,
+		RejectedSteps:      rejectedSteps,
+		StepGoalClaims:     stepGoalClaims,
+		Wallet:             walletBalances(playerID, player.Wallet),
+		WalletLedger:       walletLedger,
+		Upgrades:           upgrades,
+		Inventory:          inventoryEntries(s.contentStore.Current(), inventory),
+		DungeonUnlocks:     dungeonUnlocks,
+		ForgeJobs:          forgeJobs,
+		ExportedAt:         time.Now(),
+	}, nil
+}
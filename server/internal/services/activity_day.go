@@ -0,0 +1,135 @@
+package services
+
+import (
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"time"
+)
+
+// activityDay는 하루치 활동 기록과 요청 처리 중의 검증 상태입니다
+type activityDay struct {
+	date      string
+	timezone  string
+	start     time.Time  // 담당 구간 시작 (포함)
+	end       time.Time  // 담당 구간 끝 (미포함)
+	watermark *time.Time // 이미 반영된 마지막 샘플 종료 시각
+	steps     int        // 기존 걸음 수 + 이번 요청에서 통과한 걸음 수
+	delta     repository.StepDelta
+	accepted  bool
+}
+
+// newActivityDay는 저장된 활동 기록으로 activityDay를 만듭니다
+func newActivityDay(activity *models.UserActivity) *activityDay {
+	return &activityDay{
+		date:      activity.Date,
+		timezone:  activity.Timezone,
+		start:     activity.DayStartAt,
+		end:       activity.DayEndAt,
+		watermark: activity.LastSampleEndAt,
+		steps:     activity.Steps,
+	}
+}
+
+// contains는 시각 t가 담당 구간에 속하는지 확인합니다
+func (d *activityDay) contains(t time.Time) bool {
+	return !t.Before(d.start) && t.Before(d.end)
+}
+
+// activityDayResolver는 플레이어 시간대 기준으로 시각이 속한 활동 날짜를 결정합니다
+//
+// 기록마다 담당 구간을 두고 구간끼리 겹치지 않게 하므로, 시간대를 바꾼 여행자라도
+// 같은 시간이 두 날짜에 집계되지 않고 날짜는 시간순으로만 증가합니다
+//   - 서쪽으로 이동해 현지 날짜가 되돌아가면 새 날짜를 열지 않고 직전 기록의 구간을 늘립니다
+//   - 동쪽으로 이동하면 직전 기록이 끝난 시점부터 새 날짜가 시작되어 그날은 짧아집니다
+type activityDayResolver struct {
+	repo     repository.UserActivityRepositoryInterface
+	playerID uint
+	loc      *time.Location
+	days     []*activityDay // 이번 요청에서 불러오거나 새로 연 날짜
+}
+
+// newActivityDayResolver는 플레이어의 현재 시간대로 날짜를 결정하는 resolver를 생성합니다
+func newActivityDayResolver(repo repository.UserActivityRepositoryInterface, player *models.Player) *activityDayResolver {
+	return &activityDayResolver{
+		repo:     repo,
+		playerID: player.ID,
+		loc:      player.Location(),
+	}
+}
+
+// resolve는 시각 t가 속한 날짜를 반환합니다
+// 저장된 기록이 없는 시각이면 앞뒤 기록과 겹치지 않는 구간으로 날짜를 새로 엽니다 (저장은 호출자가 수행)
+func (r *activityDayResolver) resolve(t time.Time) (*activityDay, error) {
+	for _, day := range r.days {
+		if day.contains(t) {
+			return day, nil
+		}
+	}
+
+	activity, err := r.repo.FindByUserIDAt(r.playerID, t)
+	if err != nil {
+		return nil, err
+	}
+	if activity != nil {
+		return r.cached(activity), nil
+	}
+
+	prevActivity, nextActivity, err := r.repo.FindNeighbors(r.playerID, t)
+	if err != nil {
+		return nil, err
+	}
+	prev, next := r.cached(prevActivity), r.cached(nextActivity)
+	for _, day := range r.days {
+		if !day.end.After(t) && (prev == nil || day.end.After(prev.end)) {
+			prev = day
+		}
+		if day.start.After(t) && (next == nil || day.start.Before(next.start)) {
+			next = day
+		}
+	}
+
+	local := t.In(r.loc)
+	date := local.Format(models.ActivityDateLayout)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, r.loc)
+	end := start.AddDate(0, 0, 1)
+	if prev != nil && prev.end.After(start) {
+		start = prev.end
+	}
+	if next != nil && next.start.Before(end) {
+		end = next.start
+	}
+
+	switch {
+	case prev != nil && prev.date >= date:
+		// 현지 날짜가 되돌아간 경우 이미 지난 날짜를 다시 열지 않습니다
+		prev.end = end
+		return prev, nil
+	case next != nil && next.date <= date:
+		next.start = start
+		return next, nil
+	}
+
+	day := &activityDay{
+		date:     date,
+		timezone: r.loc.String(),
+		start:    start,
+		end:      end,
+	}
+	r.days = append(r.days, day)
+	return day, nil
+}
+
+// cached는 저장된 기록에 해당하는 activityDay를 반환합니다 (이미 불러온 날짜면 처리 중인 상태를 재사용)
+func (r *activityDayResolver) cached(activity *models.UserActivity) *activityDay {
+	if activity == nil {
+		return nil
+	}
+	for _, day := range r.days {
+		if day.date == activity.Date {
+			return day
+		}
+	}
+	day := newActivityDay(activity)
+	r.days = append(r.days, day)
+	return day
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/models"
+	"time"
+)
+
+// StepGoalStatus는 하루 걸음 수 목표 한 단계의 달성/수령 상태입니다
+type StepGoalStatus struct {
+	Tier      config.StepGoalTier
+	Reached   bool
+	Claimed   bool
+	ClaimedAt *time.Time
+}
+
+// StepGoalDay는 하루의 걸음 수와 목표 단계별 상태입니다
+type StepGoalDay struct {
+	Date     string
+	Timezone string
+	Steps    int
+	Goals    []StepGoalStatus
+}
+
+// StepGoalClaimResult는 오늘 목표 보상 수령 결과입니다
+type StepGoalClaimResult struct {
+	Day     StepGoalDay
+	Claimed []models.StepGoalClaim // 이번 요청으로 새로 수령한 목표 (이미 모두 수령했으면 비어 있음)
+	Gold    int64                  // 이번 요청으로 지급한 골드 합계
+	Embers  int64                  // 이번 요청으로 지급한 활력의 불씨 합계
+}
+
+// GetTodayGoals는 플레이어 시간대 기준 오늘의 걸음 수와 목표 단계별 달성/수령 상태를 조회합니다
+func (s *ActivityService) GetTodayGoals(playerID uint) (*StepGoalDay, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	return s.todayGoals(player, time.Now())
+}
+
+// ClaimTodayGoals는 오늘 달성했지만 아직 받지 않은 목표 보상을 모두 수령합니다
+// 목표 단계마다 하루에 한 번만 지급되므로 같은 요청을 반복해도 보상이 중복 지급되지 않습니다
+// 지난 날짜의 보상은 수령할 수 없습니다
+func (s *ActivityService) ClaimTodayGoals(playerID uint) (*StepGoalClaimResult, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	now := time.Now()
+	day, err := s.todayGoals(player, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []models.StepGoalClaim
+	for _, goal := range day.Goals {
+		if !goal.Reached || goal.Claimed {
+			continue
+		}
+		pending = append(pending, models.StepGoalClaim{
+			PlayerID:  playerID,
+			Date:      day.Date,
+			GoalSteps: goal.Tier.Steps,
+			Steps:     day.Steps,
+			Gold:      goal.Tier.Gold,
+			Embers:    goal.Tier.Embers,
+			ClaimedAt: now,
+		})
+	}
+
+	result := &StepGoalClaimResult{Claimed: []models.StepGoalClaim{}}
+	if len(pending) > 0 {
+		claimed, err := s.stepGoalClaimRepo.Claim(playerID, pending)
+		if err != nil {
+			return nil, err
+		}
+		for _, claim := range claimed {
+			result.Gold += claim.Gold
+			result.Embers += claim.Embers
+		}
+		if claimed != nil {
+			result.Claimed = claimed
+		}
+
+		// 동시에 들어온 다른 요청이 먼저 수령했을 수 있으므로 저장된 기록으로 상태를 다시 계산합니다
+		if day, err = s.todayGoals(player, now); err != nil {
+			return nil, err
+		}
+	}
+	result.Day = *day
+	return result, nil
+}
+
+// GetGoalHistory는 걸음 수 기록이 있는 최근 days일의 목표 달성/수령 상태를 최신 날짜부터 조회합니다
+func (s *ActivityService) GetGoalHistory(playerID uint, days int) ([]StepGoalDay, error) {
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	activities, err := s.userActivityRepo.FindRecentByUserID(playerID, days)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]string, len(activities))
+	for i, activity := range activities {
+		dates[i] = activity.Date
+	}
+	claims, err := s.stepGoalClaimRepo.FindByPlayerIDAndDates(playerID, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]StepGoalDay, len(activities))
+	for i, activity := range activities {
+		history[i] = s.newStepGoalDay(activity.Date, activity.Timezone, activity.Steps, claims)
+	}
+	return history, nil
+}
+
+// todayGoals는 플레이어 시간대 기준으로 now가 속한 날짜의 목표 상태를 계산합니다
+func (s *ActivityService) todayGoals(player *models.Player, now time.Time) (*StepGoalDay, error) {
+	date, steps, err := currentActivityDay(s.userActivityRepo, player, now)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.stepGoalClaimRepo.FindByPlayerIDAndDates(player.ID, []string{date})
+	if err != nil {
+		return nil, err
+	}
+	day := s.newStepGoalDay(date, player.Timezone, steps, claims)
+	return &day, nil
+}
+
+// newStepGoalDay는 설정된 목표 단계마다 걸음 수 달성 여부와 수령 기록을 채운 StepGoalDay를 만듭니다
+// 설정이 바뀌어 현재 목표에 없는 단계의 수령 기록은 무시합니다
+func (s *ActivityService) newStepGoalDay(date, timezone string, steps int, claims []models.StepGoalClaim) StepGoalDay {
+	day := StepGoalDay{
+		Date:     date,
+		Timezone: timezone,
+		Steps:    steps,
+		Goals:    make([]StepGoalStatus, len(s.cfg.StepGoalTiers)),
+	}
+	for i, tier := range s.cfg.StepGoalTiers {
+		goal := StepGoalStatus{Tier: tier, Reached: steps >= tier.Steps}
+		for _, claim := range claims {
+			if claim.Date == date && claim.GoalSteps == tier.Steps {
+				claimedAt := claim.ClaimedAt
+				goal.Claimed = true
+				goal.ClaimedAt = &claimedAt
+				break
+			}
+		}
+		day.Goals[i] = goal
+	}
+	return day
+}
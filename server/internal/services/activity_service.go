@@ -0,0 +1,247 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"sort"
+	"time"
+)
+
+// stepSampleClockSkew는 기기와 서버의 시계 차이로 허용하는 미래 시각 여유입니다
+const stepSampleClockSkew = 5 * time.Minute
+
+var (
+	// ErrNoStepSamples는 샘플 없이 동기화를 요청했을 때 반환됩니다
+	ErrNoStepSamples = errors.New("no step samples")
+	// ErrStepSyncConflict는 같은 구간의 샘플이 다른 요청에서 동시에 반영되었을 때 반환됩니다 (다시 동기화하면 중복 샘플은 건너뜀)
+	ErrStepSyncConflict = errors.New("step samples were applied by a concurrent sync")
+	// ErrInvalidTimezone은 IANA 시간대 이름이 아닐 때 반환됩니다
+	ErrInvalidTimezone = errors.New("invalid timezone")
+)
+
+// TooManyStepSamplesError는 요청 한 번에 허용된 샘플 수를 넘었을 때 반환됩니다
+type TooManyStepSamplesError struct {
+	Max int
+}
+
+func (e *TooManyStepSamplesError) Error() string {
+	return fmt.Sprintf("too many step samples, at most %d per sync", e.Max)
+}
+
+// StepSample은 기기(휴대폰/워치)가 측정한 구간별 걸음 수입니다
+type StepSample struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+	Steps     int
+	Calories  float64
+}
+
+// RejectedStepSampleResult는 동기화 요청에서 거부된 샘플과 거부 사유입니다
+type RejectedStepSampleResult struct {
+	Index  int // 요청 내 샘플 순서 (0부터)
+	Sample StepSample
+	Reason string
+}
+
+// StepSyncResult는 걸음 수 동기화 결과입니다
+type StepSyncResult struct {
+	AcceptedSamples  int
+	AcceptedSteps    int
+	DuplicateSamples int // 이미 반영된 구간이라 건너뛴 샘플 수 (재전송)
+	Rejected         []RejectedStepSampleResult
+	Days             []models.UserActivity // 이번 동기화로 갱신된 날짜별 기록
+}
+
+// ActivityService는 걸음 수 동기화와 부정 행위 검증, 일일 걸음 수 목표 보상 비즈니스 로직을 담당합니다
+type ActivityService struct {
+	playerRepo        repository.PlayerRepositoryInterface
+	userActivityRepo  repository.UserActivityRepositoryInterface
+	rejectedStepRepo  repository.RejectedStepSampleRepositoryInterface
+	stepGoalClaimRepo repository.StepGoalClaimRepositoryInterface
+	cfg               *config.Config
+}
+
+// NewActivityService는 새로운 ActivityService 인스턴스를 생성합니다
+func NewActivityService(
+	playerRepo repository.PlayerRepositoryInterface,
+	userActivityRepo repository.UserActivityRepositoryInterface,
+	rejectedStepRepo repository.RejectedStepSampleRepositoryInterface,
+	stepGoalClaimRepo repository.StepGoalClaimRepositoryInterface,
+	cfg *config.Config,
+) *ActivityService {
+	return &ActivityService{
+		playerRepo:        playerRepo,
+		userActivityRepo:  userActivityRepo,
+		rejectedStepRepo:  rejectedStepRepo,
+		stepGoalClaimRepo: stepGoalClaimRepo,
+		cfg:               cfg,
+	}
+}
+
+// SyncSteps는 기기가 보낸 걸음 수 샘플을 검증하여 날짜별 활동 기록에 더합니다
+// 날짜는 플레이어 시간대 기준이며, timezone을 보내면 (여행 등으로 바뀐 경우) 플레이어 시간대를 먼저 갱신합니다
+// 샘플은 시간순이어야 하며, 물리적으로 불가능한 샘플은 반영하지 않고 검토용으로 기록합니다
+// 이미 반영된 구간의 샘플(재전송)은 거부하지 않고 건너뜁니다
+func (s *ActivityService) SyncSteps(playerID uint, source, timezone string, samples []StepSample) (*StepSyncResult, error) {
+	if len(samples) == 0 {
+		return nil, ErrNoStepSamples
+	}
+	if len(samples) > s.cfg.ActivityMaxSamplesPerSync {
+		return nil, &TooManyStepSamplesError{Max: s.cfg.ActivityMaxSamplesPerSync}
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if timezone != "" && timezone != player.Timezone {
+		if !models.IsValidTimezone(timezone) {
+			return nil, ErrInvalidTimezone
+		}
+		player.Timezone = timezone
+		if err := s.playerRepo.UpdateProfile(player); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	oldest := now.AddDate(0, 0, -s.cfg.ActivitySyncWindowDays)
+	resolver := newActivityDayResolver(s.userActivityRepo, player)
+	result := &StepSyncResult{}
+	var prevEnd time.Time
+
+	for i, sample := range samples {
+		reason := ""
+		var day *activityDay
+
+		switch {
+		case !sample.EndedAt.After(sample.StartedAt) || sample.Steps < 0 || sample.Calories < 0:
+			reason = models.StepRejectInvalid
+		case sample.EndedAt.After(now.Add(stepSampleClockSkew)):
+			reason = models.StepRejectFuture
+		case sample.StartedAt.Before(oldest):
+			reason = models.StepRejectTooOld
+		case sample.StartedAt.Before(prevEnd):
+			reason = models.StepRejectNonMonotonic
+		}
+
+		if reason == "" {
+			day, err = resolver.resolve(sample.StartedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case sample.EndedAt.After(day.end):
+				reason = models.StepRejectSpansDays
+			case day.watermark != nil && !sample.EndedAt.After(*day.watermark):
+				result.DuplicateSamples++
+				prevEnd = sample.EndedAt
+				continue
+			default:
+				reason = s.validateRate(day, sample)
+			}
+		}
+
+		if reason != "" {
+			result.Rejected = append(result.Rejected, RejectedStepSampleResult{
+				Index:  i,
+				Sample: sample,
+				Reason: reason,
+			})
+			continue
+		}
+
+		if !day.accepted {
+			day.delta.FirstStart = sample.StartedAt
+			day.accepted = true
+		}
+		day.delta.Steps += sample.Steps
+		day.delta.Calories += sample.Calories
+		day.delta.LastEnd = sample.EndedAt
+		day.steps += sample.Steps
+		prevEnd = sample.EndedAt
+
+		result.AcceptedSamples++
+		result.AcceptedSteps += sample.Steps
+	}
+
+	if err := s.recordRejections(playerID, source, result.Rejected); err != nil {
+		return nil, err
+	}
+
+	days := make([]*activityDay, 0, len(resolver.days))
+	for _, day := range resolver.days {
+		if day.accepted {
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].start.Before(days[j].start)
+	})
+
+	for _, day := range days {
+		delta := day.delta
+		delta.SyncedAt = now
+		delta.Timezone = day.timezone
+		delta.DayStart = day.start
+		delta.DayEnd = day.end
+		activity, err := s.userActivityRepo.AddSteps(playerID, day.date, delta)
+		if errors.Is(err, repository.ErrStepSamplesOverlap) {
+			return nil, ErrStepSyncConflict
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Days = append(result.Days, *activity)
+	}
+	return result, nil
+}
+
+// validateRate는 이미 반영된 구간과의 겹침, 초당 걸음 수, 하루 최대 걸음 수를 검사하고 거부 사유를 반환합니다
+func (s *ActivityService) validateRate(day *activityDay, sample StepSample) string {
+	if day.watermark != nil && sample.StartedAt.Before(*day.watermark) {
+		return models.StepRejectOverlap
+	}
+	seconds := sample.EndedAt.Sub(sample.StartedAt).Seconds()
+	if float64(sample.Steps) > seconds*float64(s.cfg.ActivityMaxStepsPerSecond) {
+		return models.StepRejectRateExceeded
+	}
+	if day.steps+sample.Steps > s.cfg.ActivityMaxDailySteps {
+		return models.StepRejectDailyLimit
+	}
+	return ""
+}
+
+// recordRejections는 거부된 샘플을 검토용으로 저장합니다
+func (s *ActivityService) recordRejections(playerID uint, source string, rejected []RejectedStepSampleResult) error {
+	if len(rejected) == 0 {
+		return nil
+	}
+
+	records := make([]models.RejectedStepSample, len(rejected))
+	for i, r := range rejected {
+		records[i] = models.RejectedStepSample{
+			PlayerID:  playerID,
+			Source:    source,
+			StartedAt: r.Sample.StartedAt,
+			EndedAt:   r.Sample.EndedAt,
+			Steps:     r.Sample.Steps,
+			Reason:    r.Reason,
+		}
+	}
+	return s.rejectedStepRepo.CreateBatch(records)
+}
+
+// currentActivityDay는 플레이어 시간대 기준으로 now가 속한 날짜와 그날의 걸음 수를 반환합니다
+// 아직 기록이 없는 날이면 걸음 수는 0입니다
+func currentActivityDay(repo repository.UserActivityRepositoryInterface, player *models.Player, now time.Time) (string, int, error) {
+	day, err := newActivityDayResolver(repo, player).resolve(now)
+	if err != nil {
+		return "", 0, err
+	}
+	return day.date, day.steps, nil
+}
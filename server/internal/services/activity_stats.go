@@ -0,0 +1,176 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"time"
+)
+
+// 활동 통계 조회 범위 (오늘을 포함한 최근 N일)
+const (
+	ActivityStatsRangeWeek  = "week"
+	ActivityStatsRangeMonth = "month"
+	ActivityStatsRangeYear  = "year"
+)
+
+// activityStatsRangeDays는 조회 범위별 날짜 수입니다
+var activityStatsRangeDays = map[string]int{
+	ActivityStatsRangeWeek:  7,
+	ActivityStatsRangeMonth: 30,
+	ActivityStatsRangeYear:  365,
+}
+
+// ErrInvalidStatsRange는 지원하지 않는 통계 조회 범위일 때 반환됩니다
+var ErrInvalidStatsRange = errors.New("invalid activity stats range")
+
+// ActivityStatsDay는 하루의 걸음 수와 소모 칼로리입니다
+type ActivityStatsDay struct {
+	Date     string
+	Steps    int
+	Calories float64
+}
+
+// ActivityStats는 기간별 활동 통계입니다
+type ActivityStats struct {
+	Range string
+	From  string // 조회 시작 날짜 (포함)
+	To    string // 조회 끝 날짜 (오늘, 포함)
+	Days  []ActivityStatsDay
+
+	// 기간 집계
+	ActiveDays      int
+	TotalSteps      int64
+	TotalCalories   float64
+	AverageSteps    float64 // 기록이 없는 날을 0으로 보고 계산한 하루 평균
+	AverageCalories float64
+	MaxSteps        int // 기간 내 하루 최대 걸음 수
+	MaxCalories     float64
+
+	// 연속 달성 (하루 StreakGoalSteps 이상 걸은 날 기준, 전체 기간)
+	StreakGoalSteps int
+	CurrentStreak   int // 오늘 또는 어제까지 이어지는 연속 달성 일수
+	LongestStreak   int
+
+	// 개인 최고 기록 (전체 기간, 기록이 없으면 nil)
+	BestStepsDay    *ActivityStatsDay
+	BestCaloriesDay *ActivityStatsDay
+}
+
+// GetStats는 플레이어 시간대 기준 오늘을 포함한 최근 범위의 날짜별 걸음 수와 칼로리,
+// 합계와 평균, 연속 달성 일수, 개인 최고 기록을 조회합니다
+func (s *ActivityService) GetStats(playerID uint, statsRange string) (*ActivityStats, error) {
+	days, ok := activityStatsRangeDays[statsRange]
+	if !ok {
+		return nil, ErrInvalidStatsRange
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	today, _, err := currentActivityDay(s.userActivityRepo, player, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	to, err := time.Parse(models.ActivityDateLayout, today)
+	if err != nil {
+		return nil, err
+	}
+	from := to.AddDate(0, 0, -(days - 1)).Format(models.ActivityDateLayout)
+
+	stats := &ActivityStats{
+		Range: statsRange,
+		From:  from,
+		To:    today,
+	}
+
+	activities, err := s.userActivityRepo.FindByUserIDBetween(playerID, from, today)
+	if err != nil {
+		return nil, err
+	}
+	stats.Days = fillActivityStatsDays(from, days, activities)
+
+	totals, err := s.userActivityRepo.SumByUserIDBetween(playerID, from, today)
+	if err != nil {
+		return nil, err
+	}
+	stats.ActiveDays = int(totals.ActiveDays)
+	stats.TotalSteps = totals.TotalSteps
+	stats.TotalCalories = totals.TotalCalories
+	stats.AverageSteps = float64(totals.TotalSteps) / float64(days)
+	stats.AverageCalories = totals.TotalCalories / float64(days)
+	stats.MaxSteps = totals.MaxSteps
+	stats.MaxCalories = totals.MaxCalories
+
+	stats.StreakGoalSteps = s.streakGoalSteps()
+	dates, err := s.userActivityRepo.FindDatesWithMinSteps(playerID, stats.StreakGoalSteps)
+	if err != nil {
+		return nil, err
+	}
+	stats.CurrentStreak, stats.LongestStreak = activityStreaks(dates, to)
+
+	bestSteps, bestCalories, err := s.userActivityRepo.FindBestByUserID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	if bestSteps != nil {
+		stats.BestStepsDay = &ActivityStatsDay{Date: bestSteps.Date, Steps: bestSteps.Steps, Calories: bestSteps.Calories}
+	}
+	if bestCalories != nil {
+		stats.BestCaloriesDay = &ActivityStatsDay{Date: bestCalories.Date, Steps: bestCalories.Steps, Calories: bestCalories.Calories}
+	}
+	return stats, nil
+}
+
+// streakGoalSteps는 연속 달성으로 인정하는 하루 걸음 수입니다 (가장 낮은 일일 목표, 목표가 없으면 1보)
+func (s *ActivityService) streakGoalSteps() int {
+	if len(s.cfg.StepGoalTiers) == 0 {
+		return 1
+	}
+	return s.cfg.StepGoalTiers[0].Steps
+}
+
+// fillActivityStatsDays는 from부터 days일 동안의 날짜별 기록을 만듭니다 (기록이 없는 날은 0)
+func fillActivityStatsDays(from string, days int, activities []models.UserActivity) []ActivityStatsDay {
+	byDate := make(map[string]*models.UserActivity, len(activities))
+	for i := range activities {
+		byDate[activities[i].Date] = &activities[i]
+	}
+
+	start, _ := time.Parse(models.ActivityDateLayout, from)
+	result := make([]ActivityStatsDay, days)
+	for i := range result {
+		date := start.AddDate(0, 0, i).Format(models.ActivityDateLayout)
+		result[i] = ActivityStatsDay{Date: date}
+		if activity, ok := byDate[date]; ok {
+			result[i].Steps = activity.Steps
+			result[i].Calories = activity.Calories
+		}
+	}
+	return result
+}
+
+// activityStreaks는 날짜순으로 정렬된 달성 날짜에서 현재 연속 일수와 최장 연속 일수를 계산합니다
+// 오늘은 아직 진행 중이므로 어제까지 이어진 연속 기록도 현재 연속으로 인정합니다
+func activityStreaks(dates []string, today time.Time) (current, longest int) {
+	var run int
+	var prev time.Time
+	for _, date := range dates {
+		day, err := time.Parse(models.ActivityDateLayout, date)
+		if err != nil {
+			continue
+		}
+		if run > 0 && day.Equal(prev.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		prev = day
+		longest = max(longest, run)
+	}
+
+	if run > 0 && !prev.Before(today.AddDate(0, 0, -1)) {
+		current = run
+	}
+	return current, longest
+}
@@ -0,0 +1,555 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"log"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrDungeonNotFound는 던전을 찾을 수 없을 때 반환됩니다
+	ErrDungeonNotFound = errors.New("dungeon not found")
+	// ErrInvalidDungeonSchedule은 던전 종료 시각이 시작 시각보다 빠를 때 반환됩니다
+	ErrInvalidDungeonSchedule = errors.New("dungeon end time must be after start time")
+	// ErrInvalidRole은 정의되지 않은 역할일 때 반환됩니다
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrCannotModifySelf는 운영자가 자기 자신을 대상으로 역할 변경, 이용 정지, 지급을 하려 할 때 반환됩니다
+	ErrCannotModifySelf = errors.New("cannot modify own account")
+	// ErrInsufficientPrivilege는 자신과 같거나 높은 권한의 계정을 대상으로 작업하려 할 때 반환됩니다
+	ErrInsufficientPrivilege = errors.New("insufficient privilege for target account")
+	// ErrSuspensionNotFound는 이용 정지 기록을 찾을 수 없을 때 반환됩니다
+	ErrSuspensionNotFound = errors.New("suspension not found")
+	// ErrAlreadySuspended는 이미 유효한 이용 정지가 있는 플레이어를 다시 정지하려 할 때 반환됩니다
+	ErrAlreadySuspended = errors.New("player is already suspended")
+	// ErrSuspensionNotActive는 이미 해제되었거나 만료된 이용 정지를 해제하려 할 때 반환됩니다
+	ErrSuspensionNotActive = errors.New("suspension is not active")
+	// ErrInvalidSuspensionExpiry는 이용 정지 만료 시각이 현재보다 이전일 때 반환됩니다
+	ErrInvalidSuspensionExpiry = errors.New("suspension expiry must be in the future")
+	// ErrInvalidWeaponType은 지급할 무기 종류가 현재 콘텐츠의 어떤 무기 템플릿에도 없을 때 반환됩니다
+	ErrInvalidWeaponType = errors.New("weapon type is not used by any weapon template")
+	// ErrInvalidRarity는 지급할 무기 등급이 현재 콘텐츠의 등급 표에 없을 때 반환됩니다
+	ErrInvalidRarity = errors.New("rarity is not defined in the rarity tiers")
+	// ErrInvalidWeaponStats는 지급할 무기의 공격력이나 공격 속도가 0 이하일 때 반환됩니다
+	ErrInvalidWeaponStats = errors.New("attack power and attack speed must be positive")
+	// ErrInvalidWeaponLevel은 지급할 무기 단계가 1보다 낮거나 현재 콘텐츠의 강화 비용 표가 다루는 단계보다 높을 때 반환됩니다
+	ErrInvalidWeaponLevel = errors.New("weapon level is outside the upgrade table")
+)
+
+// AdminActor는 운영 작업을 수행하는 운영자/관리자 정보입니다 (감사 로그용)
+type AdminActor struct {
+	PlayerID uint
+	Role     string
+	IP       string
+}
+
+// DungeonInput은 던전 생성/수정 입력값입니다
+type DungeonInput struct {
+	Name       string
+	Type       string
+	Difficulty int
+	IsActive   bool
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+// WeaponGrant는 플레이어에게 지급할 무기 정보입니다
+type WeaponGrant struct {
+	Name        string
+	Type        string
+	AttackPower int
+	AttackSpeed float64
+	Rarity      string
+	Level       int
+}
+
+// AdminService는 운영/관리 기능 비즈니스 로직을 담당합니다
+// 상태를 바꾸는 모든 작업은 감사 로그를 남깁니다
+type AdminService struct {
+	playerRepo       repository.PlayerRepositoryInterface
+	weaponRepo       repository.WeaponRepositoryInterface
+	dungeonRepo      repository.DungeonRepositoryInterface
+	suspensionRepo   repository.SuspensionRepositoryInterface
+	rejectedStepRepo repository.RejectedStepSampleRepositoryInterface
+	walletRepo       repository.WalletRepositoryInterface
+	inventoryRepo    repository.InventoryRepositoryInterface
+	levelService     *LevelService
+	contentStore     *content.Store
+	auditLogRepo     repository.AuditLogRepositoryInterface
+	cfg              *config.Config
+}
+
+// NewAdminService는 새로운 AdminService 인스턴스를 생성합니다
+func NewAdminService(
+	playerRepo repository.PlayerRepositoryInterface,
+	weaponRepo repository.WeaponRepositoryInterface,
+	dungeonRepo repository.DungeonRepositoryInterface,
+	suspensionRepo repository.SuspensionRepositoryInterface,
+	rejectedStepRepo repository.RejectedStepSampleRepositoryInterface,
+	walletRepo repository.WalletRepositoryInterface,
+	inventoryRepo repository.InventoryRepositoryInterface,
+	levelService *LevelService,
+	contentStore *content.Store,
+	auditLogRepo repository.AuditLogRepositoryInterface,
+	cfg *config.Config,
+) *AdminService {
+	return &AdminService{
+		playerRepo:       playerRepo,
+		weaponRepo:       weaponRepo,
+		dungeonRepo:      dungeonRepo,
+		suspensionRepo:   suspensionRepo,
+		rejectedStepRepo: rejectedStepRepo,
+		walletRepo:       walletRepo,
+		inventoryRepo:    inventoryRepo,
+		levelService:     levelService,
+		contentStore:     contentStore,
+		auditLogRepo:     auditLogRepo,
+		cfg:              cfg,
+	}
+}
+
+// GetDungeons는 전체 던전 목록을 조회합니다
+func (s *AdminService) GetDungeons() ([]models.Dungeon, error) {
+	return s.dungeonRepo.FindAll()
+}
+
+// CreateDungeon은 새로운 던전을 생성합니다
+func (s *AdminService) CreateDungeon(actor AdminActor, input DungeonInput) (*models.Dungeon, error) {
+	if err := validateDungeonSchedule(input); err != nil {
+		return nil, err
+	}
+
+	dungeon := &models.Dungeon{}
+	applyDungeonInput(dungeon, input)
+	if err := s.dungeonRepo.Create(dungeon); err != nil {
+		return nil, err
+	}
+	// GORM은 기본값(default:true)이 있는 컬럼의 false를 INSERT에서 생략하므로 비활성 던전은 다시 저장합니다
+	if !input.IsActive && dungeon.IsActive {
+		dungeon.IsActive = false
+		if err := s.dungeonRepo.Update(dungeon); err != nil {
+			return nil, err
+		}
+	}
+
+	s.audit(actor, models.AuditActionDungeonCreate, models.AuditTargetDungeon, dungeon.ID, map[string]interface{}{
+		"after": dungeon,
+	})
+	return dungeon, nil
+}
+
+// UpdateDungeon은 던전 정보를 수정합니다
+func (s *AdminService) UpdateDungeon(actor AdminActor, dungeonID uint, input DungeonInput) (*models.Dungeon, error) {
+	if err := validateDungeonSchedule(input); err != nil {
+		return nil, err
+	}
+
+	dungeon, err := s.dungeonRepo.FindByID(dungeonID)
+	if err != nil {
+		return nil, ErrDungeonNotFound
+	}
+	before := *dungeon
+
+	applyDungeonInput(dungeon, input)
+	if err := s.dungeonRepo.Update(dungeon); err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionDungeonUpdate, models.AuditTargetDungeon, dungeon.ID, map[string]interface{}{
+		"before": before,
+		"after":  dungeon,
+	})
+	return dungeon, nil
+}
+
+// DeleteDungeon은 던전을 삭제합니다
+func (s *AdminService) DeleteDungeon(actor AdminActor, dungeonID uint) error {
+	dungeon, err := s.dungeonRepo.FindByID(dungeonID)
+	if err != nil {
+		return ErrDungeonNotFound
+	}
+
+	if err := s.dungeonRepo.Delete(dungeon.ID); err != nil {
+		return err
+	}
+
+	s.audit(actor, models.AuditActionDungeonDelete, models.AuditTargetDungeon, dungeon.ID, map[string]interface{}{
+		"before": dungeon,
+	})
+	return nil
+}
+
+// GetPlayer는 ID로 플레이어를 이용 정지 이력과 함께 조회합니다
+func (s *AdminService) GetPlayer(playerID uint) (*models.Player, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if err := s.loadSuspensions(player); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// FindPlayerByUsername은 사용자명으로 플레이어를 이용 정지 이력과 함께 조회합니다
+func (s *AdminService) FindPlayerByUsername(username string) (*models.Player, error) {
+	player, err := s.playerRepo.FindByUsername(username)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if err := s.loadSuspensions(player); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// SuspendPlayer는 플레이어를 이용 정지합니다
+// 세션은 그대로 두고 AuthMiddleware가 정지 기간 동안 토큰을 거부하므로, 클라이언트는 정지 사유를 안내받을 수 있습니다
+// expiresAt이 nil이면 영구 정지이며, 이미 유효한 정지가 있으면 ErrAlreadySuspended를 반환합니다
+func (s *AdminService) SuspendPlayer(actor AdminActor, playerID uint, reason string, expiresAt *time.Time) (*models.Suspension, error) {
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrInvalidSuspensionExpiry
+	}
+
+	player, err := s.targetPlayer(actor, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.suspensionRepo.FindActiveByPlayerID(player.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, ErrAlreadySuspended
+	}
+
+	suspension := &models.Suspension{
+		PlayerID:   player.ID,
+		Reason:     reason,
+		IssuedByID: actor.PlayerID,
+		ExpiresAt:  expiresAt,
+	}
+	if err := s.suspensionRepo.Create(suspension); err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionPlayerSuspend, models.AuditTargetPlayer, player.ID, map[string]interface{}{
+		"suspension_id": suspension.ID,
+		"reason":        reason,
+		"expires_at":    expiresAt,
+	})
+	return suspension, nil
+}
+
+// LiftSuspension은 유효한 이용 정지를 만료 전에 해제합니다
+func (s *AdminService) LiftSuspension(actor AdminActor, suspensionID uint, reason string) (*models.Suspension, error) {
+	suspension, err := s.suspensionRepo.FindByID(suspensionID)
+	if err != nil {
+		return nil, ErrSuspensionNotFound
+	}
+	if _, err := s.targetPlayer(actor, suspension.PlayerID); err != nil {
+		return nil, err
+	}
+
+	err = s.suspensionRepo.Lift(suspension.ID, actor.PlayerID, reason, time.Now())
+	if errors.Is(err, repository.ErrSuspensionNotActive) {
+		return nil, ErrSuspensionNotActive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lifted, err := s.suspensionRepo.FindByID(suspension.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionPlayerLift, models.AuditTargetPlayer, lifted.PlayerID, map[string]interface{}{
+		"suspension_id":   lifted.ID,
+		"reason":          reason,
+		"original_reason": lifted.Reason,
+	})
+	return lifted, nil
+}
+
+// GetPlayerSuspensions는 플레이어의 이용 정지 이력을 최신순으로 조회합니다
+func (s *AdminService) GetPlayerSuspensions(playerID uint) ([]models.Suspension, error) {
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	return s.suspensionRepo.FindByPlayerID(playerID)
+}
+
+// GetSuspensions는 이용 정지 목록을 최신순으로 조회합니다 (activeOnly면 현재 유효한 정지만)
+func (s *AdminService) GetSuspensions(playerID uint, activeOnly bool, limit, offset int) ([]models.Suspension, int64, error) {
+	filter := repository.SuspensionFilter{
+		PlayerID:   playerID,
+		ActiveOnly: activeOnly,
+		Now:        time.Now(),
+	}
+	return s.suspensionRepo.Find(filter, limit, offset)
+}
+
+// GetRejectedStepSamples는 걸음 수 동기화에서 거부된 샘플을 최신순으로 조회합니다 (부정 행위 검토용)
+func (s *AdminService) GetRejectedStepSamples(playerID uint, reason string, limit, offset int) ([]models.RejectedStepSample, int64, error) {
+	filter := repository.RejectedStepSampleFilter{
+		PlayerID: playerID,
+		Reason:   reason,
+	}
+	return s.rejectedStepRepo.Find(filter, limit, offset)
+}
+
+// ChangeRole은 플레이어의 역할을 변경합니다
+func (s *AdminService) ChangeRole(actor AdminActor, playerID uint, role string) (*models.Player, error) {
+	if !models.IsValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+
+	player, err := s.targetPlayer(actor, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRole := player.Role
+	if err := s.playerRepo.UpdateRole(player.ID, role); err != nil {
+		return nil, err
+	}
+	player.Role = role
+	if err := s.loadSuspensions(player); err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionPlayerRole, models.AuditTargetPlayer, player.ID, map[string]interface{}{
+		"before": previousRole,
+		"after":  role,
+	})
+	return player, nil
+}
+
+// grantAuditActions는 재화 종류별 지급 감사 로그 동작입니다
+var grantAuditActions = map[string]string{
+	models.CurrencyGold:   models.AuditActionGrantGold,
+	models.CurrencyEmbers: models.AuditActionGrantEmbers,
+	models.CurrencyGems:   models.AuditActionGrantGems,
+	models.CurrencyScrap:  models.AuditActionGrantScrap,
+}
+
+// GrantCurrency는 플레이어에게 재화를 지급(음수면 회수)하고 변경 후 잔액을 반환합니다
+// 지급 내역은 재화 원장에 사유와 함께 기록됩니다
+func (s *AdminService) GrantCurrency(actor AdminActor, playerID uint, currency string, amount int64, reason string) (int64, error) {
+	action, ok := grantAuditActions[currency]
+	if !ok {
+		return 0, ErrInvalidCurrency
+	}
+	if actor.PlayerID == playerID {
+		return 0, ErrCannotModifySelf
+	}
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return 0, ErrPlayerNotFound
+	}
+
+	entries, err := s.walletRepo.Apply([]models.WalletLedgerEntry{{
+		PlayerID: playerID,
+		Currency: currency,
+		Amount:   amount,
+		Reason:   models.LedgerReasonAdminGrant,
+		RefType:  models.AuditTargetPlayer,
+		RefID:    strconv.FormatUint(uint64(actor.PlayerID), 10),
+		Note:     reason,
+	}})
+	if err != nil {
+		return 0, walletError(err)
+	}
+	balance := entries[0].BalanceAfter
+
+	s.audit(actor, action, models.AuditTargetPlayer, playerID, map[string]interface{}{
+		"currency": currency,
+		"amount":   amount,
+		"balance":  balance,
+		"reason":   reason,
+	})
+	return balance, nil
+}
+
+// GrantWeapon은 플레이어에게 무기를 지급합니다
+// 무기 종류와 등급은 현재 콘텐츠의 무기 템플릿과 등급 표에 있는 것만, 단계는 강화 비용 표가 다루는 단계까지만 지급할 수 있습니다
+// 지급한 무기도 보관함 자리를 차지하므로, 보관함이 가득 찬 플레이어에게는 ErrInventoryFull을 반환합니다
+func (s *AdminService) GrantWeapon(actor AdminActor, playerID uint, grant WeaponGrant, reason string) (*models.Weapon, error) {
+	catalog := s.contentStore.Current()
+	if !catalog.HasWeaponType(grant.Type) {
+		return nil, ErrInvalidWeaponType
+	}
+	if catalog.RarityIndex(grant.Rarity) < 0 {
+		return nil, ErrInvalidRarity
+	}
+	if grant.AttackPower <= 0 || grant.AttackSpeed <= 0 {
+		return nil, ErrInvalidWeaponStats
+	}
+	if grant.Level < 1 || grant.Level > catalog.WeaponUpgrade.TableMaxLevel() {
+		return nil, ErrInvalidWeaponLevel
+	}
+	if actor.PlayerID == playerID {
+		return nil, ErrCannotModifySelf
+	}
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	used, err := s.weaponRepo.CountByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	if used >= int64(weaponCapacity(s.cfg, player)) {
+		return nil, ErrInventoryFull
+	}
+
+	weapon := &models.Weapon{
+		PlayerID:    playerID,
+		Name:        grant.Name,
+		Type:        grant.Type,
+		AttackPower: grant.AttackPower,
+		AttackSpeed: grant.AttackSpeed,
+		Rarity:      grant.Rarity,
+		Level:       grant.Level,
+	}
+	if err := s.weaponRepo.Create(weapon); err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionGrantWeapon, models.AuditTargetPlayer, playerID, map[string]interface{}{
+		"weapon": weapon,
+		"reason": reason,
+	})
+	return weapon, nil
+}
+
+// GrantItem은 플레이어에게 재료·소모품 아이템을 지급합니다. 음수면 회수합니다
+// 지급 후 수량을 반환하며, 최대 보유 수량을 넘으면 *ItemStackFullError, 회수할 수량이 부족하면 *InsufficientItemsError를 반환합니다
+func (s *AdminService) GrantItem(actor AdminActor, playerID uint, itemID string, amount int64, reason string) (int64, error) {
+	item, ok := s.contentStore.Current().Item(itemID)
+	if !ok {
+		return 0, ErrItemNotFound
+	}
+	if actor.PlayerID == playerID {
+		return 0, ErrCannotModifySelf
+	}
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return 0, ErrPlayerNotFound
+	}
+
+	items, err := s.inventoryRepo.Apply([]repository.InventoryChange{itemChange(playerID, item, amount)})
+	if err != nil {
+		return 0, inventoryError(err)
+	}
+	quantity := items[0].Quantity
+
+	s.audit(actor, models.AuditActionGrantItem, models.AuditTargetPlayer, playerID, map[string]interface{}{
+		"item_id":  itemID,
+		"amount":   amount,
+		"quantity": quantity,
+		"reason":   reason,
+	})
+	return quantity, nil
+}
+
+// GrantExperience는 플레이어에게 경험치를 지급합니다
+// 레벨이 오르면 레벨업 보상도 함께 지급되며, 오른 레벨마다 레벨업 이벤트를 반환합니다
+func (s *AdminService) GrantExperience(actor AdminActor, playerID uint, amount int64, reason string) (*ExperienceResult, error) {
+	if actor.PlayerID == playerID {
+		return nil, ErrCannotModifySelf
+	}
+
+	result, err := s.levelService.GrantExperience(playerID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(actor, models.AuditActionGrantExperience, models.AuditTargetPlayer, playerID, map[string]interface{}{
+		"amount":     amount,
+		"level":      result.Player.Level,
+		"experience": result.Player.Experience,
+		"level_ups":  len(result.LevelUps),
+		"reason":     reason,
+	})
+	return result, nil
+}
+
+// GetAuditLogs는 감사 로그를 최신순으로 조회합니다
+func (s *AdminService) GetAuditLogs(filter repository.AuditLogFilter, limit, offset int) ([]models.AuditLog, int64, error) {
+	return s.auditLogRepo.Find(filter, limit, offset)
+}
+
+// targetPlayer는 작업 대상 플레이어를 조회하고 작업 권한을 확인합니다
+// 자기 자신이나 자신과 같거나 높은 권한의 계정은 대상으로 삼을 수 없습니다
+func (s *AdminService) targetPlayer(actor AdminActor, playerID uint) (*models.Player, error) {
+	if actor.PlayerID == playerID {
+		return nil, ErrCannotModifySelf
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if player.HasRole(actor.Role) {
+		return nil, ErrInsufficientPrivilege
+	}
+	return player, nil
+}
+
+// loadSuspensions는 운영자 화면에 보여줄 이용 정지 이력을 플레이어에 채웁니다
+func (s *AdminService) loadSuspensions(player *models.Player) error {
+	suspensions, err := s.suspensionRepo.FindByPlayerID(player.ID)
+	if err != nil {
+		return err
+	}
+	player.Suspensions = suspensions
+	return nil
+}
+
+// audit는 감사 로그를 남깁니다
+// 작업 자체는 이미 완료되었으므로 기록 실패는 작업 실패로 돌리지 않고 서버 로그에 남깁니다
+func (s *AdminService) audit(actor AdminActor, action, targetType string, targetID uint, details map[string]interface{}) {
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		detailsJSON = []byte("{}")
+	}
+
+	entry := &models.AuditLog{
+		ActorID:    actor.PlayerID,
+		ActorRole:  actor.Role,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Details:    string(detailsJSON),
+		IP:         actor.IP,
+	}
+	if err := s.auditLogRepo.Create(entry); err != nil {
+		log.Printf("Failed to write audit log (actor=%d action=%s target=%s:%d): %v",
+			actor.PlayerID, action, targetType, targetID, err)
+	}
+}
+
+// validateDungeonSchedule은 던전 운영 기간이 올바른지 확인합니다
+func validateDungeonSchedule(input DungeonInput) error {
+	if input.StartTime != nil && input.EndTime != nil && !input.EndTime.After(*input.StartTime) {
+		return ErrInvalidDungeonSchedule
+	}
+	return nil
+}
+
+// applyDungeonInput은 입력값을 던전 모델에 반영합니다
+func applyDungeonInput(dungeon *models.Dungeon, input DungeonInput) {
+	dungeon.Name = input.Name
+	dungeon.Type = input.Type
+	dungeon.Difficulty = input.Difficulty
+	dungeon.IsActive = input.IsActive
+	dungeon.StartTime = input.StartTime
+	dungeon.EndTime = input.EndTime
+}
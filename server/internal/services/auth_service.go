@@ -2,23 +2,82 @@ package services
 
 import (
 	"errors"
+	"game_eating_pizza/internal/config"
 	"game_eating_pizza/internal/models"
 	"game_eating_pizza/internal/repository"
+	"game_eating_pizza/pkg/token"
+	"time"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
+// refreshTokenBytes는 리프레시 토큰 원문의 랜덤 바이트 길이입니다
+const refreshTokenBytes = 32
+
+// guestUsernamePrefix는 게스트 계정에 자동 부여되는 사용자명 접두사입니다
+const guestUsernamePrefix = "guest_"
+
+var (
+	// ErrInvalidCredentials는 사용자명 또는 비밀번호가 틀렸을 때 반환됩니다
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrUsernameTaken은 이미 사용 중인 사용자명일 때 반환됩니다
+	ErrUsernameTaken = errors.New("username already exists")
+	// ErrNotGuestAccount는 게스트가 아닌 계정에 계정 연결을 시도했을 때 반환됩니다
+	ErrNotGuestAccount = errors.New("account is not a guest account")
+
+	// ErrInvalidRefreshToken은 존재하지 않는 리프레시 토큰일 때 반환됩니다
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenExpired는 리프레시 토큰이 만료되었을 때 반환됩니다
+	ErrRefreshTokenExpired = errors.New("refresh token expired")
+	// ErrRefreshTokenReused는 이미 회전된 리프레시 토큰이 다시 사용되었을 때 반환됩니다
+	// 탈취 가능성이 있으므로 해당 토큰 패밀리 전체를 폐기합니다
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+)
+
 // AuthService는 인증 관련 비즈니스 로직을 담당합니다
 type AuthService struct {
-	playerRepo repository.PlayerRepositoryInterface
+	playerRepo        repository.PlayerRepositoryInterface
+	refreshTokenRepo  repository.RefreshTokenRepositoryInterface
+	sessionRepo       repository.SessionRepositoryInterface
+	loginGuard        *LoginGuard
+	suspensionService *SuspensionService
+	cfg               *config.Config
 }
 
 // NewAuthService는 새로운 AuthService 인스턴스를 생성합니다
-func NewAuthService(playerRepo repository.PlayerRepositoryInterface) *AuthService {
+func NewAuthService(
+	playerRepo repository.PlayerRepositoryInterface,
+	refreshTokenRepo repository.RefreshTokenRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
+	loginGuard *LoginGuard,
+	suspensionService *SuspensionService,
+	cfg *config.Config,
+) *AuthService {
 	return &AuthService{
-		playerRepo: playerRepo,
+		playerRepo:        playerRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		sessionRepo:       sessionRepo,
+		loginGuard:        loginGuard,
+		suspensionService: suspensionService,
+		cfg:               cfg,
 	}
 }
 
+// DeviceInfo는 로그인한 기기 정보입니다 (세션 목록 표시용)
+type DeviceInfo struct {
+	Name     string
+	Platform string
+	IP       string
+}
+
+// TokenPair는 로그인/토큰 갱신 시 발급되는 액세스 토큰과 리프레시 토큰입니다
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
 // Register는 새로운 플레이어를 등록합니다
 func (s *AuthService) Register(username, password string) (*models.Player, error) {
 	// 사용자명 중복 확인
@@ -27,7 +86,7 @@ func (s *AuthService) Register(username, password string) (*models.Player, error
 		return nil, err
 	}
 	if exists {
-		return nil, errors.New("username already exists")
+		return nil, ErrUsernameTaken
 	}
 
 	// 비밀번호 해시
@@ -41,7 +100,10 @@ func (s *AuthService) Register(username, password string) (*models.Player, error
 		Username: username,
 		Password: string(hashedPassword),
 		Level:    1,
-		Gold:     0,
+
+		Locale:        models.LocaleKorean,
+		Timezone:      models.DefaultTimezone,
+		Notifications: models.DefaultNotificationPreferences(),
 	}
 
 	if err := s.playerRepo.Create(player); err != nil {
@@ -51,23 +113,305 @@ func (s *AuthService) Register(username, password string) (*models.Player, error
 	return player, nil
 }
 
-// Login은 플레이어 로그인을 처리합니다
-func (s *AuthService) Login(username, password string) (string, *models.Player, error) {
+// Login은 플레이어 로그인을 처리하고 기기 세션과 새로운 토큰 패밀리를 시작합니다
+// 실패가 누적된 사용자명/IP는 일정 시간 동안 *LoginLockedError를 반환합니다
+// 이용 정지된 계정은 *AccountSuspendedError를 반환합니다
+func (s *AuthService) Login(username, password string, device DeviceInfo) (*TokenPair, *models.Player, error) {
+	// 잠금 확인 (잠긴 상태에서는 bcrypt 비교 비용을 쓰지 않음)
+	if err := s.loginGuard.Check(username, device.IP); err != nil {
+		return nil, nil, err
+	}
+
 	// 플레이어 조회
 	player, err := s.playerRepo.FindByUsername(username)
 	if err != nil {
-		return "", nil, errors.New("invalid credentials")
+		return nil, nil, s.loginFailed(username, device.IP)
 	}
 
 	// 비밀번호 확인
 	err = bcrypt.CompareHashAndPassword([]byte(player.Password), []byte(password))
 	if err != nil {
-		return "", nil, errors.New("invalid credentials")
+		return nil, nil, s.loginFailed(username, device.IP)
+	}
+
+	if err := s.loginGuard.RecordSuccess(username); err != nil {
+		return nil, nil, err
+	}
+	// 이용 정지 여부는 비밀번호가 맞은 경우에만 알려줍니다
+	if err := s.suspensionService.Check(player.ID); err != nil {
+		return nil, nil, err
+	}
+	if err := s.cancelPendingDeletion(player); err != nil {
+		return nil, nil, err
+	}
+
+	pair, err := s.startSession(player.ID, device)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pair, player, nil
+}
+
+// loginFailed는 로그인 실패를 기록하고 클라이언트에 돌려줄 에러를 반환합니다
+func (s *AuthService) loginFailed(username, ip string) error {
+	if err := s.loginGuard.RecordFailure(username, ip); err != nil {
+		return err
+	}
+	return ErrInvalidCredentials
+}
+
+// GuestLogin은 기기 식별자로 게스트 플레이어를 생성하거나 기존 게스트로 로그인합니다
+// 앱을 처음 실행한 유저가 회원가입 없이 바로 게임을 시작할 수 있도록 합니다
+func (s *AuthService) GuestLogin(deviceID string, device DeviceInfo) (*TokenPair, *models.Player, error) {
+	player, err := s.playerRepo.FindGuestByDeviceID(deviceID)
+	if err != nil {
+		player, err = s.createGuest(deviceID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := s.suspensionService.Check(player.ID); err != nil {
+		return nil, nil, err
+	}
+	if err := s.cancelPendingDeletion(player); err != nil {
+		return nil, nil, err
+	}
+
+	pair, err := s.startSession(player.ID, device)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pair, player, nil
+}
+
+// LinkAccount는 게스트 계정에 아이디/비밀번호를 연결하여 정식 계정으로 전환합니다
+// 같은 플레이어 레코드를 그대로 사용하므로 무기, 골드, 진행도가 모두 유지됩니다
+func (s *AuthService) LinkAccount(playerID uint, username, password string) (*models.Player, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, errors.New("player not found")
+	}
+	if !player.IsGuest {
+		return nil, ErrNotGuestAccount
+	}
+
+	exists, err := s.playerRepo.ExistsByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrUsernameTaken
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	// 기기 바인딩을 해제하여 이후 게스트 로그인으로는 이 계정에 접근할 수 없게 합니다
+	player.Username = username
+	player.Password = string(hashedPassword)
+	player.IsGuest = false
+	player.DeviceID = nil
+
+	if err := s.playerRepo.UpdateCredentials(player); err != nil {
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// cancelPendingDeletion은 삭제 유예 기간 중인 계정으로 로그인하면 삭제 예약을 취소합니다
+func (s *AuthService) cancelPendingDeletion(player *models.Player) error {
+	if !player.IsDeletionPending() {
+		return nil
+	}
+	if err := s.playerRepo.SetDeletionSchedule(player.ID, nil); err != nil {
+		return err
+	}
+	player.DeletionScheduledAt = nil
+	return nil
+}
+
+// createGuest는 기기 식별자에 묶인 새 게스트 플레이어를 생성합니다
+func (s *AuthService) createGuest(deviceID string) (*models.Player, error) {
+	suffix, err := token.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	// 게스트는 비밀번호가 없으므로 비밀번호 로그인이 불가능합니다
+	player := &models.Player{
+		Username: guestUsernamePrefix + suffix[:12],
+		IsGuest:  true,
+		DeviceID: &deviceID,
+		Level:    1,
+
+		Locale:        models.LocaleKorean,
+		Timezone:      models.DefaultTimezone,
+		Notifications: models.DefaultNotificationPreferences(),
+	}
+
+	if err := s.playerRepo.Create(player); err != nil {
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// startSession은 기기 세션을 생성하고 해당 세션의 첫 토큰 쌍을 발급합니다
+func (s *AuthService) startSession(playerID uint, device DeviceInfo) (*TokenPair, error) {
+	familyID, err := token.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	session := &models.Session{
+		PlayerID:        playerID,
+		RefreshFamilyID: familyID,
+		DeviceName:      device.Name,
+		Platform:        device.Platform,
+		LastSeenAt:      time.Now(),
+		LastIP:          device.IP,
+	}
+	if session.DeviceName == "" {
+		session.DeviceName = "Unknown device"
+	}
+	if session.Platform == "" {
+		session.Platform = models.PlatformUnknown
+	}
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshRaw, err := s.newRefreshToken(playerID, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Create(refreshToken); err != nil {
+		return nil, err
+	}
+
+	return s.buildTokenPair(playerID, session.ID, refreshToken, refreshRaw)
+}
+
+// Refresh는 리프레시 토큰을 회전시키고 새로운 토큰 쌍을 발급합니다
+// 이미 사용된(회전된) 토큰이 다시 들어오면 토큰 패밀리와 세션 전체를 폐기합니다
+func (s *AuthService) Refresh(rawRefreshToken, ip string) (*TokenPair, error) {
+	current, err := s.refreshTokenRepo.FindByHash(token.HashOpaqueToken(rawRefreshToken))
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	session, err := s.sessionRepo.FindByRefreshFamilyID(current.FamilyID)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	if session.IsRevoked() {
+		return nil, ErrSessionRevoked
+	}
+
+	if current.IsRevoked() {
+		if err := s.revokeSession(session); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	if current.IsExpired(time.Now()) {
+		return nil, ErrRefreshTokenExpired
+	}
+
+	// 정지 기간 중에는 토큰을 갱신해 주지 않습니다
+	if err := s.suspensionService.Check(current.PlayerID); err != nil {
+		return nil, err
+	}
+
+	next, nextRaw, err := s.newRefreshToken(current.PlayerID, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshTokenRepo.Rotate(current, next); err != nil {
+		// 동시에 같은 토큰으로 갱신을 시도한 경우도 재사용으로 간주합니다
+		if errors.Is(err, repository.ErrRefreshTokenAlreadyRotated) {
+			if err := s.revokeSession(session); err != nil {
+				return nil, err
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, err
+	}
+
+	if err := s.sessionRepo.Touch(session.ID, time.Now(), ip); err != nil {
+		return nil, err
+	}
+
+	return s.buildTokenPair(current.PlayerID, session.ID, next, nextRaw)
+}
+
+// Logout은 리프레시 토큰이 속한 세션(현재 기기)을 종료합니다
+func (s *AuthService) Logout(rawRefreshToken string) error {
+	current, err := s.refreshTokenRepo.FindByHash(token.HashOpaqueToken(rawRefreshToken))
+	if err != nil {
+		return ErrInvalidRefreshToken
+	}
+
+	session, err := s.sessionRepo.FindByRefreshFamilyID(current.FamilyID)
+	if err != nil {
+		return ErrInvalidRefreshToken
+	}
+	return s.revokeSession(session)
+}
+
+// LogoutAll은 플레이어의 모든 세션과 리프레시 토큰을 폐기합니다 (전체 기기 로그아웃)
+func (s *AuthService) LogoutAll(playerID uint) error {
+	if err := s.sessionRepo.RevokeAllByPlayerID(playerID); err != nil {
+		return err
+	}
+	return s.refreshTokenRepo.RevokeAllByPlayerID(playerID)
+}
+
+// revokeSession은 세션을 종료하고 세션의 리프레시 토큰 패밀리를 폐기합니다
+// 세션이 종료되면 AuthMiddleware가 해당 세션의 액세스 토큰도 즉시 거부합니다
+func (s *AuthService) revokeSession(session *models.Session) error {
+	if err := s.sessionRepo.Revoke(session.ID); err != nil {
+		return err
+	}
+	return s.refreshTokenRepo.RevokeFamily(session.RefreshFamilyID)
+}
+
+// newRefreshToken은 저장용 리프레시 토큰 모델과 클라이언트에 전달할 원문을 생성합니다
+func (s *AuthService) newRefreshToken(playerID uint, familyID string) (*models.RefreshToken, string, error) {
+	raw, err := token.GenerateOpaqueToken(refreshTokenBytes)
+	if err != nil {
+		return nil, "", err
 	}
 
-	// TODO: JWT 토큰 생성
-	// 임시로 사용자 ID를 토큰으로 사용 (실제로는 JWT 생성 필요)
-	token := "temp_token_" + string(rune(player.ID))
+	refreshToken := &models.RefreshToken{
+		PlayerID:  playerID,
+		FamilyID:  familyID,
+		TokenHash: token.HashOpaqueToken(raw),
+		ExpiresAt: time.Now().Add(time.Duration(s.cfg.JWTRefreshExpiration) * time.Hour),
+	}
+	return refreshToken, raw, nil
+}
+
+// buildTokenPair는 액세스 토큰을 발급하여 리프레시 토큰과 함께 묶어 반환합니다
+// 액세스 토큰은 설정된 비밀키와 유효기간(JWTExpiration, 시간 단위)으로 서명합니다
+func (s *AuthService) buildTokenPair(playerID, sessionID uint, refreshToken *models.RefreshToken, refreshRaw string) (*TokenPair, error) {
+	ttl := time.Duration(s.cfg.JWTExpiration) * time.Hour
+	accessToken, accessExpiresAt, err := token.GenerateAccessToken(playerID, sessionID, s.cfg.JWTSecret, ttl)
+	if err != nil {
+		return nil, err
+	}
 
-	return token, player, nil
+	return &TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshRaw,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, nil
 }
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"math"
+	"time"
+)
+
+// RarityChance는 제작 결과 등급과 그 가중치입니다
+type RarityChance struct {
+	Rarity string
+	Weight int
+}
+
+// ForgeRecipe는 대장간에서 제작할 수 있는 무기 레시피입니다 (콘텐츠 카탈로그의 레시피, 무기 템플릿, 드롭 테이블을 합친 값)
+// 능력치는 무기 템플릿의 범위로만 정의하고 실제 값은 수령 시 서버에서 굴립니다
+type ForgeRecipe struct {
+	ID            string
+	Weapon        content.WeaponTemplate // 제작될 무기 템플릿
+	GoldCost      int64
+	Duration      time.Duration // 기본 제작 시간
+	MinLevel      int           // 제작 가능한 최소 플레이어 레벨
+	RarityChances []RarityChance
+	BaseRarity    string // 걸음 수 부스트를 적용하지 않는 가장 낮은 등급 (콘텐츠 등급 표의 첫 등급)
+}
+
+// newForgeRecipe는 콘텐츠 카탈로그의 레시피를 무기 템플릿과 드롭 테이블로 풀어 만듭니다
+// 카탈로그 검증에서 참조를 확인하므로 템플릿과 드롭 테이블은 항상 존재합니다
+func newForgeRecipe(catalog *content.Catalog, recipe *content.ForgeRecipe) ForgeRecipe {
+	weapon, _ := catalog.Weapon(recipe.Weapon)
+	dropTable, _ := catalog.DropTable(recipe.DropTable)
+
+	chances := make([]RarityChance, len(dropTable.Rarities))
+	for i, entry := range dropTable.Rarities {
+		chances[i] = RarityChance{Rarity: entry.Rarity, Weight: entry.Weight}
+	}
+	return ForgeRecipe{
+		ID:            recipe.ID,
+		Weapon:        *weapon,
+		GoldCost:      recipe.GoldCost,
+		Duration:      recipe.Duration(),
+		MinLevel:      recipe.MinLevel,
+		RarityChances: chances,
+		BaseRarity:    catalog.LowestRarity().ID,
+	}
+}
+
+// forgeRecipes는 카탈로그의 제작 가능한 레시피 목록을 반환합니다 (카탈로그 순서 유지)
+func forgeRecipes(catalog *content.Catalog) []ForgeRecipe {
+	recipes := make([]ForgeRecipe, len(catalog.ForgeRecipes))
+	for i := range catalog.ForgeRecipes {
+		recipes[i] = newForgeRecipe(catalog, &catalog.ForgeRecipes[i])
+	}
+	return recipes
+}
+
+// BoostedDuration은 걸음 수 부스트 배율을 적용한 제작 시간을 반환합니다 (배율만큼 빨라짐)
+func (r *ForgeRecipe) BoostedDuration(boost float64) time.Duration {
+	if boost <= 1 {
+		return r.Duration
+	}
+	return time.Duration(float64(r.Duration) / boost).Round(time.Second)
+}
+
+// BoostedRarityChances는 걸음 수 부스트 배율을 적용한 등급 가중치를 반환합니다
+// 가장 낮은 등급(BaseRarity)을 제외한 등급의 가중치에 배율을 곱해 높은 등급 쪽으로 분포를 옮깁니다
+func (r *ForgeRecipe) BoostedRarityChances(boost float64) []RarityChance {
+	if boost <= 1 {
+		return r.RarityChances
+	}
+	chances := make([]RarityChance, len(r.RarityChances))
+	for i, chance := range r.RarityChances {
+		chances[i] = chance
+		if chance.Rarity != r.BaseRarity {
+			chances[i].Weight = int(math.Round(float64(chance.Weight) * boost))
+		}
+	}
+	return chances
+}
+
+// newForgeJobRecipe는 작업에 고정할 레시피 내용(무기 템플릿과 부스트를 적용한 등급 가중치)을 만듭니다
+func newForgeJobRecipe(recipe *ForgeRecipe, boost float64) models.ForgeJobRecipe {
+	chances := recipe.BoostedRarityChances(boost)
+	weights := make([]models.ForgeRarityWeight, len(chances))
+	for i, chance := range chances {
+		weights[i] = models.ForgeRarityWeight{Rarity: chance.Rarity, Weight: chance.Weight}
+	}
+	return models.ForgeJobRecipe{
+		WeaponName:     recipe.Weapon.Name,
+		WeaponType:     recipe.Weapon.Type,
+		AttackPowerMin: recipe.Weapon.AttackPower.Min,
+		AttackPowerMax: recipe.Weapon.AttackPower.Max,
+		AttackSpeedMin: recipe.Weapon.AttackSpeed.Min,
+		AttackSpeedMax: recipe.Weapon.AttackSpeed.Max,
+		RarityWeights:  weights,
+	}
+}
+
+// forgeJobTemplate은 작업에 고정한 레시피 내용을 무기 템플릿과 등급 가중치로 되돌립니다
+func forgeJobTemplate(recipe models.ForgeJobRecipe) (content.WeaponTemplate, []RarityChance) {
+	chances := make([]RarityChance, len(recipe.RarityWeights))
+	for i, weight := range recipe.RarityWeights {
+		chances[i] = RarityChance{Rarity: weight.Rarity, Weight: weight.Weight}
+	}
+	return content.WeaponTemplate{
+		Name:        recipe.WeaponName,
+		Type:        recipe.WeaponType,
+		AttackPower: content.IntRange{Min: recipe.AttackPowerMin, Max: recipe.AttackPowerMax},
+		AttackSpeed: content.FloatRange{Min: recipe.AttackSpeedMin, Max: recipe.AttackSpeedMax},
+	}, chances
+}
+
+// findForgeRecipe는 카탈로그에서 ID로 레시피를 찾습니다
+func findForgeRecipe(catalog *content.Catalog, id string) (*ForgeRecipe, bool) {
+	recipe, ok := catalog.Recipe(id)
+	if !ok {
+		return nil, false
+	}
+	resolved := newForgeRecipe(catalog, recipe)
+	return &resolved, true
+}
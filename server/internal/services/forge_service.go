@@ -0,0 +1,291 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+var (
+	// ErrRecipeNotFound는 존재하지 않는 레시피일 때 반환됩니다
+	ErrRecipeNotFound = errors.New("recipe not found")
+	// ErrLevelTooLow는 레시피에 필요한 레벨보다 플레이어 레벨이 낮을 때 반환됩니다
+	ErrLevelTooLow = errors.New("player level too low for recipe")
+	// ErrInsufficientGold는 제작 비용을 낼 골드가 부족할 때 반환됩니다
+	ErrInsufficientGold = errors.New("insufficient gold")
+	// ErrForgeSlotsFull은 동시에 진행할 수 있는 제작 작업 수를 넘었을 때 반환됩니다
+	ErrForgeSlotsFull = errors.New("all forge slots are in use")
+	// ErrForgeJobNotFound는 제작 작업이 없거나 다른 플레이어의 작업일 때 반환됩니다
+	ErrForgeJobNotFound = errors.New("forge job not found")
+	// ErrForgeJobAlreadyClaimed는 이미 수령한 제작 작업을 다시 수령하려 할 때 반환됩니다
+	ErrForgeJobAlreadyClaimed = errors.New("forge job already claimed")
+	// ErrForgeJobRefunded는 레시피가 사라져 무기 대신 제작 비용을 돌려준 작업일 때 반환됩니다
+	ErrForgeJobRefunded = errors.New("forge recipe was removed, gold cost refunded")
+)
+
+// ForgeJobNotReadyError는 제작이 끝나지 않은 작업을 수령하려 할 때 반환됩니다
+type ForgeJobNotReadyError struct {
+	Remaining time.Duration // 제작 완료까지 남은 시간
+}
+
+func (e *ForgeJobNotReadyError) Error() string {
+	return fmt.Sprintf("forge job is not ready, %s remaining", e.Remaining)
+}
+
+// ForgeBoost는 당일 걸음 수로 계산한 대장간 부스트입니다
+type ForgeBoost struct {
+	Multiplier float64 // 제작 시간 단축 및 등급 확률 보정 배율 (1.0 = 부스트 없음)
+	Steps      int     // 부스트 산정에 사용한 걸음 수
+	Date       string  // 걸음 수 기록 날짜 ("2024-05-21" 형식)
+}
+
+// ForgeService는 대장간 무기 제작 비즈니스 로직을 담당합니다
+// 클라이언트는 레시피만 고르고, 비용 차감과 결과 무기 능력치는 모두 서버가 결정합니다
+// 레시피와 걸음 수 부스트 단계는 콘텐츠 카탈로그를 따릅니다
+type ForgeService struct {
+	forgeJobRepo     repository.ForgeJobRepositoryInterface
+	weaponRepo       repository.WeaponRepositoryInterface
+	playerRepo       repository.PlayerRepositoryInterface
+	userActivityRepo repository.UserActivityRepositoryInterface
+	contentStore     *content.Store
+	cfg              *config.Config
+}
+
+// NewForgeService는 새로운 ForgeService 인스턴스를 생성합니다
+func NewForgeService(
+	forgeJobRepo repository.ForgeJobRepositoryInterface,
+	weaponRepo repository.WeaponRepositoryInterface,
+	playerRepo repository.PlayerRepositoryInterface,
+	userActivityRepo repository.UserActivityRepositoryInterface,
+	contentStore *content.Store,
+	cfg *config.Config,
+) *ForgeService {
+	return &ForgeService{
+		forgeJobRepo:     forgeJobRepo,
+		weaponRepo:       weaponRepo,
+		playerRepo:       playerRepo,
+		userActivityRepo: userActivityRepo,
+		contentStore:     contentStore,
+		cfg:              cfg,
+	}
+}
+
+// GetRecipes는 제작 가능한 레시피 목록을 반환합니다
+func (s *ForgeService) GetRecipes() []ForgeRecipe {
+	return forgeRecipes(s.contentStore.Current())
+}
+
+// CurrentBoost는 플레이어 시간대 기준 오늘의 걸음 수로 대장간 부스트를 계산합니다
+// 오늘 활동 기록이 없으면 부스트 없음(1.0)을 반환합니다
+func (s *ForgeService) CurrentBoost(playerID uint, now time.Time) (ForgeBoost, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return ForgeBoost{}, ErrPlayerNotFound
+	}
+	return s.boostFor(player, now)
+}
+
+// boostFor는 플레이어의 now 기준 대장간 부스트를 계산합니다
+func (s *ForgeService) boostFor(player *models.Player, now time.Time) (ForgeBoost, error) {
+	date, steps, err := currentActivityDay(s.userActivityRepo, player, now)
+	if err != nil {
+		return ForgeBoost{}, err
+	}
+	return ForgeBoost{
+		Multiplier: s.contentStore.Current().ForgeBoost(steps),
+		Steps:      steps,
+		Date:       date,
+	}, nil
+}
+
+// StartJob은 골드를 차감하고 레시피의 제작 작업을 시작합니다
+// 시작 시점의 걸음 수 부스트가 작업에 고정되어 제작 시간과 수령 시 등급 확률에 적용됩니다
+// 레시피 내용(무기 템플릿, 등급 가중치)도 작업에 고정되므로 수령 전에 콘텐츠가 바뀌어도 비용을 낸 시점의 레시피대로 만들어집니다
+// 수령하지 않은 작업도 무기 보관함의 자리를 차지하므로, 보관함에 남은 자리가 없으면 ErrInventoryFull을 반환합니다
+func (s *ForgeService) StartJob(playerID uint, recipeID string) (*models.ForgeJob, error) {
+	recipe, ok := findForgeRecipe(s.contentStore.Current(), recipeID)
+	if !ok {
+		return nil, ErrRecipeNotFound
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if player.Level < recipe.MinLevel {
+		return nil, ErrLevelTooLow
+	}
+
+	now := time.Now()
+	boost, err := s.boostFor(player, now)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &models.ForgeJob{
+		PlayerID:    playerID,
+		RecipeID:    recipe.ID,
+		Status:      models.ForgeJobStatusCrafting,
+		GoldCost:    recipe.GoldCost,
+		ForgeBoost:  boost.Multiplier,
+		BoostSteps:  boost.Steps,
+		Recipe:      newForgeJobRecipe(recipe, boost.Multiplier),
+		StartedAt:   now,
+		CompletesAt: now.Add(recipe.BoostedDuration(boost.Multiplier)),
+	}
+
+	err = s.forgeJobRepo.Start(job, repository.ForgeStartLimits{
+		MaxActive:    s.cfg.ForgeMaxActiveJobs,
+		BaseCapacity: s.cfg.WeaponInventoryBaseCapacity,
+		MaxCapacity:  s.cfg.WeaponInventoryMaxCapacity,
+	})
+	var fundsErr *repository.InsufficientFundsError
+	switch {
+	case errors.As(err, &fundsErr):
+		return nil, ErrInsufficientGold
+	case errors.Is(err, repository.ErrForgeSlotsFull):
+		return nil, ErrForgeSlotsFull
+	case errors.Is(err, repository.ErrForgeInventoryFull):
+		return nil, ErrInventoryFull
+	case err != nil:
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetJobs는 플레이어가 아직 수령하지 않은 제작 작업 목록을 조회합니다
+func (s *ForgeService) GetJobs(playerID uint) ([]models.ForgeJob, error) {
+	return s.forgeJobRepo.FindUnclaimedByPlayerID(playerID)
+}
+
+// GetJob은 플레이어의 제작 작업을 조회합니다
+func (s *ForgeService) GetJob(playerID, jobID uint) (*models.ForgeJob, error) {
+	job, err := s.forgeJobRepo.FindByID(jobID)
+	if err != nil || job.PlayerID != playerID {
+		return nil, ErrForgeJobNotFound
+	}
+	return job, nil
+}
+
+// ClaimJob은 완료된 제작 작업의 결과 무기를 작업에 고정한 레시피로 굴려서 플레이어에게 지급합니다
+// 레시피를 고정하기 전에 시작한 작업은 현재 콘텐츠의 레시피를 쓰며, 그 레시피가 사라졌으면 비용을 돌려주고 ErrForgeJobRefunded를 반환합니다
+func (s *ForgeService) ClaimJob(playerID, jobID uint) (*models.ForgeJob, *models.Weapon, error) {
+	job, err := s.GetJob(playerID, jobID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	switch job.StatusAt(now) {
+	case models.ForgeJobStatusClaimed:
+		return nil, nil, ErrForgeJobAlreadyClaimed
+	case models.ForgeJobStatusRefunded:
+		return nil, nil, ErrForgeJobRefunded
+	case models.ForgeJobStatusCrafting:
+		return nil, nil, &ForgeJobNotReadyError{Remaining: job.RemainingAt(now)}
+	}
+
+	template, chances, ok := s.jobRecipe(job)
+	if !ok {
+		return nil, nil, s.refundJob(job, now)
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, nil, ErrPlayerNotFound
+	}
+	if err := s.checkInventorySpace(player); err != nil {
+		return nil, nil, err
+	}
+
+	weapon := rollWeapon(s.contentStore.Current(), &template, chances, playerID)
+	err = s.forgeJobRepo.Claim(job.ID, weapon, now)
+	if errors.Is(err, repository.ErrForgeJobNotClaimable) {
+		// 사전 확인 이후 다른 요청이 먼저 수령한 경우입니다
+		return nil, nil, ErrForgeJobAlreadyClaimed
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	job.Status = models.ForgeJobStatusClaimed
+	job.ClaimedAt = &now
+	job.WeaponID = &weapon.ID
+	return job, weapon, nil
+}
+
+// jobRecipe는 작업을 수령할 때 쓸 무기 템플릿과 (부스트를 적용한) 등급 가중치를 반환합니다
+// 레시피를 고정하기 전에 시작한 작업은 현재 콘텐츠에서 레시피를 찾으며, 레시피가 사라졌으면 false를 반환합니다
+func (s *ForgeService) jobRecipe(job *models.ForgeJob) (content.WeaponTemplate, []RarityChance, bool) {
+	if !job.Recipe.IsEmpty() {
+		template, chances := forgeJobTemplate(job.Recipe)
+		return template, chances, true
+	}
+	recipe, ok := findForgeRecipe(s.contentStore.Current(), job.RecipeID)
+	if !ok {
+		return content.WeaponTemplate{}, nil, false
+	}
+	return recipe.Weapon, recipe.BoostedRarityChances(job.ForgeBoost), true
+}
+
+// refundJob은 레시피가 사라진 작업의 비용을 원장을 통해 돌려주고 ErrForgeJobRefunded를 반환합니다
+func (s *ForgeService) refundJob(job *models.ForgeJob, now time.Time) error {
+	err := s.forgeJobRepo.Refund(job, now)
+	if errors.Is(err, repository.ErrForgeJobNotClaimable) {
+		// 사전 확인 이후 다른 요청이 먼저 수령하거나 환불한 경우입니다
+		return ErrForgeJobAlreadyClaimed
+	}
+	if err != nil {
+		return err
+	}
+	return ErrForgeJobRefunded
+}
+
+// checkInventorySpace는 무기 보관함에 무기를 하나 더 받을 자리가 있는지 확인합니다
+func (s *ForgeService) checkInventorySpace(player *models.Player) error {
+	used, err := s.weaponRepo.CountByPlayerID(player.ID)
+	if err != nil {
+		return err
+	}
+	if used >= int64(weaponCapacity(s.cfg, player)) {
+		return ErrInventoryFull
+	}
+	return nil
+}
+
+// rollWeapon은 등급 가중치로 등급을 고르고 무기 템플릿의 능력치 범위로 무기를 생성합니다
+// 공격력에는 콘텐츠 등급 표의 등급별 배율을 곱하고, 등급의 확률로 무작위 특수 효과가 하나 붙습니다
+// 등급 표에서 사라진 등급이 나오면 배율 1배, 특수 효과 없이 만듭니다
+func rollWeapon(catalog *content.Catalog, template *content.WeaponTemplate, chances []RarityChance, playerID uint) *models.Weapon {
+	rarity := rollRarity(chances)
+
+	weapon := newWeaponFromTemplate(template, playerID, rarity)
+	weapon.AttackPower = int(math.Round(float64(weapon.AttackPower) * rarityStatMultiplier(catalog, rarity)))
+	if tier, ok := catalog.Rarity(rarity); ok {
+		weapon.Effects = rollForgeEffects(catalog.ForgeEffects(tier), tier.ForgeEffectChance)
+	}
+	return weapon
+}
+
+// rollRarity는 가중치에 따라 등급을 하나 고릅니다
+func rollRarity(chances []RarityChance) string {
+	total := 0
+	for _, chance := range chances {
+		total += chance.Weight
+	}
+
+	roll := rand.IntN(total)
+	for _, chance := range chances {
+		if roll < chance.Weight {
+			return chance.Rarity
+		}
+		roll -= chance.Weight
+	}
+	return chances[len(chances)-1].Rarity
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrInvalidStage는 옮겨 갈 스테이지가 1보다 낮거나, 현재 스테이지의 다음 단계 또는 최고 스테이지보다 높을 때 반환됩니다
+	ErrInvalidStage = errors.New("stage must be between 1 and the next stage")
+	// ErrStageNotCleared는 현재 스테이지에서 처치한 몬스터 수(이번 정산 포함)가 콘텐츠의 클리어 조건에 못 미친 채 다음 스테이지로 옮기려 할 때 반환됩니다
+	ErrStageNotCleared = errors.New("current stage is not cleared yet")
+	// ErrIdleSyncConflict는 정산을 반영하는 동안 (동시에 처리된 다른 정산이나 경험치 지급으로) 플레이어 진행 상황이 바뀌었을 때 반환됩니다
+	ErrIdleSyncConflict = errors.New("idle progress changed, please retry")
+)
+
+// IdleEarnings는 방치 사냥 정산 결과입니다 (클라이언트 "다시 오신 것을 환영합니다" 화면용)
+type IdleEarnings struct {
+	Player       *models.Player // 반영 후 진행 상황
+	LastSyncedAt *time.Time     // 직전 정산 시각 (처음 정산하면 nil)
+	SyncedAt     time.Time      // 새 정산 시각 (처치 하나를 채우지 못한 남은 시간은 다음 정산으로 넘기므로 현재 시각보다 이를 수 있음)
+	Elapsed      time.Duration  // 직전 정산 이후 흐른 시간
+	Credited     time.Duration  // 그중 인정한 시간 (최대 정산 시간까지)
+	Capped       bool           // 최대 정산 시간을 넘어 버린 시간이 있는지
+
+	Stage           int     // 정산에 쓴 스테이지
+	WeaponID        *uint   // 정산에 쓴 무기 (장착한 무기가 없으면 nil)
+	DamagePerSecond float64 // 영구 강화 보너스를 반영한 무기 초당 피해
+	Kills           int
+	StageKills      int // 정산 후 현재 스테이지에서 처치한 몬스터 수 (스테이지를 옮기면 0)
+	ClearKills      int // 다음 스테이지로 올라가는 데 필요한 처치 수
+	Gold            int64
+	Experience      int64
+	LevelUps        []LevelUpEvent // 처치 경험치로 오른 레벨마다 하나씩, 낮은 레벨부터
+}
+
+// IdleService는 방치(오프라인) 사냥 정산 비즈니스 로직을 담당합니다
+// 클라이언트가 보낸 처치 기록 대신 마지막 정산 이후 흐른 시간, 장착한 무기 능력치와 현재 스테이지로 서버가 직접 계산합니다
+// 처치 규칙과 스테이지 곡선은 게임 콘텐츠의 idle 섹션에서 읽습니다
+type IdleService struct {
+	playerRepo      repository.PlayerRepositoryInterface
+	weaponRepo      repository.WeaponRepositoryInterface
+	progressionRepo repository.ProgressionRepositoryInterface
+	upgradeService  *UpgradeService
+	contentStore    *content.Store
+}
+
+// NewIdleService는 새로운 IdleService 인스턴스를 생성합니다
+func NewIdleService(
+	playerRepo repository.PlayerRepositoryInterface,
+	weaponRepo repository.WeaponRepositoryInterface,
+	progressionRepo repository.ProgressionRepositoryInterface,
+	upgradeService *UpgradeService,
+	contentStore *content.Store,
+) *IdleService {
+	return &IdleService{
+		playerRepo:      playerRepo,
+		weaponRepo:      weaponRepo,
+		progressionRepo: progressionRepo,
+		upgradeService:  upgradeService,
+		contentStore:    contentStore,
+	}
+}
+
+// Sync는 마지막 정산 이후 흐른 시간 동안의 방치 사냥 처치 수, 골드와 경험치를 계산해 지급하고 정산 시각을 옮깁니다
+// 처음 정산하면 지급 없이 정산 시각만 기록합니다. 흐른 시간은 최대 정산 시간까지만 인정합니다
+// stage가 0이 아니면 정산 후 그 스테이지로 옮깁니다 (한 번에 한 단계씩만 올라갈 수 있고, 내려가는 것은 자유)
+// 올라가려면 현재 스테이지의 처치 수(이번 정산 포함)가 콘텐츠의 clear_kills 이상이어야 합니다
+// 정산 시각, 스테이지, 처치 수, 레벨·경험치, 골드와 레벨업 보상은 한 트랜잭션으로 반영됩니다
+func (s *IdleService) Sync(playerID uint, stage int) (*IdleEarnings, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	catalog := s.contentStore.Current()
+	rules := catalog.Idle
+	current := min(max(player.Stage, 1), rules.MaxStage)
+	next := current
+	if stage != 0 {
+		if stage < 1 || stage > min(current+1, rules.MaxStage) {
+			return nil, ErrInvalidStage
+		}
+		next = stage
+	}
+
+	now := time.Now()
+	earnings := &IdleEarnings{
+		Player:       player,
+		LastSyncedAt: player.IdleSyncedAt,
+		SyncedAt:     now,
+		Stage:        current,
+		ClearKills:   rules.ClearKills,
+	}
+	if player.IdleSyncedAt != nil {
+		earnings.Elapsed = max(now.Sub(*player.IdleSyncedAt), 0)
+	}
+	earnings.Credited = min(earnings.Elapsed, rules.MaxOffline())
+	earnings.Capped = earnings.Elapsed > earnings.Credited
+
+	if weapon := s.equippedWeapon(player); weapon != nil {
+		bonuses, err := s.upgradeService.GetStatBonuses(playerID)
+		if err != nil {
+			return nil, err
+		}
+		earnings.WeaponID = &weapon.ID
+		earnings.DamagePerSecond = weapon.DamagePerSecond(bonuses)
+	}
+	if earnings.DamagePerSecond > 0 && earnings.Credited > 0 {
+		// 처치 한 번에 걸리는 시간 (몬스터 등장 간격보다 짧을 수 없고, 방치 효율만큼 늘어남)
+		killSeconds := max(float64(rules.MonsterHP.At(current))/earnings.DamagePerSecond, rules.SpawnIntervalSeconds) / rules.OfflineRate
+		earnings.Kills = int(earnings.Credited.Seconds() / killSeconds)
+		if !earnings.Capped {
+			// 처치 하나를 채우지 못한 남은 시간은 버리지 않고 다음 정산으로 넘깁니다
+			used := min(time.Duration(float64(earnings.Kills)*killSeconds*float64(time.Second)), earnings.Credited)
+			earnings.SyncedAt = player.IdleSyncedAt.Add(used)
+		}
+	}
+	// 스테이지를 옮기지 않았으면 처치 수를 이어서 세고, 옮겼으면 새 스테이지에서 0부터 셉니다
+	stageKills := player.StageKills
+	if player.Stage != current {
+		stageKills = 0
+	}
+	stageKills += earnings.Kills
+	if next > current && stageKills < rules.ClearKills {
+		return nil, ErrStageNotCleared
+	}
+	if next != current {
+		stageKills = 0
+	}
+	earnings.StageKills = stageKills
+
+	earnings.Gold = int64(earnings.Kills) * rules.GoldPerKill.At(current)
+	earnings.Experience = int64(earnings.Kills) * rules.ExpPerKill.At(current)
+
+	gain, levelUps, err := newExperienceGain(player, earnings.Experience, catalog, now)
+	if err != nil {
+		return nil, err
+	}
+	earnings.LevelUps = levelUps
+	if earnings.Gold > 0 {
+		gain.Rewards = append([]models.WalletLedgerEntry{{
+			PlayerID: playerID,
+			Currency: models.CurrencyGold,
+			Amount:   earnings.Gold,
+			Reason:   models.LedgerReasonIdleHunt,
+			RefType:  "stage",
+			RefID:    strconv.Itoa(current),
+		}}, gain.Rewards...)
+	}
+
+	progress := repository.IdleProgress{
+		ExperienceGain: gain,
+		FromSyncedAt:   player.IdleSyncedAt,
+		SyncedAt:       earnings.SyncedAt,
+		Stage:          next,
+		StageKills:     stageKills,
+		Kills:          earnings.Kills,
+	}
+	if err := s.progressionRepo.ApplyIdleProgress(progress); err != nil {
+		if errors.Is(err, repository.ErrPlayerProgressChanged) {
+			return nil, ErrIdleSyncConflict
+		}
+		return nil, inventoryError(walletError(err))
+	}
+
+	player.Stage = next
+	player.StageKills = stageKills
+	player.IdleSyncedAt = &earnings.SyncedAt
+	player.TotalKills += earnings.Kills
+	return earnings, nil
+}
+
+// equippedWeapon은 플레이어가 장착한 무기를 조회합니다 (장착한 무기가 없거나 더 이상 플레이어의 무기가 아니면 nil)
+func (s *IdleService) equippedWeapon(player *models.Player) *models.Weapon {
+	if player.CurrentWeaponID == nil {
+		return nil
+	}
+	weapon, err := s.weaponRepo.FindByID(*player.CurrentWeaponID)
+	if err != nil || weapon.PlayerID != player.ID {
+		return nil
+	}
+	return weapon
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"slices"
+)
+
+var (
+	// ErrInvalidItemType는 정의되지 않은 아이템 종류일 때 반환됩니다
+	ErrInvalidItemType = errors.New("invalid item type")
+	// ErrItemNotFound는 콘텐츠 아이템 목록에 없는 아이템일 때 반환됩니다
+	ErrItemNotFound = errors.New("item not found")
+)
+
+// InsufficientItemsError는 소모할 아이템 수량이 부족할 때 반환됩니다
+type InsufficientItemsError struct {
+	ItemID string
+}
+
+func (e *InsufficientItemsError) Error() string {
+	return fmt.Sprintf("insufficient item %s", e.ItemID)
+}
+
+// ItemStackFullError는 획득하면 아이템의 최대 보유 수량을 넘을 때 반환됩니다
+type ItemStackFullError struct {
+	ItemID   string
+	MaxStack int64
+}
+
+func (e *ItemStackFullError) Error() string {
+	return fmt.Sprintf("item %s stack is full (max %d)", e.ItemID, e.MaxStack)
+}
+
+// InventoryEntry는 보유 아이템 수량과 그 아이템의 정의입니다
+type InventoryEntry struct {
+	Item       models.InventoryItem
+	Definition content.ItemDefinition
+}
+
+// InventoryService는 재료·소모품 아이템 목록과 플레이어 인벤토리 조회 비즈니스 로직을 담당합니다
+// 아이템 목록은 게임 콘텐츠의 items 섹션에서 읽고, 아이템 지급/소모는 각 기능의 Repository가 같은 트랜잭션 안에서 처리합니다
+type InventoryService struct {
+	playerRepo    repository.PlayerRepositoryInterface
+	inventoryRepo repository.InventoryRepositoryInterface
+	contentStore  *content.Store
+}
+
+// NewInventoryService는 새로운 InventoryService 인스턴스를 생성합니다
+func NewInventoryService(
+	playerRepo repository.PlayerRepositoryInterface,
+	inventoryRepo repository.InventoryRepositoryInterface,
+	contentStore *content.Store,
+) *InventoryService {
+	return &InventoryService{
+		playerRepo:    playerRepo,
+		inventoryRepo: inventoryRepo,
+		contentStore:  contentStore,
+	}
+}
+
+// GetCatalog는 모든 아이템 정의를 목록 순서대로 반환합니다 (types가 비어 있지 않으면 해당 종류만)
+func (s *InventoryService) GetCatalog(types []string) ([]content.ItemDefinition, error) {
+	for _, t := range types {
+		if !models.IsValidItemType(t) {
+			return nil, ErrInvalidItemType
+		}
+	}
+	catalog := s.contentStore.Current()
+	items := make([]content.ItemDefinition, 0, len(catalog.Items))
+	for _, item := range catalog.Items {
+		if len(types) == 0 || slices.Contains(types, item.Type) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// GetInventory는 플레이어가 보유한 아이템을 아이템 ID 순으로 조회합니다 (types가 비어 있으면 모든 종류)
+func (s *InventoryService) GetInventory(playerID uint, types []string, limit, offset int) ([]InventoryEntry, int64, error) {
+	for _, t := range types {
+		if !models.IsValidItemType(t) {
+			return nil, 0, ErrInvalidItemType
+		}
+	}
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return nil, 0, ErrPlayerNotFound
+	}
+
+	catalog := s.contentStore.Current()
+	filter := repository.InventoryFilter{PlayerID: playerID}
+	if len(types) > 0 {
+		filter.ItemIDs = itemIDsByTypes(catalog, types)
+		if len(filter.ItemIDs) == 0 {
+			return []InventoryEntry{}, 0, nil
+		}
+	}
+	items, total, err := s.inventoryRepo.Find(filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return inventoryEntries(catalog, items), total, nil
+}
+
+// itemIDsByTypes는 types 중 하나에 속하는 아이템 ID를 콘텐츠 목록 순서대로 반환합니다
+func itemIDsByTypes(catalog *content.Catalog, types []string) []string {
+	ids := make([]string, 0, len(catalog.Items))
+	for _, item := range catalog.Items {
+		if slices.Contains(types, item.Type) {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// inventoryEntries는 인벤토리 행에 콘텐츠의 아이템 정의를 붙입니다
+// 목록에서 빠진 아이템은 ID만 채운 정의로 남겨 보유 기록이 사라지지 않게 합니다
+func inventoryEntries(catalog *content.Catalog, items []models.InventoryItem) []InventoryEntry {
+	entries := make([]InventoryEntry, len(items))
+	for i, item := range items {
+		definition := content.ItemDefinition{ID: item.ItemID, Name: item.ItemID}
+		if found, ok := catalog.Item(item.ItemID); ok {
+			definition = *found
+		}
+		entries[i] = InventoryEntry{Item: item, Definition: definition}
+	}
+	return entries
+}
+
+// itemChange는 아이템 정의의 최대 보유 수량을 상한으로 하는 수량 변동을 만듭니다
+func itemChange(playerID uint, item *content.ItemDefinition, amount int64) repository.InventoryChange {
+	return repository.InventoryChange{
+		PlayerID: playerID,
+		ItemID:   item.ID,
+		Amount:   amount,
+		MaxStack: item.MaxStack,
+	}
+}
+
+// inventoryError는 Repository의 아이템 수량 오류를 서비스 오류로 변환합니다
+func inventoryError(err error) error {
+	var itemsErr *repository.InsufficientItemsError
+	if errors.As(err, &itemsErr) {
+		return &InsufficientItemsError{ItemID: itemsErr.ItemID}
+	}
+	var stackErr *repository.ItemStackFullError
+	if errors.As(err, &stackErr) {
+		return &ItemStackFullError{ItemID: stackErr.ItemID, MaxStack: stackErr.MaxStack}
+	}
+	return err
+}
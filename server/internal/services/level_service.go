@@ -0,0 +1,218 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrInvalidExperience는 지급할 경험치가 0 이하일 때 반환됩니다
+	ErrInvalidExperience = errors.New("experience amount must be positive")
+	// ErrExperienceConflict는 경험치를 반영하는 동안 (동시에 처리된 다른 요청 등으로) 플레이어 레벨이나 경험치가 바뀌었을 때 반환됩니다
+	ErrExperienceConflict = errors.New("player progress changed, please retry")
+)
+
+// LevelUpEvent는 레벨업 한 번과 그 레벨에 도달해 받은 보상입니다 (클라이언트 레벨업 연출용)
+type LevelUpEvent struct {
+	Level    int
+	Gold     int64
+	Items    []content.ItemReward
+	Dungeons []uint // 해금한 던전 ID
+}
+
+// ExperienceResult는 경험치 지급 결과입니다
+type ExperienceResult struct {
+	Player   *models.Player // 반영 후 레벨과 경험치
+	Amount   int64          // 지급한 경험치 (최고 레벨에 도달해 버린 경험치 포함)
+	LevelUps []LevelUpEvent // 오른 레벨마다 하나씩, 낮은 레벨부터
+}
+
+// LevelInfo는 레벨 하나의 필요 경험치와 도달 보상입니다
+type LevelInfo struct {
+	Level     int
+	ExpToNext int64                // 다음 레벨까지 필요한 경험치 (최고 레벨이면 0)
+	Reward    *content.LevelReward // 이 레벨에 도달할 때 받는 보상 (없으면 nil)
+}
+
+// LevelProgress는 플레이어의 레벨 진행 상황입니다
+type LevelProgress struct {
+	Level      int
+	MaxLevel   int
+	Experience int64
+	ExpToNext  int64      // 다음 레벨까지 필요한 경험치 (최고 레벨이면 0)
+	NextReward *LevelInfo // 다음으로 보상을 받는 레벨 (남은 보상이 없으면 nil)
+	Unlocks    []models.DungeonUnlock
+}
+
+// LevelService는 경험치 지급, 레벨업과 레벨업 보상 비즈니스 로직을 담당합니다
+// 레벨 곡선, 최고 레벨과 보상은 게임 콘텐츠의 levels 섹션에서 읽습니다
+type LevelService struct {
+	playerRepo      repository.PlayerRepositoryInterface
+	progressionRepo repository.ProgressionRepositoryInterface
+	contentStore    *content.Store
+}
+
+// NewLevelService는 새로운 LevelService 인스턴스를 생성합니다
+func NewLevelService(
+	playerRepo repository.PlayerRepositoryInterface,
+	progressionRepo repository.ProgressionRepositoryInterface,
+	contentStore *content.Store,
+) *LevelService {
+	return &LevelService{
+		playerRepo:      playerRepo,
+		progressionRepo: progressionRepo,
+		contentStore:    contentStore,
+	}
+}
+
+// GetLevels는 1레벨부터 최고 레벨까지의 필요 경험치와 도달 보상을 반환합니다
+func (s *LevelService) GetLevels() []LevelInfo {
+	levels := s.contentStore.Current().Levels
+	infos := make([]LevelInfo, levels.MaxLevel)
+	for i := range infos {
+		infos[i] = levelInfo(levels, i+1)
+	}
+	return infos
+}
+
+// GetProgress는 플레이어의 레벨 진행 상황과 해금한 던전을 반환합니다
+func (s *LevelService) GetProgress(playerID uint) (*LevelProgress, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	unlocks, err := s.progressionRepo.FindDungeonUnlocksByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := s.contentStore.Current().Levels
+	progress := &LevelProgress{
+		Level:      player.Level,
+		MaxLevel:   levels.MaxLevel,
+		Experience: player.Experience,
+		ExpToNext:  levelInfo(levels, player.Level).ExpToNext,
+		Unlocks:    unlocks,
+	}
+	for _, reward := range levels.Rewards {
+		if reward.Level > player.Level && (progress.NextReward == nil || reward.Level < progress.NextReward.Level) {
+			info := levelInfo(levels, reward.Level)
+			progress.NextReward = &info
+		}
+	}
+	return progress, nil
+}
+
+// GrantExperience는 플레이어에게 경험치를 지급하고, 레벨이 오르면 오른 레벨마다 보상(골드, 아이템, 던전 해금)을 함께 지급합니다
+// 레벨·경험치 변경과 보상 지급은 한 트랜잭션으로 처리되며, 아이템이 최대 보유 수량을 넘으면 넘는 만큼은 지급하지 않습니다
+func (s *LevelService) GrantExperience(playerID uint, amount int64) (*ExperienceResult, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidExperience
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	gain, levelUps, err := newExperienceGain(player, amount, s.contentStore.Current(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	result := &ExperienceResult{
+		Player:   player,
+		Amount:   amount,
+		LevelUps: levelUps,
+	}
+
+	if gain.Level == gain.FromLevel && gain.Experience == gain.FromExperience {
+		return result, nil // 최고 레벨이라 바뀐 것이 없음
+	}
+	if err := s.progressionRepo.GainExperience(gain); err != nil {
+		if errors.Is(err, repository.ErrPlayerProgressChanged) {
+			return nil, ErrExperienceConflict
+		}
+		return nil, inventoryError(walletError(err))
+	}
+	return result, nil
+}
+
+// newExperienceGain은 player에 경험치를 더해 바뀔 레벨·경험치와 오른 레벨마다의 보상을 계산합니다
+// player의 레벨과 경험치는 더한 뒤의 값으로 바뀝니다
+// 레벨 곡선과 보상, 보상 아이템 정의는 catalog에서 읽습니다
+func newExperienceGain(player *models.Player, amount int64, catalog *content.Catalog, now time.Time) (repository.ExperienceGain, []LevelUpEvent, error) {
+	levels := catalog.Levels
+	gain := repository.ExperienceGain{
+		PlayerID:       player.ID,
+		FromLevel:      player.Level,
+		FromExperience: player.Experience,
+	}
+	player.AddExperience(amount, levels.MaxLevel, levels.RequiredExp)
+	gain.Level = player.Level
+	gain.Experience = player.Experience
+
+	levelUps := make([]LevelUpEvent, 0, gain.Level-gain.FromLevel)
+	for level := gain.FromLevel + 1; level <= gain.Level; level++ {
+		event := LevelUpEvent{Level: level}
+		if reward, ok := levels.Reward(level); ok {
+			if err := addLevelReward(catalog, &gain, &event, reward, now); err != nil {
+				return gain, nil, err
+			}
+		}
+		levelUps = append(levelUps, event)
+	}
+	return gain, levelUps, nil
+}
+
+// addLevelReward는 레벨 도달 보상을 경험치 반영 내용과 레벨업 이벤트에 추가합니다
+func addLevelReward(catalog *content.Catalog, gain *repository.ExperienceGain, event *LevelUpEvent, reward *content.LevelReward, now time.Time) error {
+	refID := strconv.Itoa(reward.Level)
+	if reward.Gold > 0 {
+		gain.Rewards = append(gain.Rewards, models.WalletLedgerEntry{
+			PlayerID: gain.PlayerID,
+			Currency: models.CurrencyGold,
+			Amount:   reward.Gold,
+			Reason:   models.LedgerReasonLevelUp,
+			RefType:  "level",
+			RefID:    refID,
+		})
+	}
+	for _, item := range reward.Items {
+		definition, ok := catalog.Item(item.ID)
+		if !ok {
+			return ErrItemNotFound
+		}
+		change := itemChange(gain.PlayerID, definition, item.Quantity)
+		change.DiscardOverflow = true
+		gain.Items = append(gain.Items, change)
+	}
+	for _, dungeonID := range reward.Dungeons {
+		gain.Dungeons = append(gain.Dungeons, models.DungeonUnlock{
+			PlayerID:   gain.PlayerID,
+			DungeonID:  dungeonID,
+			Level:      reward.Level,
+			UnlockedAt: now,
+		})
+	}
+
+	event.Gold = reward.Gold
+	event.Items = reward.Items
+	event.Dungeons = reward.Dungeons
+	return nil
+}
+
+// levelInfo는 레벨의 필요 경험치와 도달 보상을 반환합니다
+func levelInfo(levels content.LevelTable, level int) LevelInfo {
+	info := LevelInfo{Level: level}
+	if level < levels.MaxLevel {
+		info.ExpToNext = levels.RequiredExp(level)
+	}
+	if reward, ok := levels.Reward(level); ok {
+		info.Reward = reward
+	}
+	return info
+}
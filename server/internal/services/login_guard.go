@@ -0,0 +1,128 @@
+package services
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/repository"
+	"strings"
+	"time"
+)
+
+// LoginLockedError는 로그인 시도가 일시적으로 잠겼을 때 반환됩니다
+type LoginLockedError struct {
+	RetryAfter time.Duration // 다시 시도할 수 있을 때까지 남은 시간
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("too many login attempts, retry after %s", e.RetryAfter)
+}
+
+// LoginGuard는 사용자명/IP별 로그인 실패를 추적하여 무차별 대입 공격을 막습니다
+// 허용 횟수를 넘긴 뒤에는 실패할 때마다 잠금 시간이 2배씩 늘어납니다 (최대값 제한)
+type LoginGuard struct {
+	store            repository.LoginAttemptStoreInterface
+	maxFailures      int
+	maxFailuresPerIP int
+	lockoutBase      time.Duration
+	lockoutMax       time.Duration
+	failureWindow    time.Duration
+}
+
+// NewLoginGuard는 새로운 LoginGuard 인스턴스를 생성합니다
+func NewLoginGuard(store repository.LoginAttemptStoreInterface, cfg *config.Config) *LoginGuard {
+	return &LoginGuard{
+		store:            store,
+		maxFailures:      cfg.LoginMaxFailures,
+		maxFailuresPerIP: cfg.LoginMaxFailuresPerIP,
+		lockoutBase:      time.Duration(cfg.LoginLockoutBase) * time.Second,
+		lockoutMax:       time.Duration(cfg.LoginLockoutMax) * time.Second,
+		failureWindow:    time.Duration(cfg.LoginFailureWindow) * time.Minute,
+	}
+}
+
+// Check는 사용자명 또는 IP가 잠겨 있으면 *LoginLockedError를 반환합니다
+// 잠긴 상태에서는 비밀번호 검증(bcrypt)을 아예 수행하지 않습니다
+func (g *LoginGuard) Check(username, ip string) error {
+	now := time.Now()
+	var retryAfter time.Duration
+
+	for _, key := range g.keys(username, ip) {
+		attempt, err := g.store.Get(key)
+		if err != nil {
+			return err
+		}
+		if remaining := attempt.LockedUntil.Sub(now); remaining > retryAfter {
+			retryAfter = remaining
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordFailure는 로그인 실패를 기록하고, 허용 횟수를 넘기면 잠금을 설정합니다
+func (g *LoginGuard) RecordFailure(username, ip string) error {
+	now := time.Now()
+
+	limits := map[string]int{
+		usernameKey(username): g.maxFailures,
+	}
+	if ip != "" {
+		limits[ipKey(ip)] = g.maxFailuresPerIP
+	}
+
+	for key, limit := range limits {
+		attempt, err := g.store.IncrementFailure(key, now, g.failureWindow)
+		if err != nil {
+			return err
+		}
+		if attempt.Failures < limit {
+			continue
+		}
+
+		lockout := g.lockoutDuration(attempt.Failures - limit)
+		if err := g.store.Lock(key, now.Add(lockout), lockout+g.failureWindow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordSuccess는 로그인 성공 시 사용자명의 실패 기록을 초기화합니다
+// IP 기록은 유지하여 한 IP에서 여러 계정을 대입하는 공격(credential stuffing)을 계속 추적합니다
+func (g *LoginGuard) RecordSuccess(username string) error {
+	return g.store.Reset(usernameKey(username))
+}
+
+// lockoutDuration은 허용 횟수 초과분에 따른 잠금 시간을 계산합니다 (base * 2^excess, 최대값 제한)
+func (g *LoginGuard) lockoutDuration(excess int) time.Duration {
+	lockout := g.lockoutBase
+	for i := 0; i < excess && lockout < g.lockoutMax; i++ {
+		lockout *= 2
+	}
+	if lockout > g.lockoutMax {
+		lockout = g.lockoutMax
+	}
+	return lockout
+}
+
+// keys는 확인할 저장소 키 목록을 반환합니다
+func (g *LoginGuard) keys(username, ip string) []string {
+	keys := []string{usernameKey(username)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	return keys
+}
+
+// usernameKey는 사용자명 기준 저장소 키입니다 (대소문자 구분 없이 추적)
+func usernameKey(username string) string {
+	return "login:user:" + strings.ToLower(username)
+}
+
+// ipKey는 IP 기준 저장소 키입니다
+func ipKey(ip string) string {
+	return "login:ip:" + ip
+}
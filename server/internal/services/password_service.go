@@ -0,0 +1,202 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/notifier"
+	"game_eating_pizza/internal/repository"
+	"game_eating_pizza/pkg/token"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// passwordResetCodeLength는 재설정 코드 길이입니다 (32종 문자 8자리 = 약 1조 가지)
+const passwordResetCodeLength = 8
+
+var (
+	// ErrIncorrectPassword는 비밀번호 변경 시 현재 비밀번호가 틀렸을 때 반환됩니다
+	ErrIncorrectPassword = errors.New("current password is incorrect")
+	// ErrPasswordUnchanged는 새 비밀번호가 현재 비밀번호와 같을 때 반환됩니다
+	ErrPasswordUnchanged = errors.New("new password must differ from current password")
+	// ErrGuestAccount는 비밀번호가 없는 게스트 계정에 비밀번호 관련 작업을 시도했을 때 반환됩니다
+	ErrGuestAccount = errors.New("guest account has no password, link the account first")
+	// ErrInvalidResetCode는 재설정 코드가 없거나, 만료되었거나, 이미 사용되었을 때 반환됩니다
+	ErrInvalidResetCode = errors.New("invalid or expired reset code")
+)
+
+// PasswordService는 비밀번호 변경 및 재설정 비즈니스 로직을 담당합니다
+type PasswordService struct {
+	playerRepo        repository.PlayerRepositoryInterface
+	passwordResetRepo repository.PasswordResetRepositoryInterface
+	authService       *AuthService
+	sessionService    *SessionService
+	loginGuard        *LoginGuard
+	notifier          notifier.Notifier
+	cfg               *config.Config
+}
+
+// NewPasswordService는 새로운 PasswordService 인스턴스를 생성합니다
+func NewPasswordService(
+	playerRepo repository.PlayerRepositoryInterface,
+	passwordResetRepo repository.PasswordResetRepositoryInterface,
+	authService *AuthService,
+	sessionService *SessionService,
+	loginGuard *LoginGuard,
+	notifier notifier.Notifier,
+	cfg *config.Config,
+) *PasswordService {
+	return &PasswordService{
+		playerRepo:        playerRepo,
+		passwordResetRepo: passwordResetRepo,
+		authService:       authService,
+		sessionService:    sessionService,
+		loginGuard:        loginGuard,
+		notifier:          notifier,
+		cfg:               cfg,
+	}
+}
+
+// ChangePassword는 현재 비밀번호를 확인한 뒤 새 비밀번호로 변경합니다
+// 변경에 사용한 기기(currentSessionID)를 제외한 모든 세션을 종료합니다
+func (s *PasswordService) ChangePassword(playerID, currentSessionID uint, currentPassword, newPassword, ip string) error {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return errors.New("player not found")
+	}
+	if player.IsGuest {
+		return ErrGuestAccount
+	}
+
+	// 탈취된 액세스 토큰으로 현재 비밀번호를 대입하는 것도 로그인과 같은 기준으로 제한합니다
+	if err := s.loginGuard.Check(player.Username, ip); err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(player.Password), []byte(currentPassword)); err != nil {
+		if err := s.loginGuard.RecordFailure(player.Username, ip); err != nil {
+			return err
+		}
+		return ErrIncorrectPassword
+	}
+	if currentPassword == newPassword {
+		return ErrPasswordUnchanged
+	}
+
+	if err := s.setPassword(player, newPassword); err != nil {
+		return err
+	}
+	if err := s.loginGuard.RecordSuccess(player.Username); err != nil {
+		return err
+	}
+
+	// 이전에 요청해 둔 재설정 코드로 비밀번호가 다시 바뀌지 않도록 무효화합니다
+	if err := s.passwordResetRepo.InvalidateAllByPlayerID(player.ID); err != nil {
+		return err
+	}
+	return s.sessionService.RevokeOtherSessions(player.ID, currentSessionID)
+}
+
+// RequestPasswordReset은 재설정 코드를 발급하여 Notifier로 전달합니다
+// 사용자명 존재 여부가 드러나지 않도록 없는 계정이나 게스트 계정이어도 에러 없이 종료합니다
+func (s *PasswordService) RequestPasswordReset(username, ip string) error {
+	if err := s.loginGuard.Check(username, ip); err != nil {
+		return err
+	}
+
+	player, err := s.playerRepo.FindByUsername(username)
+	if err != nil || player.IsGuest {
+		return nil
+	}
+
+	code, err := token.GenerateCode(passwordResetCodeLength)
+	if err != nil {
+		return err
+	}
+
+	// 가장 최근에 발급된 코드 하나만 유효하도록 이전 코드를 무효화합니다
+	if err := s.passwordResetRepo.InvalidateAllByPlayerID(player.ID); err != nil {
+		return err
+	}
+
+	reset := &models.PasswordReset{
+		PlayerID:  player.ID,
+		CodeHash:  token.HashOpaqueToken(code),
+		ExpiresAt: time.Now().Add(time.Duration(s.cfg.PasswordResetExpiration) * time.Minute),
+	}
+	if err := s.passwordResetRepo.Create(reset); err != nil {
+		return err
+	}
+
+	// 전달 실패는 응답으로 드러내지 않습니다 (계정 존재 여부 노출 방지)
+	if err := s.notifier.SendPasswordResetCode(player, code, reset.ExpiresAt); err != nil {
+		log.Printf("Failed to send password reset code to player %d: %v", player.ID, err)
+	}
+	return nil
+}
+
+// ResetPassword는 재설정 코드를 확인하고 새 비밀번호를 설정합니다
+// 코드는 한 번만 사용할 수 있으며, 성공하면 모든 기기의 세션과 리프레시 토큰을 폐기합니다
+func (s *PasswordService) ResetPassword(username, code, newPassword, ip string) error {
+	// 코드 대입도 로그인 실패와 같은 기준으로 잠급니다
+	if err := s.loginGuard.Check(username, ip); err != nil {
+		return err
+	}
+
+	player, err := s.playerRepo.FindByUsername(username)
+	if err != nil || player.IsGuest {
+		return s.resetFailed(username, ip)
+	}
+
+	reset, err := s.passwordResetRepo.FindUsable(player.ID, token.HashOpaqueToken(normalizeResetCode(code)), time.Now())
+	if err != nil {
+		return s.resetFailed(username, ip)
+	}
+
+	// 동시에 같은 코드로 요청해도 하나만 성공합니다
+	if err := s.passwordResetRepo.MarkUsed(reset.ID); err != nil {
+		if errors.Is(err, repository.ErrPasswordResetAlreadyUsed) {
+			return ErrInvalidResetCode
+		}
+		return err
+	}
+
+	if err := s.setPassword(player, newPassword); err != nil {
+		return err
+	}
+	if err := s.loginGuard.RecordSuccess(player.Username); err != nil {
+		return err
+	}
+
+	return s.authService.LogoutAll(player.ID)
+}
+
+// resetFailed는 재설정 실패를 기록하고 클라이언트에 돌려줄 에러를 반환합니다
+func (s *PasswordService) resetFailed(username, ip string) error {
+	if err := s.loginGuard.RecordFailure(username, ip); err != nil {
+		return err
+	}
+	return ErrInvalidResetCode
+}
+
+// setPassword는 비밀번호를 해시하여 저장합니다
+func (s *PasswordService) setPassword(player *models.Player, password string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := s.playerRepo.UpdatePassword(player.ID, string(hashedPassword)); err != nil {
+		return err
+	}
+	player.Password = string(hashedPassword)
+	return nil
+}
+
+// normalizeResetCode는 사용자가 입력한 코드의 공백, 하이픈, 대소문자 차이를 정리합니다
+func normalizeResetCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
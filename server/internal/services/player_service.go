@@ -1,24 +1,74 @@
 package services
 
 import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/content"
 	"game_eating_pizza/internal/models"
 	"game_eating_pizza/internal/repository"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// 프로필 필드 제약 조건입니다
+const (
+	displayNameMinLength = 2
+	displayNameMaxLength = 20
+)
+
+// ErrPlayerNotFound는 플레이어를 찾을 수 없을 때 반환됩니다
+var ErrPlayerNotFound = errors.New("player not found")
+
+// avatarIDPattern은 아바타 식별자 형식입니다 (예: "knight_01")
+var avatarIDPattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// ProfileFieldError는 프로필 수정 요청의 특정 필드가 유효하지 않을 때 반환됩니다
+type ProfileFieldError struct {
+	Field  string // JSON 필드명
+	Reason string
+}
+
+func (e *ProfileFieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// ProfileUpdate는 프로필 부분 수정 내용입니다 (nil인 필드는 변경하지 않음)
+type ProfileUpdate struct {
+	DisplayName   *string
+	AvatarID      *string
+	Locale        *string
+	Timezone      *string
+	Notifications NotificationPreferencesUpdate
+}
+
+// NotificationPreferencesUpdate는 알림 설정 부분 수정 내용입니다 (nil인 항목은 변경하지 않음)
+type NotificationPreferencesUpdate struct {
+	ForgeComplete *bool
+	StepGoal      *bool
+	Raid          *bool
+	Events        *bool
+}
+
 // PlayerService는 플레이어 관련 비즈니스 로직을 담당합니다
 type PlayerService struct {
-	playerRepo repository.PlayerRepositoryInterface
-	weaponRepo repository.WeaponRepositoryInterface
+	playerRepo   repository.PlayerRepositoryInterface
+	weaponRepo   repository.WeaponRepositoryInterface
+	contentStore *content.Store
 }
 
 // NewPlayerService는 새로운 PlayerService 인스턴스를 생성합니다
 func NewPlayerService(
 	playerRepo repository.PlayerRepositoryInterface,
 	weaponRepo repository.WeaponRepositoryInterface,
+	contentStore *content.Store,
 ) *PlayerService {
 	return &PlayerService{
-		playerRepo: playerRepo,
-		weaponRepo: weaponRepo,
+		playerRepo:   playerRepo,
+		weaponRepo:   weaponRepo,
+		contentStore: contentStore,
 	}
 }
 
@@ -27,6 +77,88 @@ func (s *PlayerService) GetPlayerByID(id uint) (*models.Player, error) {
 	return s.playerRepo.FindByID(id)
 }
 
+// UpdateProfile은 플레이어 프로필을 부분 수정하고 수정된 플레이어를 반환합니다
+// 골드, 레벨, 경험치 등 서버가 관리하는 값은 이 경로로 변경할 수 없습니다
+func (s *PlayerService) UpdateProfile(playerID uint, update ProfileUpdate) (*models.Player, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	if update.DisplayName != nil {
+		displayName, err := validateDisplayName(*update.DisplayName)
+		if err != nil {
+			return nil, err
+		}
+		player.DisplayName = displayName
+	}
+	if update.AvatarID != nil {
+		if !avatarIDPattern.MatchString(*update.AvatarID) {
+			return nil, &ProfileFieldError{Field: "avatar_id", Reason: "must be 1-32 lowercase letters, digits or underscores"}
+		}
+		player.AvatarID = *update.AvatarID
+	}
+	if update.Locale != nil {
+		if !slices.Contains(models.SupportedLocales, *update.Locale) {
+			return nil, &ProfileFieldError{Field: "locale", Reason: "must be one of " + strings.Join(models.SupportedLocales, ", ")}
+		}
+		player.Locale = *update.Locale
+	}
+	if update.Timezone != nil {
+		if !models.IsValidTimezone(*update.Timezone) {
+			return nil, &ProfileFieldError{Field: "timezone", Reason: "must be an IANA time zone name such as Asia/Seoul"}
+		}
+		player.Timezone = *update.Timezone
+	}
+
+	notifications := update.Notifications
+	if notifications.ForgeComplete != nil {
+		player.Notifications.ForgeComplete = *notifications.ForgeComplete
+	}
+	if notifications.StepGoal != nil {
+		player.Notifications.StepGoal = *notifications.StepGoal
+	}
+	if notifications.Raid != nil {
+		player.Notifications.Raid = *notifications.Raid
+	}
+	if notifications.Events != nil {
+		player.Notifications.Events = *notifications.Events
+	}
+
+	if err := s.playerRepo.UpdateProfile(player); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// validateDisplayName은 표시 이름의 앞뒤 공백을 정리하고 길이와 문자를 검증합니다
+// 빈 문자열은 표시 이름을 지우는 것으로 간주합니다 (이후 Username으로 표시)
+func validateDisplayName(displayName string) (string, error) {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return "", nil
+	}
+
+	length := utf8.RuneCountInString(displayName)
+	if length < displayNameMinLength || length > displayNameMaxLength {
+		return "", &ProfileFieldError{
+			Field:  "display_name",
+			Reason: fmt.Sprintf("must be %d-%d characters", displayNameMinLength, displayNameMaxLength),
+		}
+	}
+
+	// 한글 등 유니코드 문자와 숫자, 공백, 밑줄, 하이픈만 허용합니다
+	for _, r := range displayName {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '_' && r != '-' {
+			return "", &ProfileFieldError{
+				Field:  "display_name",
+				Reason: "may only contain letters, digits, spaces, underscores and hyphens",
+			}
+		}
+	}
+	return displayName, nil
+}
+
 // GetTopPlayersByLevel은 레벨이 높은 상위 플레이어를 조회합니다
 func (s *PlayerService) GetTopPlayersByLevel(limit int) ([]models.Player, error) {
 	return s.playerRepo.FindTopPlayersByLevel(limit)
@@ -37,30 +169,27 @@ func (s *PlayerService) CreatePlayer(username string) (*models.Player, error) {
 	player := &models.Player{
 		Username: username,
 		Level:    1,
-		Gold:     0,
+
+		Locale:        models.LocaleKorean,
+		Timezone:      models.DefaultTimezone,
+		Notifications: models.DefaultNotificationPreferences(),
 	}
 
 	if err := s.playerRepo.Create(player); err != nil {
 		return nil, err
 	}
 
-	// 기본 무기 생성
-	defaultWeapon := &models.Weapon{
-		PlayerID:    player.ID,
-		Name:        "Basic Sword",
-		Type:        "sword",
-		AttackPower: 10,
-		AttackSpeed: 1.0,
-		Rarity:      "common",
-		Level:       1,
-	}
+	// 기본 무기 생성 (콘텐츠 카탈로그의 starter_weapon)
+	catalog := s.contentStore.Current()
+	template, _ := catalog.Weapon(catalog.StarterWeapon)
+	defaultWeapon := newWeaponFromTemplate(template, player.ID, catalog.LowestRarity().ID)
 
 	if err := s.weaponRepo.Create(defaultWeapon); err != nil {
 		return nil, err
 	}
 
 	player.CurrentWeaponID = &defaultWeapon.ID
-	s.playerRepo.Update(player)
+	s.playerRepo.UpdateCurrentWeapon(player.ID, player.CurrentWeaponID)
 
 	return player, nil
 }
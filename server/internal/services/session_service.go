@@ -0,0 +1,96 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"log"
+	"time"
+)
+
+// sessionTouchInterval은 마지막 접속 정보를 갱신하는 최소 간격입니다
+// 매 요청마다 DB 쓰기가 발생하지 않도록 일정 시간이 지난 경우에만 갱신합니다
+const sessionTouchInterval = time.Minute
+
+var (
+	// ErrSessionNotFound는 세션이 없거나 다른 플레이어의 세션일 때 반환됩니다
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrSessionRevoked는 로그아웃 등으로 종료된 세션일 때 반환됩니다
+	ErrSessionRevoked = errors.New("session revoked")
+)
+
+// SessionService는 기기별 세션 관리 비즈니스 로직을 담당합니다
+type SessionService struct {
+	sessionRepo      repository.SessionRepositoryInterface
+	refreshTokenRepo repository.RefreshTokenRepositoryInterface
+}
+
+// NewSessionService는 새로운 SessionService 인스턴스를 생성합니다
+func NewSessionService(
+	sessionRepo repository.SessionRepositoryInterface,
+	refreshTokenRepo repository.RefreshTokenRepositoryInterface,
+) *SessionService {
+	return &SessionService{
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+	}
+}
+
+// GetActiveSessions는 플레이어의 활성 세션 목록을 조회합니다
+func (s *SessionService) GetActiveSessions(playerID uint) ([]models.Session, error) {
+	return s.sessionRepo.FindActiveByPlayerID(playerID)
+}
+
+// RevokeSession은 플레이어 소유의 세션을 종료하고 해당 세션의 리프레시 토큰을 폐기합니다
+func (s *SessionService) RevokeSession(playerID, sessionID uint) error {
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil || session.PlayerID != playerID {
+		return ErrSessionNotFound
+	}
+
+	if err := s.sessionRepo.Revoke(session.ID); err != nil {
+		return err
+	}
+	return s.refreshTokenRepo.RevokeFamily(session.RefreshFamilyID)
+}
+
+// RevokeOtherSessions는 현재 세션(keepSessionID)을 제외한 플레이어의 모든 세션을 종료합니다
+func (s *SessionService) RevokeOtherSessions(playerID, keepSessionID uint) error {
+	sessions, err := s.sessionRepo.FindActiveByPlayerID(playerID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == keepSessionID {
+			continue
+		}
+		if err := s.sessionRepo.Revoke(session.ID); err != nil {
+			return err
+		}
+		if err := s.refreshTokenRepo.RevokeFamily(session.RefreshFamilyID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSession은 액세스 토큰의 세션이 아직 유효한지 확인하고 마지막 접속 정보를 갱신합니다
+func (s *SessionService) ValidateSession(playerID, sessionID uint, ip string) error {
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil || session.PlayerID != playerID {
+		return ErrSessionNotFound
+	}
+	if session.IsRevoked() {
+		return ErrSessionRevoked
+	}
+
+	now := time.Now()
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval || session.LastIP != ip {
+		// 접속 정보 갱신 실패는 인증 자체를 막을 이유가 아니므로 기록만 남깁니다
+		if err := s.sessionRepo.Touch(session.ID, now, ip); err != nil {
+			log.Printf("Failed to touch session %d: %v", session.ID, err)
+		}
+	}
+	return nil
+}
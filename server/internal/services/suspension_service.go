@@ -0,0 +1,46 @@
+package services
+
+import (
+	"fmt"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"time"
+)
+
+// AccountSuspendedError는 이용 정지된 계정이 로그인하거나 API를 호출할 때 반환됩니다
+type AccountSuspendedError struct {
+	Suspension *models.Suspension // 현재 유효한 정지 (사유, 만료 시각 안내용)
+}
+
+func (e *AccountSuspendedError) Error() string {
+	if e.Suspension.IsPermanent() {
+		return "account is permanently suspended"
+	}
+	return fmt.Sprintf("account is suspended until %s", e.Suspension.ExpiresAt.Format(time.RFC3339))
+}
+
+// SuspensionService는 이용 정지 적용 여부를 확인합니다
+// 정지 발급/해제는 감사 로그가 필요한 운영 작업이므로 AdminService가 담당합니다
+type SuspensionService struct {
+	suspensionRepo repository.SuspensionRepositoryInterface
+}
+
+// NewSuspensionService는 새로운 SuspensionService 인스턴스를 생성합니다
+func NewSuspensionService(suspensionRepo repository.SuspensionRepositoryInterface) *SuspensionService {
+	return &SuspensionService{
+		suspensionRepo: suspensionRepo,
+	}
+}
+
+// Check는 플레이어에게 유효한 이용 정지가 있으면 *AccountSuspendedError를 반환합니다
+// 기간이 지난 정지는 별도 처리 없이 자동으로 무시됩니다
+func (s *SuspensionService) Check(playerID uint) error {
+	suspension, err := s.suspensionRepo.FindActiveByPlayerID(playerID, time.Now())
+	if err != nil {
+		return err
+	}
+	if suspension != nil {
+		return &AccountSuspendedError{Suspension: suspension}
+	}
+	return nil
+}
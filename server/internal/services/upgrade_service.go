@@ -0,0 +1,231 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+)
+
+var (
+	// ErrUpgradeNotFound는 존재하지 않는 강화 노드일 때 반환됩니다
+	ErrUpgradeNotFound = errors.New("upgrade not found")
+	// ErrUpgradeMaxed는 이미 최대 단계까지 구매한 강화 노드일 때 반환됩니다
+	ErrUpgradeMaxed = errors.New("upgrade already at max level")
+	// ErrUpgradeLocked는 선행 강화 조건을 만족하지 않은 노드를 구매하려 할 때 반환됩니다
+	ErrUpgradeLocked = errors.New("upgrade is locked")
+	// ErrUpgradeConflict는 같은 단계를 동시에 구매하려 했을 때 반환됩니다
+	ErrUpgradeConflict = errors.New("upgrade level changed, please retry")
+)
+
+// UpgradeNode는 활력의 불씨로 구매하는 영구 강화 트리의 노드입니다
+type UpgradeNode struct {
+	ID            string
+	Name          string
+	Description   string
+	Stat          string  // 올리는 능력치 (models.StatAttackSpeed 등)
+	BonusPerLevel float64 // 단계마다 더해지는 능력치 보너스 비율 (0.02 = +2%)
+	MaxLevel      int
+	BaseCost      int64  // 1단계 구매 비용 (불씨)
+	CostStep      int64  // 단계가 오를 때마다 늘어나는 비용
+	Requires      string // 선행 노드 ID (없으면 빈 문자열)
+	RequiresLevel int    // 선행 노드에 필요한 단계
+}
+
+// CostForLevel은 level 단계를 구매하는 데 필요한 불씨입니다
+func (n UpgradeNode) CostForLevel(level int) int64 {
+	return n.BaseCost + n.CostStep*int64(level-1)
+}
+
+// upgradeNodes는 영구 강화 트리입니다 (선행 노드가 먼저 오도록 정렬)
+var upgradeNodes = []UpgradeNode{
+	{
+		ID:            "quick_hands",
+		Name:          "재빠른 손놀림",
+		Description:   "단계마다 무기 공격 속도 +2%",
+		Stat:          models.StatAttackSpeed,
+		BonusPerLevel: 0.02,
+		MaxLevel:      10,
+		BaseCost:      3,
+		CostStep:      1,
+	},
+	{
+		ID:            "tempered_grip",
+		Name:          "단련된 손잡이",
+		Description:   "단계마다 무기 공격력 +3%",
+		Stat:          models.StatAttackPower,
+		BonusPerLevel: 0.03,
+		MaxLevel:      5,
+		BaseCost:      5,
+		CostStep:      3,
+		Requires:      "quick_hands",
+		RequiresLevel: 3,
+	},
+	{
+		ID:            "ember_rhythm",
+		Name:          "불씨의 리듬",
+		Description:   "단계마다 무기 공격 속도 +5%",
+		Stat:          models.StatAttackSpeed,
+		BonusPerLevel: 0.05,
+		MaxLevel:      5,
+		BaseCost:      10,
+		CostStep:      5,
+		Requires:      "quick_hands",
+		RequiresLevel: 5,
+	},
+	{
+		ID:            "heart_of_forge",
+		Name:          "화로의 심장",
+		Description:   "무기 공격 속도 +10%",
+		Stat:          models.StatAttackSpeed,
+		BonusPerLevel: 0.10,
+		MaxLevel:      1,
+		BaseCost:      50,
+		Requires:      "ember_rhythm",
+		RequiresLevel: 5,
+	},
+}
+
+// findUpgradeNode는 ID로 강화 노드를 찾습니다
+func findUpgradeNode(id string) (UpgradeNode, bool) {
+	for _, node := range upgradeNodes {
+		if node.ID == id {
+			return node, true
+		}
+	}
+	return UpgradeNode{}, false
+}
+
+// UpgradeNodeStatus는 플레이어 기준 강화 노드 상태입니다
+type UpgradeNodeStatus struct {
+	Node     UpgradeNode
+	Level    int   // 구매한 단계
+	NextCost int64 // 다음 단계 비용 (최대 단계면 0)
+	Locked   bool  // 선행 조건을 만족하지 않음
+	Maxed    bool  // 최대 단계 도달
+}
+
+// UpgradeTree는 플레이어의 강화 트리 상태와 합산된 능력치 보너스입니다
+type UpgradeTree struct {
+	Nodes   []UpgradeNodeStatus
+	Bonuses models.StatBonuses
+	Embers  int64 // 현재 보유한 활력의 불씨
+}
+
+// UpgradeService는 활력의 불씨로 구매하는 영구 강화 비즈니스 로직을 담당합니다
+type UpgradeService struct {
+	playerRepo  repository.PlayerRepositoryInterface
+	upgradeRepo repository.PlayerUpgradeRepositoryInterface
+}
+
+// NewUpgradeService는 새로운 UpgradeService 인스턴스를 생성합니다
+func NewUpgradeService(
+	playerRepo repository.PlayerRepositoryInterface,
+	upgradeRepo repository.PlayerUpgradeRepositoryInterface,
+) *UpgradeService {
+	return &UpgradeService{
+		playerRepo:  playerRepo,
+		upgradeRepo: upgradeRepo,
+	}
+}
+
+// GetTree는 플레이어의 강화 트리 상태를 조회합니다
+func (s *UpgradeService) GetTree(playerID uint) (*UpgradeTree, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	levels, err := s.levels(playerID)
+	if err != nil {
+		return nil, err
+	}
+	tree := newUpgradeTree(levels)
+	tree.Embers = player.Balance(models.CurrencyEmbers)
+	return tree, nil
+}
+
+// Purchase는 강화 노드의 다음 단계를 구매합니다
+// 불씨 차감과 단계 상승은 같은 트랜잭션에서 처리되며, 불씨가 부족하면 *InsufficientFundsError를 반환합니다
+func (s *UpgradeService) Purchase(playerID uint, nodeID string) (*UpgradeTree, error) {
+	node, ok := findUpgradeNode(nodeID)
+	if !ok {
+		return nil, ErrUpgradeNotFound
+	}
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return nil, ErrPlayerNotFound
+	}
+
+	levels, err := s.levels(playerID)
+	if err != nil {
+		return nil, err
+	}
+	status := newUpgradeNodeStatus(node, levels)
+	if status.Maxed {
+		return nil, ErrUpgradeMaxed
+	}
+	if status.Locked {
+		return nil, ErrUpgradeLocked
+	}
+
+	if _, err := s.upgradeRepo.Purchase(playerID, node.ID, status.Level+1, status.NextCost); err != nil {
+		if errors.Is(err, repository.ErrUpgradeLevelChanged) {
+			return nil, ErrUpgradeConflict
+		}
+		return nil, walletError(err)
+	}
+	return s.GetTree(playerID)
+}
+
+// GetStatBonuses는 플레이어가 구매한 영구 강화의 능력치 보너스 합계를 반환합니다
+func (s *UpgradeService) GetStatBonuses(playerID uint) (models.StatBonuses, error) {
+	levels, err := s.levels(playerID)
+	if err != nil {
+		return models.StatBonuses{}, err
+	}
+	return newUpgradeTree(levels).Bonuses, nil
+}
+
+// levels는 노드 ID별 구매 단계를 조회합니다
+func (s *UpgradeService) levels(playerID uint) (map[string]int, error) {
+	upgrades, err := s.upgradeRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	levels := make(map[string]int, len(upgrades))
+	for _, upgrade := range upgrades {
+		levels[upgrade.NodeID] = upgrade.Level
+	}
+	return levels, nil
+}
+
+// newUpgradeTree는 노드별 구매 단계로 트리 상태와 능력치 보너스 합계를 계산합니다
+// 트리에서 빠진 노드의 구매 기록은 무시합니다
+func newUpgradeTree(levels map[string]int) *UpgradeTree {
+	tree := &UpgradeTree{Nodes: make([]UpgradeNodeStatus, len(upgradeNodes))}
+	for i, node := range upgradeNodes {
+		status := newUpgradeNodeStatus(node, levels)
+		tree.Nodes[i] = status
+
+		bonus := node.BonusPerLevel * float64(status.Level)
+		switch node.Stat {
+		case models.StatAttackSpeed:
+			tree.Bonuses.AttackSpeed += bonus
+		case models.StatAttackPower:
+			tree.Bonuses.AttackPower += bonus
+		}
+	}
+	return tree
+}
+
+// newUpgradeNodeStatus는 구매 단계로 노드의 잠금/최대 단계 여부와 다음 비용을 계산합니다
+func newUpgradeNodeStatus(node UpgradeNode, levels map[string]int) UpgradeNodeStatus {
+	status := UpgradeNodeStatus{
+		Node:  node,
+		Level: min(levels[node.ID], node.MaxLevel),
+	}
+	status.Maxed = status.Level >= node.MaxLevel
+	status.Locked = node.Requires != "" && levels[node.Requires] < node.RequiresLevel
+	if !status.Maxed {
+		status.NextCost = node.CostForLevel(status.Level + 1)
+	}
+	return status
+}
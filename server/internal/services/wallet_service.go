@@ -0,0 +1,85 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+)
+
+// ErrInvalidCurrency는 정의되지 않은 재화 종류일 때 반환됩니다
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// InsufficientFundsError는 차감할 재화가 부족할 때 반환됩니다
+type InsufficientFundsError struct {
+	Currency string
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient %s", e.Currency)
+}
+
+// WalletService는 플레이어 지갑(재화 잔액)과 재화 원장 조회 비즈니스 로직을 담당합니다
+// 재화 지급/차감은 각 기능의 Repository가 같은 트랜잭션 안에서 원장과 함께 처리합니다
+type WalletService struct {
+	playerRepo repository.PlayerRepositoryInterface
+	walletRepo repository.WalletRepositoryInterface
+}
+
+// NewWalletService는 새로운 WalletService 인스턴스를 생성합니다
+func NewWalletService(
+	playerRepo repository.PlayerRepositoryInterface,
+	walletRepo repository.WalletRepositoryInterface,
+) *WalletService {
+	return &WalletService{
+		playerRepo: playerRepo,
+		walletRepo: walletRepo,
+	}
+}
+
+// GetBalances는 플레이어의 모든 재화 잔액을 재화 종류 순서대로 반환합니다 (받은 적 없는 재화는 0)
+func (s *WalletService) GetBalances(playerID uint) ([]models.WalletBalance, error) {
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	stored, err := s.walletRepo.FindBalances(playerID)
+	if err != nil {
+		return nil, err
+	}
+	return walletBalances(playerID, stored), nil
+}
+
+// GetLedger는 플레이어의 재화 원장을 최신순으로 조회합니다 (currency가 비어 있으면 모든 재화)
+func (s *WalletService) GetLedger(playerID uint, currency string, limit, offset int) ([]models.WalletLedgerEntry, int64, error) {
+	if currency != "" && !models.IsValidCurrency(currency) {
+		return nil, 0, ErrInvalidCurrency
+	}
+	return s.walletRepo.FindLedger(repository.WalletLedgerFilter{
+		PlayerID: playerID,
+		Currency: currency,
+	}, limit, offset)
+}
+
+// walletBalances는 저장된 잔액을 모든 재화 종류에 대해 채운 목록으로 만듭니다
+func walletBalances(playerID uint, stored []models.WalletBalance) []models.WalletBalance {
+	balances := make([]models.WalletBalance, len(models.Currencies))
+	for i, currency := range models.Currencies {
+		balances[i] = models.WalletBalance{PlayerID: playerID, Currency: currency}
+		for _, balance := range stored {
+			if balance.Currency == currency {
+				balances[i] = balance
+				break
+			}
+		}
+	}
+	return balances
+}
+
+// walletError는 Repository의 잔액 부족 오류를 서비스 오류로 변환합니다
+func walletError(err error) error {
+	var fundsErr *repository.InsufficientFundsError
+	if errors.As(err, &fundsErr) {
+		return &InsufficientFundsError{Currency: fundsErr.Currency}
+	}
+	return err
+}
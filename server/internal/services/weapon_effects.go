@@ -0,0 +1,80 @@
+package services
+
+import (
+	"errors"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"math"
+	"math/rand/v2"
+)
+
+var (
+	// ErrInvalidWeaponEffect는 콘텐츠에 정의되지 않은 특수 효과 종류일 때 반환됩니다
+	ErrInvalidWeaponEffect = errors.New("invalid weapon effect type")
+	// ErrWeaponEffectSlotsFull은 무기 등급이 허용하는 특수 효과 슬롯이 모두 찼을 때 반환됩니다
+	ErrWeaponEffectSlotsFull = errors.New("weapon effect slots are full")
+)
+
+// weaponEffectSlotCount는 콘텐츠 등급 표에서 등급이 허용하는 특수 효과 개수를 반환합니다 (표에 없는 등급은 가장 낮은 등급 기준)
+func weaponEffectSlotCount(catalog *content.Catalog, rarity string) int {
+	if tier, ok := catalog.Rarity(rarity); ok {
+		return tier.EffectSlots
+	}
+	return catalog.LowestRarity().EffectSlots
+}
+
+// rollWeaponEffect는 콘텐츠에 정의한 종류별 범위 안에서 효과량과 발동 확률을 굴려 특수 효과를 만듭니다
+func rollWeaponEffect(rule *content.WeaponEffectRule) models.WeaponEffect {
+	return models.WeaponEffect{
+		Type:       rule.ID,
+		Magnitude:  roundTo2(rule.Magnitude.Min + rand.Float64()*(rule.Magnitude.Max-rule.Magnitude.Min)),
+		ProcChance: roundTo2(rule.ProcChance.Min + rand.Float64()*(rule.ProcChance.Max-rule.ProcChance.Min)),
+	}
+}
+
+// rollForgeEffects는 제작한 무기 등급의 확률(chance)로 effects 중 무작위 종류의 특수 효과를 붙일지 굴립니다
+func rollForgeEffects(effects []content.WeaponEffectRule, chance float64) []models.WeaponEffect {
+	if len(effects) == 0 || rand.Float64() >= chance {
+		return nil
+	}
+	return []models.WeaponEffect{rollWeaponEffect(&effects[rand.IntN(len(effects))])}
+}
+
+// roundTo2는 소수 둘째 자리까지 반올림합니다
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// EnchantWeapon은 활력의 불씨를 내서 playerID 소유 무기에 effectType 특수 효과를 붙입니다
+// 이미 같은 종류의 효과가 있으면 수치를 다시 굴리고, 없으면 등급별 슬롯에 여유가 있을 때만 새로 붙입니다
+// 비용과 효과 종류별 수치 범위는 게임 콘텐츠의 enchant 섹션에서 읽습니다
+func (s *WeaponService) EnchantWeapon(playerID, weaponID uint, effectType string) (*models.Weapon, error) {
+	catalog := s.contentStore.Current()
+	rule, ok := catalog.WeaponEffect(effectType)
+	if !ok {
+		return nil, ErrInvalidWeaponEffect
+	}
+
+	weapon, err := s.weaponRepo.FindByID(weaponID)
+	if err != nil {
+		return nil, ErrWeaponNotFound
+	}
+	if weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
+	}
+
+	effect := rollWeaponEffect(rule)
+	effect.WeaponID = weapon.ID
+	enchanted, err := s.weaponRepo.Enchant(playerID, effect, weaponEffectSlotCount(catalog, weapon.Rarity), catalog.Enchant.EmberCost)
+	switch {
+	case errors.Is(err, repository.ErrWeaponEffectSlotsFull):
+		return nil, ErrWeaponEffectSlotsFull
+	case errors.Is(err, repository.ErrWeaponNotOwned):
+		// 사전 확인 이후 무기가 삭제되거나 소모된 경우입니다
+		return nil, ErrWeaponNotFound
+	case err != nil:
+		return nil, walletError(err)
+	}
+	return enchanted, nil
+}
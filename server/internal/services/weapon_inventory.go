@@ -0,0 +1,300 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"strconv"
+)
+
+var (
+	// ErrInvalidDisposal은 처분할 무기 ID와 등급 필터 중 하나만 지정하지 않았거나 등급이 잘못되었을 때 반환됩니다
+	ErrInvalidDisposal = errors.New("specify either weapon ids or valid rarities")
+	// ErrWeaponDisposalConflict는 처분 도중 무기가 다른 요청으로 바뀌었을 때 반환됩니다
+	ErrWeaponDisposalConflict = errors.New("weapons changed, please retry")
+	// ErrInventoryFull은 무기 보관함이 가득 차 무기를 더 받을 수 없을 때 반환됩니다
+	ErrInventoryFull = errors.New("weapon inventory is full")
+	// ErrInventoryMaxCapacity는 무기 보관함이 이미 최대 크기일 때 반환됩니다
+	ErrInventoryMaxCapacity = errors.New("weapon inventory is already at max capacity")
+	// ErrInventoryExpandConflict는 같은 보관함을 동시에 확장하려 했을 때 반환됩니다
+	ErrInventoryExpandConflict = errors.New("weapon inventory was expanded by another request")
+)
+
+// 처분할 수 없는 무기의 사유입니다
+const (
+	DisposalBlockedLocked   = "locked"   // 잠긴 무기
+	DisposalBlockedEquipped = "equipped" // 장착 중인 무기
+)
+
+// WeaponNotDisposableError는 지정한 무기가 잠겼거나 장착 중이라 판매·분해할 수 없을 때 반환됩니다
+type WeaponNotDisposableError struct {
+	WeaponID uint
+	Reason   string // DisposalBlockedLocked, DisposalBlockedEquipped
+}
+
+func (e *WeaponNotDisposableError) Error() string {
+	return fmt.Sprintf("weapon %d cannot be disposed: %s", e.WeaponID, e.Reason)
+}
+
+// disposalRarity는 처분 보상을 계산할 등급입니다 (콘텐츠 등급 표에 없는 등급은 가장 낮은 등급 기준)
+func disposalRarity(catalog *content.Catalog, weapon *models.Weapon) *content.RarityTier {
+	if tier, ok := catalog.Rarity(weapon.Rarity); ok {
+		return tier
+	}
+	return catalog.LowestRarity()
+}
+
+// weaponSellPrice는 무기를 판매할 때 받는 골드입니다
+// 등급별 판매 기본 가격에 강화에 쓴 골드의 일부(콘텐츠의 weapon_upgrade.sell_refund)를 더해서 돌려줍니다 (강화 비용은 콘텐츠 카탈로그의 강화 비용 표로 계산)
+func weaponSellPrice(catalog *content.Catalog, weapon *models.Weapon) int64 {
+	var upgradeSpent int64
+	for level := 1; level < weapon.Level; level++ {
+		upgradeSpent += catalog.WeaponUpgrade.Cost(level)
+	}
+	return disposalRarity(catalog, weapon).SellPrice + int64(float64(upgradeSpent)*catalog.WeaponUpgrade.SellRefund)
+}
+
+// weaponDismantleYield는 무기를 분해할 때 받는 파편 수입니다
+// 등급별 분해 기본 파편 수에 강화 단계마다 파편 1개를 더 줍니다
+func weaponDismantleYield(catalog *content.Catalog, weapon *models.Weapon) int64 {
+	return disposalRarity(catalog, weapon).DismantleScrap + int64(max(weapon.Level-1, 0))
+}
+
+// WeaponDisposal은 판매·분해할 무기를 고르는 조건입니다 (둘 중 하나만 지정)
+type WeaponDisposal struct {
+	WeaponIDs []uint   // 지정한 무기 (하나라도 처분할 수 없으면 전체를 거부)
+	Rarities  []string // 지정한 등급의 모든 무기 (잠긴 무기와 장착 중인 무기는 건너뜀)
+}
+
+// WeaponDisposalResult는 판매·분해 결과입니다
+type WeaponDisposalResult struct {
+	WeaponIDs []uint // 처분한 무기
+	Skipped   int    // 등급 필터로 골랐지만 잠겼거나 장착 중이라 건너뛴 무기 수
+	Currency  string // 받은 재화 (판매는 골드, 분해는 파편)
+	Amount    int64  // 받은 재화 양
+}
+
+// WeaponInventory는 플레이어의 무기 보관함 상태입니다
+type WeaponInventory struct {
+	Used        int   // 보유한 무기 수
+	Capacity    int   // 현재 보관 가능한 무기 수
+	MaxCapacity int   // 확장으로 늘릴 수 있는 최대 보관 수
+	ExpandStep  int   // 다음 확장으로 늘어나는 보관 수 (최대 크기면 0)
+	ExpandCost  int64 // 다음 확장 비용 (보석, 최대 크기면 0)
+}
+
+// weaponCapacity는 플레이어의 무기 보관 가능 수입니다 (기본 보관 수 + 확장, 최대 보관 수 이하)
+func weaponCapacity(cfg *config.Config, player *models.Player) int {
+	return min(cfg.WeaponInventoryBaseCapacity+player.WeaponCapacityBonus, cfg.WeaponInventoryMaxCapacity)
+}
+
+// SetWeaponLocked는 playerID 소유 무기를 잠그거나 잠금을 풉니다
+// 잠긴 무기는 판매·분해하거나 승급 재료로 쓸 수 없습니다
+func (s *WeaponService) SetWeaponLocked(playerID, weaponID uint, locked bool) (*models.Weapon, error) {
+	weapon, err := s.weaponRepo.FindByID(weaponID)
+	if err != nil {
+		return nil, ErrWeaponNotFound
+	}
+	if weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
+	}
+
+	updated, err := s.weaponRepo.SetLocked(weapon.ID, playerID, locked)
+	if errors.Is(err, repository.ErrWeaponNotOwned) {
+		return nil, ErrWeaponNotFound
+	}
+	return updated, err
+}
+
+// SellWeapons는 무기를 판매하고 등급과 강화 단계에 따른 골드를 지급합니다
+func (s *WeaponService) SellWeapons(playerID uint, disposal WeaponDisposal) (*WeaponDisposalResult, error) {
+	return s.disposeWeapons(playerID, disposal, models.CurrencyGold, models.LedgerReasonWeaponSell, weaponSellPrice)
+}
+
+// DismantleWeapons는 무기를 분해하고 등급과 강화 단계에 따른 파편을 지급합니다
+func (s *WeaponService) DismantleWeapons(playerID uint, disposal WeaponDisposal) (*WeaponDisposalResult, error) {
+	return s.disposeWeapons(playerID, disposal, models.CurrencyScrap, models.LedgerReasonWeaponDismantle, weaponDismantleYield)
+}
+
+// disposeWeapons는 조건에 맞는 무기를 삭제하고 무기별 보상의 합계를 한 번에 지급합니다
+// 무기 삭제와 보상 지급은 하나의 트랜잭션으로 처리됩니다
+func (s *WeaponService) disposeWeapons(
+	playerID uint,
+	disposal WeaponDisposal,
+	currency, reason string,
+	value func(*content.Catalog, *models.Weapon) int64,
+) (*WeaponDisposalResult, error) {
+	if (len(disposal.WeaponIDs) == 0) == (len(disposal.Rarities) == 0) {
+		return nil, ErrInvalidDisposal
+	}
+	catalog := s.contentStore.Current()
+	for _, rarity := range disposal.Rarities {
+		if catalog.RarityIndex(rarity) < 0 {
+			return nil, ErrInvalidDisposal
+		}
+	}
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	weapons, err := s.weaponRepo.FindByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []*models.Weapon
+	skipped := 0
+	if len(disposal.WeaponIDs) > 0 {
+		targets, err = s.pickDisposalTargets(player, weapons, disposal.WeaponIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rarities := make(map[string]bool, len(disposal.Rarities))
+		for _, rarity := range disposal.Rarities {
+			rarities[rarity] = true
+		}
+		for i := range weapons {
+			weapon := &weapons[i]
+			if !rarities[weapon.Rarity] {
+				continue
+			}
+			if disposalBlockReason(player, weapon) != "" {
+				skipped++
+				continue
+			}
+			targets = append(targets, weapon)
+		}
+	}
+
+	result := &WeaponDisposalResult{
+		WeaponIDs: make([]uint, len(targets)),
+		Skipped:   skipped,
+		Currency:  currency,
+	}
+	for i, weapon := range targets {
+		result.WeaponIDs[i] = weapon.ID
+		result.Amount += value(catalog, weapon)
+	}
+	if len(targets) == 0 {
+		return result, nil
+	}
+
+	reward := models.WalletLedgerEntry{
+		PlayerID: playerID,
+		Currency: currency,
+		Amount:   result.Amount,
+		Reason:   reason,
+		Note:     fmt.Sprintf("%d weapon(s)", len(targets)),
+	}
+	if len(targets) == 1 {
+		reward.RefType = "weapon"
+		reward.RefID = strconv.FormatUint(uint64(targets[0].ID), 10)
+	}
+	err = s.weaponRepo.Dispose(playerID, result.WeaponIDs, []models.WalletLedgerEntry{reward})
+	if errors.Is(err, repository.ErrWeaponDisposalConflict) {
+		return nil, ErrWeaponDisposalConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// pickDisposalTargets는 지정한 무기가 모두 플레이어 소유이고 잠기거나 장착 중이지 않은지 확인합니다
+func (s *WeaponService) pickDisposalTargets(player *models.Player, weapons []models.Weapon, weaponIDs []uint) ([]*models.Weapon, error) {
+	owned := make(map[uint]*models.Weapon, len(weapons))
+	for i := range weapons {
+		owned[weapons[i].ID] = &weapons[i]
+	}
+
+	seen := make(map[uint]bool, len(weaponIDs))
+	targets := make([]*models.Weapon, 0, len(weaponIDs))
+	for _, id := range weaponIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		weapon, ok := owned[id]
+		if !ok {
+			if _, err := s.weaponRepo.FindByID(id); err == nil {
+				return nil, ErrWeaponNotOwned
+			}
+			return nil, ErrWeaponNotFound
+		}
+		if reason := disposalBlockReason(player, weapon); reason != "" {
+			return nil, &WeaponNotDisposableError{WeaponID: id, Reason: reason}
+		}
+		targets = append(targets, weapon)
+	}
+	return targets, nil
+}
+
+// disposalBlockReason은 무기를 처분할 수 없는 사유를 반환합니다 (처분할 수 있으면 빈 문자열)
+func disposalBlockReason(player *models.Player, weapon *models.Weapon) string {
+	if weapon.Locked {
+		return DisposalBlockedLocked
+	}
+	if player.CurrentWeaponID != nil && *player.CurrentWeaponID == weapon.ID {
+		return DisposalBlockedEquipped
+	}
+	return ""
+}
+
+// GetInventory는 플레이어의 무기 보관함 상태를 조회합니다
+func (s *WeaponService) GetInventory(playerID uint) (*WeaponInventory, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	return s.inventoryFor(player)
+}
+
+// ExpandInventory는 보석을 내서 무기 보관함을 한 단계 확장합니다
+// 보석 차감과 확장은 하나의 트랜잭션으로 처리되며, 보석이 부족하면 *InsufficientFundsError를 반환합니다
+func (s *WeaponService) ExpandInventory(playerID uint) (*WeaponInventory, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	inventory, err := s.inventoryFor(player)
+	if err != nil {
+		return nil, err
+	}
+	if inventory.ExpandStep == 0 {
+		return nil, ErrInventoryMaxCapacity
+	}
+
+	err = s.weaponRepo.ExpandCapacity(playerID, player.WeaponCapacityBonus, inventory.ExpandStep, inventory.ExpandCost)
+	if errors.Is(err, repository.ErrWeaponCapacityChanged) {
+		return nil, ErrInventoryExpandConflict
+	}
+	if err != nil {
+		return nil, walletError(err)
+	}
+	return s.GetInventory(playerID)
+}
+
+// inventoryFor는 플레이어의 보유 무기 수와 보관 가능 수로 보관함 상태를 계산합니다
+func (s *WeaponService) inventoryFor(player *models.Player) (*WeaponInventory, error) {
+	used, err := s.weaponRepo.CountByPlayerID(player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &WeaponInventory{
+		Used:        int(used),
+		Capacity:    weaponCapacity(s.cfg, player),
+		MaxCapacity: s.cfg.WeaponInventoryMaxCapacity,
+	}
+	if inventory.Capacity < inventory.MaxCapacity {
+		inventory.ExpandStep = min(s.cfg.WeaponInventoryExpandStep, inventory.MaxCapacity-inventory.Capacity)
+		inventory.ExpandCost = s.cfg.WeaponInventoryExpandCost
+	}
+	return inventory, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"game_eating_pizza/internal/content"
+	"game_eating_pizza/internal/models"
+	"game_eating_pizza/internal/repository"
+	"math/rand/v2"
+)
+
+var (
+	// ErrWeaponMaxRarity는 더 올라갈 등급이 없는 무기를 승급하려 할 때 반환됩니다
+	ErrWeaponMaxRarity = errors.New("weapon is already at the highest rarity")
+	// ErrWeaponPromotionConflict는 승급 도중 무기나 재료 무기가 다른 요청으로 바뀌었을 때 반환됩니다
+	ErrWeaponPromotionConflict = errors.New("weapon or materials changed, please retry")
+)
+
+// PromotionMaterialError는 승급 재료 무기가 조건에 맞지 않을 때 반환됩니다
+type PromotionMaterialError struct {
+	WeaponID uint // 조건에 맞지 않는 재료 무기 (개수가 맞지 않으면 0)
+	Reason   string
+}
+
+func (e *PromotionMaterialError) Error() string {
+	if e.WeaponID == 0 {
+		return "invalid promotion materials: " + e.Reason
+	}
+	return fmt.Sprintf("invalid promotion material %d: %s", e.WeaponID, e.Reason)
+}
+
+// WeaponPromotionResult는 무기 등급 승급 시도 결과입니다
+type WeaponPromotionResult struct {
+	Weapon      *models.Weapon // 시도 후 무기 (실패하면 등급은 그대로이고 연속 실패 횟수가 늘어남)
+	Success     bool
+	Guaranteed  bool // 천장에 도달해 확정으로 성공했는지 여부
+	FromRarity  string
+	SuccessRate float64
+	Pity        int    // 이번 승급의 천장 (0이면 천장 없음)
+	ConsumedIDs []uint // 소모한 재료 무기
+	GoldSpent   int64
+}
+
+// GetRarityTiers는 콘텐츠의 무기 등급 표(등급별 공격력 배율, 처분 보상, 특수 효과 수와 승급 조건)를 반환합니다
+func (s *WeaponService) GetRarityTiers() []content.RarityTier {
+	return s.contentStore.Current().Rarities
+}
+
+// PromoteWeapon은 같은 종류·등급의 무기를 재료로 소모하고 골드를 내서 playerID 소유 무기의 등급을 한 단계 올립니다
+// 성공 여부는 콘텐츠 등급 표의 성공 확률로 정하며, 연속 실패가 천장에 이르면 다음 시도는 반드시 성공합니다
+// 재료와 골드는 실패해도 소모되고, 성공하면 공격력에 새 등급과 현재 등급의 배율 비를 곱합니다
+func (s *WeaponService) PromoteWeapon(playerID, weaponID uint, materialIDs []uint) (*WeaponPromotionResult, error) {
+	weapon, err := s.weaponRepo.FindByID(weaponID)
+	if err != nil {
+		return nil, ErrWeaponNotFound
+	}
+	if weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
+	}
+
+	// 등급 표에 없는 등급도 승급할 수 없는 것으로 봅니다
+	catalog := s.contentStore.Current()
+	index := catalog.RarityIndex(weapon.Rarity)
+	if index < 0 || index == len(catalog.Rarities)-1 {
+		return nil, ErrWeaponMaxRarity
+	}
+	current, next := catalog.Rarities[index], catalog.Rarities[index+1]
+	promotion := next.Promotion
+
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		return nil, ErrPlayerNotFound
+	}
+	if err := s.checkPromotionMaterials(player, weapon, promotion.Duplicates, materialIDs); err != nil {
+		return nil, err
+	}
+
+	guaranteed := promotion.Pity > 0 && weapon.PromotionFailures >= promotion.Pity
+	success := guaranteed || rand.Float64() < promotion.SuccessRate
+
+	promoted, err := s.weaponRepo.Promote(repository.WeaponPromotion{
+		WeaponID:     weapon.ID,
+		PlayerID:     playerID,
+		FromRarity:   weapon.Rarity,
+		FromFailures: weapon.PromotionFailures,
+		MaterialIDs:  materialIDs,
+		GoldCost:     promotion.GoldCost,
+		Success:      success,
+		ToRarity:     next.ID,
+		StatRatio:    next.StatMultiplier / current.StatMultiplier,
+	})
+	if errors.Is(err, repository.ErrWeaponPromotionConflict) {
+		return nil, ErrWeaponPromotionConflict
+	}
+	if err != nil {
+		return nil, walletError(err)
+	}
+
+	return &WeaponPromotionResult{
+		Weapon:      promoted,
+		Success:     success,
+		Guaranteed:  guaranteed,
+		FromRarity:  weapon.Rarity,
+		SuccessRate: promotion.SuccessRate,
+		Pity:        promotion.Pity,
+		ConsumedIDs: append([]uint{}, materialIDs...),
+		GoldSpent:   promotion.GoldCost,
+	}, nil
+}
+
+// checkPromotionMaterials는 재료 무기가 필요한 개수만큼 있고, 모두 플레이어 소유의 같은 종류·등급 무기이며
+// 승급할 무기나 장착 중인 무기, 잠긴 무기가 아닌지 확인합니다
+func (s *WeaponService) checkPromotionMaterials(player *models.Player, weapon *models.Weapon, required int, materialIDs []uint) error {
+	if len(materialIDs) != required {
+		return &PromotionMaterialError{
+			Reason: fmt.Sprintf("requires exactly %d %s %s weapon(s)", required, weapon.Rarity, weapon.Type),
+		}
+	}
+
+	seen := make(map[uint]bool, len(materialIDs))
+	for _, id := range materialIDs {
+		if seen[id] {
+			return &PromotionMaterialError{WeaponID: id, Reason: "listed more than once"}
+		}
+		seen[id] = true
+		if id == weapon.ID {
+			return &PromotionMaterialError{WeaponID: id, Reason: "cannot consume the weapon being promoted"}
+		}
+		if player.CurrentWeaponID != nil && *player.CurrentWeaponID == id {
+			return &PromotionMaterialError{WeaponID: id, Reason: "cannot consume the equipped weapon"}
+		}
+
+		material, err := s.weaponRepo.FindByID(id)
+		if err != nil || material.PlayerID != player.ID {
+			return &PromotionMaterialError{WeaponID: id, Reason: "weapon not found"}
+		}
+		if material.Type != weapon.Type || material.Rarity != weapon.Rarity {
+			return &PromotionMaterialError{WeaponID: id, Reason: fmt.Sprintf("must be a %s %s weapon", weapon.Rarity, weapon.Type)}
+		}
+		if material.Locked {
+			return &PromotionMaterialError{WeaponID: id, Reason: "cannot consume a locked weapon"}
+		}
+	}
+	return nil
+}
+
+// rarityStatMultiplier는 콘텐츠 등급 표의 공격력 배율입니다 (표에 없는 등급은 1배)
+func rarityStatMultiplier(catalog *content.Catalog, rarity string) float64 {
+	if tier, ok := catalog.Rarity(rarity); ok {
+		return tier.StatMultiplier
+	}
+	return 1.0
+}
@@ -2,24 +2,43 @@ package services
 
 import (
 	"errors"
+	"game_eating_pizza/internal/config"
+	"game_eating_pizza/internal/content"
 	"game_eating_pizza/internal/models"
 	"game_eating_pizza/internal/repository"
+	"math/rand/v2"
+)
+
+var (
+	// ErrWeaponNotFound는 존재하지 않는 무기일 때 반환됩니다
+	ErrWeaponNotFound = errors.New("weapon not found")
+	// ErrWeaponNotOwned는 다른 플레이어의 무기를 다루려 할 때 반환됩니다
+	ErrWeaponNotOwned = errors.New("weapon does not belong to player")
+	// ErrWeaponUpgradeConflict는 같은 무기를 동시에 강화하려 했을 때 반환됩니다
+	ErrWeaponUpgradeConflict = errors.New("weapon was upgraded by another request")
 )
 
 // WeaponService는 무기 관련 비즈니스 로직을 담당합니다
+// 강화 비용과 강화당 공격력은 콘텐츠 카탈로그(weapon_upgrade)를 따릅니다
 type WeaponService struct {
-	weaponRepo repository.WeaponRepositoryInterface
-	playerRepo repository.PlayerRepositoryInterface
+	weaponRepo   repository.WeaponRepositoryInterface
+	playerRepo   repository.PlayerRepositoryInterface
+	contentStore *content.Store
+	cfg          *config.Config
 }
 
 // NewWeaponService는 새로운 WeaponService 인스턴스를 생성합니다
 func NewWeaponService(
 	weaponRepo repository.WeaponRepositoryInterface,
 	playerRepo repository.PlayerRepositoryInterface,
+	contentStore *content.Store,
+	cfg *config.Config,
 ) *WeaponService {
 	return &WeaponService{
-		weaponRepo: weaponRepo,
-		playerRepo: playerRepo,
+		weaponRepo:   weaponRepo,
+		playerRepo:   playerRepo,
+		contentStore: contentStore,
+		cfg:          cfg,
 	}
 }
 
@@ -28,40 +47,42 @@ func (s *WeaponService) GetWeaponsByPlayerID(playerID uint) ([]models.Weapon, er
 	return s.weaponRepo.FindByPlayerID(playerID)
 }
 
-// UpgradeWeapon는 무기를 강화합니다
-func (s *WeaponService) UpgradeWeapon(weaponID uint) (*models.Weapon, error) {
+// UpgradeWeapon는 playerID 소유의 무기를 한 단계 강화합니다
+// 골드 차감과 무기 강화는 하나의 트랜잭션으로 처리되며, 골드가 부족하면 *InsufficientFundsError를 반환합니다
+func (s *WeaponService) UpgradeWeapon(playerID, weaponID uint) (*models.Weapon, error) {
 	weapon, err := s.weaponRepo.FindByID(weaponID)
 	if err != nil {
-		return nil, errors.New("weapon not found")
+		return nil, ErrWeaponNotFound
 	}
-
-	// 강화 비용 계산 (레벨에 따라 증가)
-	upgradeCost := int64(weapon.Level * 100)
-
-	// 플레이어 골드 확인
-	player, err := s.playerRepo.FindByID(weapon.PlayerID)
-	if err != nil {
-		return nil, errors.New("player not found")
+	if weapon.PlayerID != playerID {
+		return nil, ErrWeaponNotOwned
 	}
 
-	if player.Gold < upgradeCost {
-		return nil, errors.New("insufficient gold")
+	upgrade := s.contentStore.Current().WeaponUpgrade
+	upgraded, err := s.weaponRepo.Upgrade(weapon.ID, playerID, weapon.Level, upgrade.AttackPowerGain, upgrade.Cost(weapon.Level))
+	if errors.Is(err, repository.ErrWeaponLevelChanged) {
+		return nil, ErrWeaponUpgradeConflict
 	}
-
-	// 골드 차감
-	player.Gold -= upgradeCost
-	if err := s.playerRepo.Update(player); err != nil {
-		return nil, err
+	if err != nil {
+		return nil, walletError(err)
 	}
+	return upgraded, nil
+}
 
-	// 무기 강화
-	weapon.Level++
-	weapon.AttackPower += 5 // 레벨당 공격력 증가
-	if err := s.weaponRepo.Update(weapon); err != nil {
-		return nil, err
-	}
+// newWeaponFromTemplate은 무기 템플릿의 능력치 범위 안에서 값을 굴려 rarity 등급의 1단계 무기를 만듭니다
+func newWeaponFromTemplate(template *content.WeaponTemplate, playerID uint, rarity string) *models.Weapon {
+	attackPower := template.AttackPower.Min + rand.IntN(template.AttackPower.Max-template.AttackPower.Min+1)
+	attackSpeed := template.AttackSpeed.Min + rand.Float64()*(template.AttackSpeed.Max-template.AttackSpeed.Min)
 
-	return weapon, nil
+	return &models.Weapon{
+		PlayerID:    playerID,
+		Name:        template.Name,
+		Type:        template.Type,
+		AttackPower: attackPower,
+		AttackSpeed: roundTo2(attackSpeed),
+		Rarity:      rarity,
+		Level:       1,
+	}
 }
 
 // EquipWeapon는 무기를 장착합니다
@@ -69,19 +90,18 @@ func (s *WeaponService) EquipWeapon(playerID, weaponID uint) error {
 	// 무기가 플레이어 소유인지 확인
 	weapon, err := s.weaponRepo.FindByID(weaponID)
 	if err != nil {
-		return errors.New("weapon not found")
+		return ErrWeaponNotFound
 	}
 
 	if weapon.PlayerID != playerID {
-		return errors.New("weapon does not belong to player")
+		return ErrWeaponNotOwned
 	}
 
 	// 플레이어 조회 및 무기 장착
 	player, err := s.playerRepo.FindByID(playerID)
 	if err != nil {
-		return errors.New("player not found")
+		return ErrPlayerNotFound
 	}
 
-	player.CurrentWeaponID = &weaponID
-	return s.playerRepo.Update(player)
+	return s.playerRepo.UpdateCurrentWeapon(player.ID, &weaponID)
 }
@@ -0,0 +1,79 @@
+package token
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Issuer는 발급하는 토큰의 iss 클레임 값입니다
+const Issuer = "tiny-breakers"
+
+var (
+	// ErrTokenExpired는 토큰 유효기간이 지났을 때 반환됩니다
+	ErrTokenExpired = errors.New("token expired")
+	// ErrTokenInvalid는 서명 불일치, 형식 오류 등 위변조된 토큰일 때 반환됩니다
+	ErrTokenInvalid = errors.New("token invalid")
+)
+
+// Claims는 액세스 토큰에 담기는 클레임입니다
+type Claims struct {
+	PlayerID  uint `json:"player_id"`
+	SessionID uint `json:"sid"` // 토큰을 발급한 기기 세션 (세션 종료 시 즉시 무효화하기 위함)
+	jwt.RegisteredClaims
+}
+
+// GenerateAccessToken은 플레이어 ID와 세션 ID를 담은 HS256 액세스 토큰을 발급합니다
+// 반환값: 서명된 토큰 문자열, 만료 시각
+func GenerateAccessToken(playerID, sessionID uint, secret string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	claims := Claims{
+		PlayerID:  playerID,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Subject:   strconv.FormatUint(uint64(playerID), 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// ParseAccessToken은 액세스 토큰의 서명과 유효기간을 검증하고 클레임을 반환합니다
+// 만료된 토큰은 ErrTokenExpired, 그 외 검증 실패는 ErrTokenInvalid를 반환합니다
+func ParseAccessToken(tokenString, secret string) (*Claims, error) {
+	claims := &Claims{}
+	parsed, err := jwt.ParseWithClaims(
+		tokenString,
+		claims,
+		func(t *jwt.Token) (interface{}, error) {
+			return []byte(secret), nil
+		},
+		// alg 헤더를 HS256으로 고정하여 "none" 등 알고리즘 바꿔치기 공격을 막습니다
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, ErrTokenInvalid
+	}
+
+	if !parsed.Valid || claims.PlayerID == 0 || claims.SessionID == 0 {
+		return nil, ErrTokenInvalid
+	}
+	return claims, nil
+}
@@ -0,0 +1,50 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// GenerateOpaqueToken은 추측이 불가능한 랜덤 토큰(base64url)을 생성합니다
+// 리프레시 토큰처럼 서버에 해시만 저장하는 불투명 토큰에 사용합니다
+func GenerateOpaqueToken(byteLen int) (string, error) {
+	buf := make([]byte, byteLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// GenerateID는 토큰 패밀리 등 식별자로 쓸 32자리 랜덤 hex 문자열을 생성합니다
+func GenerateID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashOpaqueToken은 DB 저장용 SHA-256 해시(hex)를 반환합니다
+// 원문 토큰은 저장하지 않으므로 DB가 유출되어도 토큰을 재사용할 수 없습니다
+func HashOpaqueToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// codeAlphabet은 사람이 입력하는 코드에 쓰는 문자 집합입니다 (0/O, 1/I 등 혼동되는 문자 제외)
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GenerateCode는 사람이 직접 입력할 수 있는 길이 length의 랜덤 코드를 생성합니다
+func GenerateCode(length int) (string, error) {
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	// 알파벳 크기(32)가 256의 약수이므로 나머지 연산으로도 분포가 균등합니다
+	for i := range buf {
+		buf[i] = codeAlphabet[int(buf[i])%len(codeAlphabet)]
+	}
+	return string(buf), nil
+}